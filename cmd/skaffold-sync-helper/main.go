@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+)
+
+const usage = `Usage:
+  skaffold-sync-helper install <dir>      copy the helper into <dir>
+  skaffold-sync-helper extract <root>     extract the tar archive read from stdin under <root>
+  skaffold-sync-helper delete -- <paths>  delete the given paths recursively
+`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("missing arguments\n%s", usage)
+	}
+
+	switch args[0] {
+	case "install":
+		return helper.Install(args[1])
+	case "extract":
+		return helper.Extract(os.Stdin, args[1])
+	case "delete":
+		paths := args[1:]
+		if paths[0] == "--" {
+			paths = paths[1:]
+		}
+		return helper.Delete(paths)
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+}
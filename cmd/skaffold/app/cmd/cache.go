@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+var (
+	purgeAll         bool
+	cacheExplainMode string
+)
+
+// NewCmdCache describes the CLI command to inspect and manage the artifact cache.
+func NewCmdCache() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and manage the artifact cache",
+	}
+
+	cmd.AddCommand(NewCmdCacheList())
+	cmd.AddCommand(NewCmdCacheExplain())
+	cmd.AddCommand(NewCmdCachePurge())
+	return cmd
+}
+
+func NewCmdCacheList() *cobra.Command {
+	return NewCmd("list").
+		WithDescription("List the entries of the artifact cache").
+		WithExample("List the cached images", "cache list").
+		WithFlags([]*Flag{
+			{Value: &opts.CacheFile, Name: "cache-file", DefValue: "", Usage: "Specify the location of the cache file (default $HOME/.skaffold/cache)"}}).
+		NoArgs(func(_ context.Context, out io.Writer) error {
+			return cache.List(out, opts.CacheFile)
+		})
+}
+
+func NewCmdCacheExplain() *cobra.Command {
+	return NewCmd("explain").
+		WithDescription("Explain why artifacts need to be rebuilt").
+		WithLongDescription("Print the inputs hashed to look up each artifact in the cache and, for artifacts that aren't cached, how they differ from the previous build").
+		WithExample("Explain why all the artifacts need to be rebuilt", "cache explain").
+		WithExample("Explain why artifacts whose image name contains <db> need to be rebuilt in debug mode", "cache explain -b <db> --mode debug").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &cacheExplainMode, Name: "mode", DefValue: "dev", Usage: "The command whose cache lookup is explained (build, dev, run or debug), since debug builds hash different inputs."}}).
+		NoArgs(doCacheExplain)
+}
+
+func NewCmdCachePurge() *cobra.Command {
+	return NewCmd("purge").
+		WithDescription("Remove entries from the artifact cache").
+		WithExample("Remove the cached builds of an image", "cache purge gcr.io/k8s-skaffold/leeroy-web").
+		WithExample("Remove all the entries", "cache purge --all").
+		WithFlags([]*Flag{
+			{Value: &opts.CacheFile, Name: "cache-file", DefValue: "", Usage: "Specify the location of the cache file (default $HOME/.skaffold/cache)"},
+			{Value: &purgeAll, Name: "all", DefValue: false, Usage: "Remove all the entries of the cache"}}).
+		WithArgs(cobra.ArbitraryArgs, func(_ context.Context, out io.Writer, args []string) error {
+			return cache.Purge(out, opts.CacheFile, args, purgeAll)
+		})
+}
+
+func doCacheExplain(ctx context.Context, out io.Writer) error {
+	switch cacheExplainMode {
+	case "build", "dev", "run", "debug":
+	default:
+		return fmt.Errorf("invalid mode %q: must be one of build, dev, run or debug", cacheExplainMode)
+	}
+
+	// The artifacts' hashes depend on the command they are built for.
+	opts.Command = cacheExplainMode
+
+	return withRunner(ctx, out, func(r runner.Runner, configs []*latest_v1.SkaffoldConfig) error {
+		return r.ExplainCache(ctx, out, targetArtifacts(opts, configs))
+	})
+}
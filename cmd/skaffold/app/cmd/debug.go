@@ -18,12 +18,17 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 
 	"github.com/spf13/cobra"
 
 	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	kubernetesdebugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/debugging"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
 // for tests
@@ -38,6 +43,7 @@ func NewCmdDebug() *cobra.Command {
 			"Auto-build and sync is disabled by default to prevent accidentally tearing down debug sessions.").
 		WithCommonFlags().
 		WithExample("Launch with port-forwarding", "debug --port-forward").
+		WithExample("Attach debuggers to already running pods", "debug --attach --port-forward").
 		WithHouseKeepingMessages().
 		NoArgs(func(ctx context.Context, out io.Writer) error {
 			return doDebug(ctx, out)
@@ -45,6 +51,20 @@ func NewCmdDebug() *cobra.Command {
 }
 
 func runDebug(ctx context.Context, out io.Writer) error {
+	if opts.AttachDebuggers {
+		err := withRunner(ctx, out, func(r runner.Runner, configs []*latest_v1.SkaffoldConfig) error {
+			var artifacts []*latest_v1.Artifact
+			for _, cfg := range configs {
+				artifacts = append(artifacts, cfg.Build.Artifacts...)
+			}
+			return r.AttachDebuggers(ctx, out, artifacts)
+		})
+		if !errors.Is(err, kubernetesdebugging.ErrEphemeralContainersUnsupported) && !errors.Is(err, kubernetesdebugging.ErrNoAttachableContainers) {
+			return err
+		}
+		fmt.Fprintf(out, "Unable to attach debuggers (%v), redeploying the pipeline in debug mode instead\n", err)
+	}
+
 	manifest.AddTransform(debugging.ApplyDebuggingTransforms)
 
 	return doDev(ctx, out)
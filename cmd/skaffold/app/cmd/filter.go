@@ -74,6 +74,14 @@ func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildA
 				return fmt.Errorf("retrieving insecure registries: %w", err)
 			}
 
+			var artifacts []*latest_v1.Artifact
+			for _, cfg := range configs {
+				artifacts = append(artifacts, cfg.Build.Artifacts...)
+			}
+			if err := debugging.Init(artifacts); err != nil {
+				return fmt.Errorf("resolving debug transforms: %w", err)
+			}
+
 			manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, manifest.Registries{
 				DebugHelpersRegistry: debugHelpersRegistry,
 				InsecureRegistries:   insecureRegistries,
@@ -101,7 +101,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Profiles,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply", "explain"},
 	},
 	{
 		Name:          "namespace",
@@ -136,7 +136,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.CacheFile,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"dev", "build", "run", "debug"},
+		DefinedOn:     []string{"dev", "build", "run", "debug", "explain"},
 	},
 	{
 		Name:          "remote-cache-dir",
@@ -231,6 +231,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"deploy", "dev", "run", "debug", "apply"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "test-report",
+		Usage:         "Directory to write JUnit XML and JSON reports of the tests to",
+		Value:         &opts.TestReport,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "build", "test"},
+	},
 	{
 		Name:          "skip-tests",
 		Usage:         "Whether to skip the tests after building",
@@ -317,7 +325,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.GlobalConfig,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"run", "dev", "debug", "build", "deploy", "delete", "diagnose", "apply"},
+		DefinedOn:     []string{"run", "dev", "debug", "build", "deploy", "delete", "diagnose", "apply", "explain"},
 	},
 	{
 		Name:          "kube-context",
@@ -325,7 +333,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeContext,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply", "explain"},
 	},
 	{
 		Name:          "kubeconfig",
@@ -333,7 +341,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeConfig,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply", "explain"},
 	},
 	{
 		Name:          "tag",
@@ -362,7 +370,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.ProfileAutoActivation,
 		DefValue:      true,
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "explain"},
 		IsEnum:        true,
 	},
 	{
@@ -413,6 +421,24 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "attach",
+		Usage:         "Attach debuggers to the already running containers with ephemeral containers instead of redeploying them",
+		Value:         &opts.AttachDebuggers,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"debug"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "ide-launch-configs",
+		Usage:         "Write VS Code and JetBrains launch configurations for the forwarded debug ports, and remove them on exit",
+		Value:         &opts.IDELaunchConfigs,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"debug"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "watch-image",
 		Shorthand:     "w",
@@ -481,7 +507,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.TargetImages,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"build", "run"},
+		DefinedOn:     []string{"build", "run", "explain"},
 	},
 	{
 		Name:          "detect-minikube",
@@ -527,6 +553,22 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "test-concurrency",
+		Usage:         "Number of concurrently running tests. Set to 0 to run all tests in parallel.",
+		Value:         &opts.TestConcurrency,
+		DefValue:      1,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "test"},
+	},
+	{
+		Name:          "test-filter",
+		Usage:         "Only run the tests of the given images, or the tests with the given names. Set multiple times to select several tests",
+		Value:         &opts.TestFilter,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "test"},
+	},
 	{
 		Name:          "v3",
 		Usage:         "Next skaffold config (v3). Use kpt to render/hydrate and deploy manifests.",
@@ -35,6 +35,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/parser"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	v3 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/v3"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/validation"
@@ -64,7 +65,7 @@ func createNewRunner(out io.Writer, opts config.SkaffoldOptions) (runner.Runner,
 	}
 
 	instrumentation.InitMeterFromConfig(configs, opts.User)
-	runner, err := runner.NewForConfig(runCtx)
+	runner, err := newRunner(runCtx, opts.Experimental)
 	if err != nil {
 		event.InititializationFailed(err)
 		return nil, nil, nil, fmt.Errorf("creating runner: %w", err)
@@ -73,6 +74,14 @@ func createNewRunner(out io.Writer, opts config.SkaffoldOptions) (runner.Runner,
 	return runner, configs, runCtx, nil
 }
 
+// newRunner creates the v3 runner when `--v3` is set, and the v1 runner otherwise.
+func newRunner(runCtx *runcontext.RunContext, v3Runner bool) (runner.Runner, error) {
+	if v3Runner {
+		return v3.NewForConfig(runCtx)
+	}
+	return runner.NewForConfig(runCtx)
+}
+
 func runContext(out io.Writer, opts config.SkaffoldOptions) (*runcontext.RunContext, []*latest_v1.SkaffoldConfig, error) {
 	configs, err := withFallbackConfig(out, opts, parser.GetAllConfigs)
 	if err != nil {
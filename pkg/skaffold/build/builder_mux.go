@@ -24,8 +24,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
 )
 
@@ -35,10 +37,13 @@ type BuilderMux struct {
 	byImageName map[string]PipelineBuilder
 	store       ArtifactStore
 	concurrency int
+	signConfigs map[string]*latest_v1.SignConfig
+	cfg         docker.Config
 }
 
 // Config represents an interface for getting all config pipelines.
 type Config interface {
+	docker.Config
 	GetPipelines() []latest_v1.Pipeline
 	DefaultRepo() *string
 	GlobalConfig() string
@@ -49,6 +54,7 @@ type Config interface {
 func NewBuilderMux(cfg Config, store ArtifactStore, builder func(p latest_v1.Pipeline) (PipelineBuilder, error)) (*BuilderMux, error) {
 	pipelines := cfg.GetPipelines()
 	m := make(map[string]PipelineBuilder)
+	signConfigs := make(map[string]*latest_v1.SignConfig)
 	var pb []PipelineBuilder
 	minConcurrency := -1
 	for i, p := range pipelines {
@@ -59,6 +65,7 @@ func NewBuilderMux(cfg Config, store ArtifactStore, builder func(p latest_v1.Pip
 		pb = append(pb, b)
 		for _, a := range p.Build.Artifacts {
 			m[a.ImageName] = b
+			signConfigs[a.ImageName] = p.Build.Sign
 		}
 
 		if cfg.BuildConcurrency() >= 0 {
@@ -80,7 +87,7 @@ func NewBuilderMux(cfg Config, store ArtifactStore, builder func(p latest_v1.Pip
 	}
 	logrus.Infof("final build concurrency value is %d", minConcurrency)
 
-	return &BuilderMux{builders: pb, byImageName: m, store: store, concurrency: minConcurrency}, nil
+	return &BuilderMux{builders: pb, byImageName: m, store: store, concurrency: minConcurrency, signConfigs: signConfigs, cfg: cfg}, nil
 }
 
 // Build executes the specific image builder for each artifact in the given artifact slice.
@@ -112,9 +119,27 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		}
 	}
 
+	if err := b.sign(out, ar); err != nil {
+		return nil, err
+	}
+
 	return ar, nil
 }
 
+// sign signs the built images of the pipelines that have a `sign` config.
+func (b *BuilderMux) sign(out io.Writer, artifacts []graph.Artifact) error {
+	for _, a := range artifacts {
+		cfg := b.signConfigs[a.ImageName]
+		if cfg == nil {
+			continue
+		}
+		if err := sign.Sign(out, a, *cfg, b.cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Prune removes built images.
 func (b *BuilderMux) Prune(ctx context.Context, writer io.Writer) error {
 	for _, builder := range b.builders {
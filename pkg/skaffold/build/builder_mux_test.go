@@ -22,6 +22,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -92,6 +93,7 @@ func TestNewBuilderMux(t *testing.T) {
 }
 
 type mockConfig struct {
+	docker.Config
 	pipelines []latest_v1.Pipeline
 	optRepo   string
 }
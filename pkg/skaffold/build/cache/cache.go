@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
@@ -40,6 +41,12 @@ import (
 type ImageDetails struct {
 	Digest string `yaml:"digest,omitempty"`
 	ID     string `yaml:"id,omitempty"`
+
+	// ImageName, Created and Inputs describe how the image was built, for `skaffold cache`.
+	// Inputs are only kept for the most recent entry of each image.
+	ImageName string    `yaml:"imageName,omitempty"`
+	Created   time.Time `yaml:"created,omitempty"`
+	Inputs    []string  `yaml:"inputs,omitempty"`
 }
 
 // ArtifactCache is a map of [artifact dependencies hash : ImageDetails]
@@ -57,6 +64,8 @@ type cache struct {
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
 	lister             DependencyLister
+	remoteCaches       map[string]*remoteCache
+	inputsByHash       map[string][]string
 }
 
 // DependencyLister fetches a list of dependencies for an artifact
@@ -103,6 +112,11 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		}
 	}
 
+	remoteCaches, err := newRemoteCaches(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	importMissingImage := func(imageName string) (bool, error) {
 		pipeline, found := cfg.PipelineForImage(imageName)
 		if !found {
@@ -125,9 +139,29 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
 		lister:             dependencies,
+		remoteCaches:       remoteCaches,
 	}, nil
 }
 
+// newRemoteCaches returns the remote cache to use, if any, for each artifact.
+func newRemoteCaches(cfg Config) (map[string]*remoteCache, error) {
+	remoteCaches := make(map[string]*remoteCache)
+	for _, p := range cfg.GetPipelines() {
+		if p.Build.RemoteCache == nil {
+			continue
+		}
+
+		rc, err := newRemoteCache(p.Build.RemoteCache, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating remote cache: %w", err)
+		}
+		for _, a := range p.Build.Artifacts {
+			remoteCaches[a.ImageName] = rc
+		}
+	}
+	return remoteCaches, nil
+}
+
 // resolveCacheFile makes sure that either a passed in cache file or the default cache file exists
 func resolveCacheFile(cacheFile string) (string, error) {
 	if cacheFile != "" {
@@ -26,10 +26,12 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
@@ -46,6 +48,24 @@ var (
 
 type artifactHasher interface {
 	hash(ctx context.Context, a *latest_v1.Artifact) (string, error)
+	inputs(ctx context.Context, a *latest_v1.Artifact) ([]string, error)
+}
+
+// hashInput is one of the values hashed to compute an artifact's cache key,
+// along with a label describing where it comes from.
+type hashInput struct {
+	label string
+	value string
+}
+
+func (i hashInput) String() string {
+	return i.label + ": " + i.value
+}
+
+// artifactHash is the hash of a single artifact, and the inputs it was computed from.
+type artifactHash struct {
+	hash   string
+	inputs []hashInput
 }
 
 type artifactHasherImpl struct {
@@ -85,40 +105,83 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest_v1.Artifact) (s
 	return encode(hashes)
 }
 
+// inputs describes everything that was hashed to compute the artifact's cache key,
+// including the hashes of the artifacts it requires.
+func (h *artifactHasherImpl) inputs(ctx context.Context, a *latest_v1.Artifact) ([]string, error) {
+	res, err := h.safeHashResult(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []string
+	for _, in := range res.inputs {
+		inputs = append(inputs, in.String())
+	}
+	for _, dep := range sortedDependencies(a, h.artifacts) {
+		depHash, err := h.hash(ctx, dep)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, hashInput{label: "artifact " + dep.ImageName, value: depHash}.String())
+	}
+	return inputs, nil
+}
+
 func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latest_v1.Artifact) (string, error) {
+	res, err := h.safeHashResult(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	return res.hash, nil
+}
+
+func (h *artifactHasherImpl) safeHashResult(ctx context.Context, a *latest_v1.Artifact) (artifactHash, error) {
 	val := h.syncStore.Exec(a.ImageName,
 		func() interface{} {
-			hash, err := singleArtifactHash(ctx, h.lister, a, h.mode)
+			inputs, err := singleArtifactHashInputs(ctx, h.lister, a, h.mode)
+			if err != nil {
+				return err
+			}
+			hash, err := encodeInputs(inputs)
 			if err != nil {
 				return err
 			}
-			return hash
+			return artifactHash{hash: hash, inputs: inputs}
 		})
 	switch t := val.(type) {
 	case error:
-		return "", t
-	case string:
+		return artifactHash{}, t
+	case artifactHash:
 		return t, nil
 	default:
-		return "", fmt.Errorf("internal error when retrieving cache result of type %T", t)
+		return artifactHash{}, fmt.Errorf("internal error when retrieving cache result of type %T", t)
 	}
 }
 
 // singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
 func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest_v1.Artifact, mode config.RunMode) (string, error) {
-	var inputs []string
+	inputs, err := singleArtifactHashInputs(ctx, depLister, a, mode)
+	if err != nil {
+		return "", err
+	}
+	return encodeInputs(inputs)
+}
+
+// singleArtifactHashInputs lists the inputs hashed for a single artifact, and ignores its required artifacts.
+func singleArtifactHashInputs(ctx context.Context, depLister DependencyLister, a *latest_v1.Artifact, mode config.RunMode) ([]hashInput, error) {
+	var inputs []hashInput
 
 	// Append the artifact's configuration
 	config, err := artifactConfigFunc(a)
 	if err != nil {
-		return "", fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
+		return nil, fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
 	}
-	inputs = append(inputs, config)
+	inputs = append(inputs, hashInput{label: "config", value: config})
 
 	// Append the digest of each input file
 	deps, err := depLister(ctx, a)
 	if err != nil {
-		return "", fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+		return nil, fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
 	}
 	sort.Strings(deps)
 
@@ -130,20 +193,34 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 				continue // Ignore files that don't exist
 			}
 
-			return "", fmt.Errorf("getting hash for %q: %w", d, err)
+			return nil, fmt.Errorf("getting hash for %q: %w", d, err)
 		}
-		inputs = append(inputs, h)
+		inputs = append(inputs, hashInput{label: "file " + d, value: h})
 	}
 
 	// add build args for the artifact if specified
 	args, err := hashBuildArgs(a, mode)
 	if err != nil {
-		return "", fmt.Errorf("hashing build args: %w", err)
+		return nil, fmt.Errorf("hashing build args: %w", err)
 	}
-	if args != nil {
-		inputs = append(inputs, args...)
+	for _, arg := range args {
+		inputs = append(inputs, hashInput{label: "build arg", value: arg})
+	}
+
+	// add the target platforms, so that images built for different platforms don't share a cache entry
+	if platforms := hashPlatforms(a); platforms != "" {
+		inputs = append(inputs, hashInput{label: "target", value: platforms})
+	}
+	return inputs, nil
+}
+
+// encodeInputs hashes the values of the inputs. The labels are not part of the hash.
+func encodeInputs(inputs []hashInput) (string, error) {
+	values := make([]string, len(inputs))
+	for i, in := range inputs {
+		values[i] = in.value
 	}
-	return encode(inputs)
+	return encode(values)
 }
 
 func encode(inputs []string) (string, error) {
@@ -179,6 +256,8 @@ func hashBuildArgs(artifact *latest_v1.Artifact, mode config.RunMode) ([]string,
 		env, err = buildpacks.GetEnv(artifact, mode)
 	case artifact.CustomArtifact != nil && artifact.CustomArtifact.Dependencies.Dockerfile != nil:
 		args, err = util.EvaluateEnvTemplateMap(artifact.CustomArtifact.Dependencies.Dockerfile.BuildArgs)
+	case artifact.KoArtifact != nil:
+		return ko.GetBuildArgs(artifact.KoArtifact, mode)
 	default:
 		return nil, nil
 	}
@@ -195,6 +274,16 @@ func hashBuildArgs(artifact *latest_v1.Artifact, mode config.RunMode) ([]string,
 	return sl, nil
 }
 
+// hashPlatforms returns the sorted set of target platforms for the artifact.
+func hashPlatforms(a *latest_v1.Artifact) string {
+	if len(a.Platforms) == 0 {
+		return ""
+	}
+	platforms := append([]string(nil), a.Platforms...)
+	sort.Strings(platforms)
+	return "platforms=" + strings.Join(platforms, ",")
+}
+
 // fileHasher hashes the contents and name of a file
 func fileHasher(p string) (string, error) {
 	h := md5.New()
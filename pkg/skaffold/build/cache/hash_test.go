@@ -543,6 +543,25 @@ func TestHashBuildArgs(t *testing.T) {
 					Dependencies: &latest_v1.CustomDependencies{},
 				},
 			},
+		}, {
+			description: "ko artifact with env for dev",
+			artifactType: latest_v1.ArtifactType{
+				KoArtifact: &latest_v1.KoArtifact{
+					Main: ".",
+					Env:  []string{"foo=bar"},
+				},
+			},
+			mode:     config.RunModes.Dev,
+			expected: []string{"build", "-o", "", ".", "foo=bar"},
+		}, {
+			description: "ko artifact for debug",
+			artifactType: latest_v1.ArtifactType{
+				KoArtifact: &latest_v1.KoArtifact{
+					Main: ".",
+				},
+			},
+			mode:     config.RunModes.Debug,
+			expected: []string{"build", "-o", "", "-gcflags", "all=-N -l", "."},
 		},
 	}
 
@@ -569,3 +588,48 @@ func TestHashBuildArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestHashPlatforms(t *testing.T) {
+	tests := []struct {
+		description    string
+		platforms      []string
+		otherPlatforms []string
+		differentHash  bool
+	}{
+		{
+			description:   "no platforms",
+			differentHash: false,
+		},
+		{
+			description:    "same platforms in different order",
+			platforms:      []string{"linux/amd64", "linux/arm64"},
+			otherPlatforms: []string{"linux/arm64", "linux/amd64"},
+			differentHash:  false,
+		},
+		{
+			description:    "different platforms",
+			platforms:      []string{"linux/amd64"},
+			otherPlatforms: []string{"linux/arm64"},
+			differentHash:  true,
+		},
+		{
+			description:    "platform added",
+			otherPlatforms: []string{"linux/arm64"},
+			differentHash:  true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&fileHasherFunc, mockCacheHasher)
+			t.Override(&artifactConfigFunc, fakeArtifactConfig)
+			depLister := stubDependencyLister([]string{"a", "b"})
+
+			hash1, err := singleArtifactHash(context.Background(), depLister, &latest_v1.Artifact{Platforms: test.platforms}, config.RunModes.Build)
+			t.CheckNoError(err)
+			hash2, err := singleArtifactHash(context.Background(), depLister, &latest_v1.Artifact{Platforms: test.otherPlatforms}, config.RunModes.Build)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.differentHash, hash1 != hash2)
+		})
+	}
+}
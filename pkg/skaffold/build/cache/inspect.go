@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// for testing
+var now = time.Now
+
+// List prints the entries of the cache file, most recent first.
+func List(out io.Writer, cacheFile string) error {
+	artifactCache, _, err := readCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IMAGE\tHASH\tDIGEST\tID\tAGE")
+	for _, hash := range sortedHashes(artifactCache) {
+		entry := artifactCache[hash]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", orNone(entry.ImageName), shorten(hash), orNone(entry.Digest), orNone(shorten(entry.ID)), age(entry.Created))
+	}
+	return w.Flush()
+}
+
+// Purge removes the entries of the given images from the cache file, or all the entries if `all` is true.
+func Purge(out io.Writer, cacheFile string, images []string, all bool) error {
+	if len(images) == 0 && !all {
+		return errors.New("no image to purge: list the images, or use --all to purge the whole cache")
+	}
+
+	artifactCache, cacheFile, err := readCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	selected := make(map[string]bool)
+	for _, image := range images {
+		selected[image] = true
+	}
+
+	purged := 0
+	for hash, entry := range artifactCache {
+		if all || selected[entry.ImageName] {
+			delete(artifactCache, hash)
+			purged++
+		}
+	}
+
+	if err := saveArtifactCache(cacheFile, artifactCache); err != nil {
+		return fmt.Errorf("saving cache file: %w", err)
+	}
+	color.Default.Fprintf(out, "Purged %d cache entries\n", purged)
+	return nil
+}
+
+// Explain prints the inputs hashed for each artifact and, for the artifacts that aren't cached,
+// how these inputs differ from the most recent cache entry of the same image.
+func (c *cache) Explain(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
+	h := newArtifactHasherFunc(c.artifactGraph, c.lister, c.cfg.Mode())
+
+	for _, a := range artifacts {
+		hash, err := h.hash(ctx, a)
+		if err != nil {
+			return fmt.Errorf("getting hash for artifact %q: %w", a.ImageName, err)
+		}
+		inputs, err := h.inputs(ctx, a)
+		if err != nil {
+			return fmt.Errorf("getting hash inputs for artifact %q: %w", a.ImageName, err)
+		}
+
+		color.Default.Fprintf(out, "%s (hash %s): ", a.ImageName, shorten(hash))
+		c.cacheMutex.RLock()
+		entry, found := c.artifactCache[hash]
+		c.cacheMutex.RUnlock()
+		if found {
+			color.Green.Fprintf(out, "Found in cache (built %s ago)\n", age(entry.Created))
+		} else {
+			color.Yellow.Fprintln(out, "Not found in cache")
+		}
+
+		fmt.Fprintln(out, "Inputs:")
+		for _, in := range inputs {
+			fmt.Fprintf(out, " - %s\n", in)
+		}
+
+		if found {
+			fmt.Fprintln(out)
+			continue
+		}
+
+		previous, found := c.previousEntry(a.ImageName)
+		switch {
+		case !found:
+			fmt.Fprintf(out, "No previous build of %s in the cache\n", a.ImageName)
+		case len(previous.Inputs) == 0:
+			fmt.Fprintf(out, "The previous build of %s didn't record its inputs\n", a.ImageName)
+		default:
+			fmt.Fprintf(out, "Changes since the previous build (%s ago):\n", age(previous.Created))
+			printInputsDiff(out, previous.Inputs, inputs)
+		}
+		fmt.Fprintln(out)
+	}
+	return nil
+}
+
+// previousEntry returns the most recent cache entry for an image.
+func (c *cache) previousEntry(imageName string) (ImageDetails, bool) {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	var previous ImageDetails
+	found := false
+	for _, entry := range c.artifactCache {
+		if entry.ImageName == imageName && (!found || entry.Created.After(previous.Created)) {
+			previous = entry
+			found = true
+		}
+	}
+	return previous, found
+}
+
+// printInputsDiff prints the inputs that were removed, with a `-`, and added, with a `+`.
+func printInputsDiff(out io.Writer, before, after []string) {
+	inBefore := make(map[string]bool)
+	for _, in := range before {
+		inBefore[in] = true
+	}
+	inAfter := make(map[string]bool)
+	for _, in := range after {
+		inAfter[in] = true
+	}
+
+	changed := false
+	for _, in := range before {
+		if !inAfter[in] {
+			color.Red.Fprintf(out, " - %s\n", in)
+			changed = true
+		}
+	}
+	for _, in := range after {
+		if !inBefore[in] {
+			color.Green.Fprintf(out, " + %s\n", in)
+			changed = true
+		}
+	}
+	if !changed {
+		fmt.Fprintln(out, " (none)")
+	}
+}
+
+func readCacheFile(cacheFile string) (ArtifactCache, string, error) {
+	cacheFile, err := resolveCacheFile(cacheFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolving cache file: %w", err)
+	}
+	artifactCache, err := retrieveArtifactCache(cacheFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("reading cache file %q: %w", cacheFile, err)
+	}
+	return artifactCache, cacheFile, nil
+}
+
+func sortedHashes(artifactCache ArtifactCache) []string {
+	var hashes []string
+	for hash := range artifactCache {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		ei, ej := artifactCache[hashes[i]], artifactCache[hashes[j]]
+		if !ei.Created.Equal(ej.Created) {
+			return ei.Created.After(ej.Created)
+		}
+		return hashes[i] < hashes[j]
+	})
+	return hashes
+}
+
+func age(created time.Time) string {
+	if created.IsZero() {
+		return "unknown"
+	}
+	return now().Sub(created).Round(time.Second).String()
+}
+
+func shorten(s string) string {
+	s = strings.TrimPrefix(s, "sha256:")
+	if len(s) > 12 {
+		return s[:12]
+	}
+	return s
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var inspectNow = time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestList(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&now, func() time.Time { return inspectNow })
+		cacheFile := t.NewTempDir().Path("cache")
+		t.CheckNoError(saveArtifactCache(cacheFile, ArtifactCache{
+			"0123456789abcdef": {ImageName: "web", Digest: "sha256:abc", Created: inspectNow.Add(-2 * time.Hour)},
+			"fedcba9876543210": {ImageName: "db", ID: "sha256:0123456789abcdef", Created: inspectNow.Add(-time.Minute)},
+			"legacy":           {Digest: "sha256:def"},
+		}))
+
+		var out bytes.Buffer
+		err := List(&out, cacheFile)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`IMAGE   HASH          DIGEST      ID            AGE
+db      fedcba987654  <none>      0123456789ab  1m0s
+web     0123456789ab  sha256:abc  <none>        2h0m0s
+<none>  legacy        sha256:def  <none>        unknown
+`, out.String())
+	})
+}
+
+func TestPurge(t *testing.T) {
+	tests := []struct {
+		description    string
+		images         []string
+		all            bool
+		shouldErr      bool
+		expectedHashes []string
+	}{
+		{
+			description:    "purge one image",
+			images:         []string{"web"},
+			expectedHashes: []string{"hash3"},
+		},
+		{
+			description: "purge all",
+			all:         true,
+		},
+		{
+			description:    "unknown image",
+			images:         []string{"unknown"},
+			expectedHashes: []string{"hash1", "hash2", "hash3"},
+		},
+		{
+			description: "nothing to purge",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cacheFile := t.NewTempDir().Path("cache")
+			t.CheckNoError(saveArtifactCache(cacheFile, ArtifactCache{
+				"hash1": {ImageName: "web", Digest: "sha256:abc"},
+				"hash2": {ImageName: "web", Digest: "sha256:def"},
+				"hash3": {ImageName: "db", Digest: "sha256:ghi"},
+			}))
+
+			var out bytes.Buffer
+			err := Purge(&out, cacheFile, test.images, test.all)
+			t.CheckError(test.shouldErr, err)
+
+			artifactCache, err := retrieveArtifactCache(cacheFile)
+			t.CheckNoError(err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(len(test.expectedHashes), len(artifactCache))
+			for _, hash := range test.expectedHashes {
+				_, found := artifactCache[hash]
+				t.CheckTrue(found)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		description   string
+		artifactCache ArtifactCache
+		expected      []string
+	}{
+		{
+			description:   "found",
+			artifactCache: ArtifactCache{"thehash": {ImageName: "artifact", Created: inspectNow.Add(-time.Hour)}},
+			expected:      []string{"artifact (hash thehash): Found in cache (built 1h0m0s ago)", " - config: thehash"},
+		},
+		{
+			description:   "never built",
+			artifactCache: ArtifactCache{},
+			expected:      []string{"artifact (hash thehash): Not found in cache", "No previous build of artifact in the cache"},
+		},
+		{
+			description: "changed",
+			artifactCache: ArtifactCache{
+				"older":    {ImageName: "artifact", Created: inspectNow.Add(-2 * time.Hour), Inputs: []string{"config: older"}},
+				"previous": {ImageName: "artifact", Created: inspectNow.Add(-time.Hour), Inputs: []string{"config: previous"}},
+				"other":    {ImageName: "other", Created: inspectNow, Inputs: []string{"config: other"}},
+			},
+			expected: []string{"Changes since the previous build (1h0m0s ago):", " - config: previous", " + config: thehash"},
+		},
+		{
+			description:   "inputs not recorded",
+			artifactCache: ArtifactCache{"previous": {ImageName: "artifact", Created: inspectNow.Add(-time.Hour)}},
+			expected:      []string{"The previous build of artifact didn't record its inputs"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&now, func() time.Time { return inspectNow })
+			t.Override(&newArtifactHasherFunc, func(graph.ArtifactGraph, DependencyLister, config.RunMode) artifactHasher {
+				return mockHasher{"thehash"}
+			})
+			c := &cache{
+				artifactCache: test.artifactCache,
+				cfg:           &mockConfig{mode: config.RunModes.Dev},
+			}
+
+			var out bytes.Buffer
+			err := c.Explain(context.Background(), &out, []*latest_v1.Artifact{{ImageName: "artifact"}})
+
+			t.CheckNoError(err)
+			for _, line := range test.expected {
+				t.CheckContains(line+"\n", out.String())
+			}
+		})
+	}
+}
+
+func TestExplainNoCache(t *testing.T) {
+	err := (&noCache{}).Explain(context.Background(), &bytes.Buffer{}, nil)
+
+	testutil.CheckError(t, true, err)
+}
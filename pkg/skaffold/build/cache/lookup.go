@@ -34,6 +34,10 @@ func (c *cache) lookupArtifacts(ctx context.Context, tags tag.ImageTags, artifac
 	// Create a new `artifactHasher` on every new dev loop.
 	// This way every artifact hash is calculated at most once in a single dev loop, and recalculated on every dev loop.
 	h := newArtifactHasherFunc(c.artifactGraph, c.lister, c.cfg.Mode())
+	c.cacheMutex.Lock()
+	c.inputsByHash = make(map[string][]string)
+	c.cacheMutex.Unlock()
+
 	var wg sync.WaitGroup
 	for i := range artifacts {
 		wg.Add(1)
@@ -54,10 +58,19 @@ func (c *cache) lookup(ctx context.Context, a *latest_v1.Artifact, tag string, h
 	if err != nil {
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
+	// Keep track of the inputs, to record them in the cache entry if the artifact is built.
+	if inputs, err := h.inputs(ctx, a); err == nil {
+		c.cacheMutex.Lock()
+		c.inputsByHash[hash] = inputs
+		c.cacheMutex.Unlock()
+	}
 
 	c.cacheMutex.RLock()
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
+	if !cacheHit {
+		entry, cacheHit = c.lookupRemoteCache(ctx, a.ImageName, hash)
+	}
 	if !cacheHit {
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
 			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
@@ -122,8 +135,35 @@ func (c *cache) lookupRemote(ctx context.Context, hash, tag string, entry ImageD
 	return needsBuilding{hash: hash}
 }
 
+// lookupRemoteCache looks for an image built on another machine, in the artifact's remote cache.
+// Only images pushed to a registry are shared.
+func (c *cache) lookupRemoteCache(ctx context.Context, imageName, hash string) (ImageDetails, bool) {
+	rc := c.remoteCaches[imageName]
+	if rc == nil {
+		return ImageDetails{}, false
+	}
+	if isLocal, err := c.isLocalImage(imageName); err != nil || isLocal {
+		return ImageDetails{}, false
+	}
+
+	entry, err := rc.backend.Get(ctx, hash)
+	if err != nil {
+		logrus.Debugf("Could not find artifact %s in the remote cache (%s)", imageName, err)
+		return ImageDetails{}, false
+	}
+	if entry.Digest == "" {
+		return ImageDetails{}, false
+	}
+
+	entry = ImageDetails{Digest: entry.Digest, ImageName: imageName}
+	c.cacheMutex.Lock()
+	c.artifactCache[hash] = entry
+	c.cacheMutex.Unlock()
+	return entry, true
+}
+
 func (c *cache) tryImport(ctx context.Context, a *latest_v1.Artifact, tag string, hash string) (ImageDetails, error) {
-	entry := ImageDetails{}
+	entry := ImageDetails{ImageName: a.ImageName}
 
 	if importMissing, err := c.importMissingImage(a.ImageName); err != nil {
 		return entry, err
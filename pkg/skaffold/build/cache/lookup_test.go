@@ -234,6 +234,10 @@ func (m mockHasher) hash(context.Context, *latest_v1.Artifact) (string, error) {
 	return m.val, nil
 }
 
+func (m mockHasher) inputs(context.Context, *latest_v1.Artifact) ([]string, error) {
+	return []string{"config: " + m.val}, nil
+}
+
 type failingHasher struct {
 	err error
 }
@@ -242,6 +246,10 @@ func (f failingHasher) hash(context.Context, *latest_v1.Artifact) (string, error
 	return "", f.err
 }
 
+func (f failingHasher) inputs(context.Context, *latest_v1.Artifact) ([]string, error) {
+	return nil, f.err
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	remoteEntryMediaType types.MediaType = "application/vnd.dev.skaffold.cache.entry.v1+yaml"
+	digestAnnotation                     = "dev.skaffold.cache.digest"
+)
+
+// for testing
+var (
+	newRemoteBackend    = getRemoteBackend
+	authorizationHeader = docker.AuthorizationHeader
+)
+
+// errRemoteMiss is returned by remote backends that don't have an entry for a hash.
+var errRemoteMiss = errors.New("not found in remote cache")
+
+// remoteBackend stores cache entries on a storage shared across machines.
+type remoteBackend interface {
+	Get(ctx context.Context, hash string) (ImageDetails, error)
+	Put(ctx context.Context, hash string, entry ImageDetails) error
+}
+
+// remoteCache is the remote cache configured for a pipeline.
+type remoteCache struct {
+	backend  remoteBackend
+	readOnly bool
+}
+
+func newRemoteCache(cfg *latest_v1.RemoteCacheConfig, dockerCfg docker.Config) (*remoteCache, error) {
+	backend, err := newRemoteBackend(cfg, dockerCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteCache{backend: backend, readOnly: cfg.ReadOnly}, nil
+}
+
+func getRemoteBackend(cfg *latest_v1.RemoteCacheConfig, dockerCfg docker.Config) (remoteBackend, error) {
+	switch {
+	case cfg.HTTP != "":
+		u, err := url.Parse(cfg.HTTP)
+		if err != nil {
+			return nil, fmt.Errorf("parsing remote cache url %q: %w", cfg.HTTP, err)
+		}
+		return &httpBackend{url: u, client: http.DefaultClient}, nil
+	case cfg.Registry != "":
+		return &registryBackend{repository: cfg.Registry, cfg: dockerCfg}, nil
+	case cfg.Dir != "":
+		return &dirBackend{dir: cfg.Dir}, nil
+	default:
+		return nil, errors.New("remote cache requires one of `http`, `registry` or `dir`")
+	}
+}
+
+// httpBackend stores entries in a key/value store, as YAML documents, at `<url>/<hash>`.
+type httpBackend struct {
+	url    *url.URL
+	client *http.Client
+}
+
+func (b *httpBackend) Get(ctx context.Context, hash string) (ImageDetails, error) {
+	var entry ImageDetails
+
+	resp, err := b.do(ctx, http.MethodGet, hash, nil)
+	if err != nil {
+		return entry, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return entry, errRemoteMiss
+	default:
+		return entry, fmt.Errorf("getting %s: unexpected status %s", hash, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return entry, err
+	}
+	err = yaml.Unmarshal(body, &entry)
+	return entry, err
+}
+
+func (b *httpBackend) Put(ctx context.Context, hash string, entry ImageDetails) error {
+	body, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.do(ctx, http.MethodPut, hash, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("putting %s: unexpected status %s", hash, resp.Status)
+	}
+	return nil
+}
+
+func (b *httpBackend) do(ctx context.Context, method, hash string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(b.url.String(), "/")+"/"+hash, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := authorizationHeader(b.url.Host)
+	if err != nil {
+		return nil, fmt.Errorf("getting credentials for %s: %w", b.url.Host, err)
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/yaml")
+	}
+
+	return b.client.Do(req)
+}
+
+// registryBackend stores entries as `<repository>:<hash>` images, with the digest in an annotation.
+type registryBackend struct {
+	repository string
+	cfg        docker.Config
+}
+
+func (b *registryBackend) Get(_ context.Context, hash string) (ImageDetails, error) {
+	img, err := docker.RemoteImage(b.tag(hash), nil, b.cfg)
+	if err != nil {
+		return ImageDetails{}, fmt.Errorf("%w: %s", errRemoteMiss, err)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return ImageDetails{}, err
+	}
+
+	for _, layer := range manifest.Layers {
+		if digest := layer.Annotations[digestAnnotation]; digest != "" {
+			return ImageDetails{Digest: digest}, nil
+		}
+	}
+	return ImageDetails{}, errRemoteMiss
+}
+
+func (b *registryBackend) Put(_ context.Context, hash string, entry ImageDetails) error {
+	content, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	layer, err := docker.NewStaticLayer(content, remoteEntryMediaType)
+	if err != nil {
+		return err
+	}
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       layer,
+		Annotations: map[string]string{digestAnnotation: entry.Digest},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = docker.WriteRemoteImage(b.tag(hash), img, b.cfg)
+	return err
+}
+
+func (b *registryBackend) tag(hash string) string {
+	return b.repository + ":" + hash
+}
+
+// dirBackend stores entries as YAML files, one per hash, in a shared directory.
+type dirBackend struct {
+	dir string
+}
+
+func (b *dirBackend) Get(_ context.Context, hash string) (ImageDetails, error) {
+	var entry ImageDetails
+
+	content, err := ioutil.ReadFile(filepath.Join(b.dir, hash))
+	if os.IsNotExist(err) {
+		return entry, errRemoteMiss
+	}
+	if err != nil {
+		return entry, err
+	}
+
+	err = yaml.Unmarshal(content, &entry)
+	return entry, err
+}
+
+func (b *dirBackend) Put(_ context.Context, hash string, entry ImageDetails) error {
+	content, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(b.dir, 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see a partial entry.
+	tmp, err := ioutil.TempFile(b.dir, "."+hash)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(b.dir, hash))
+}
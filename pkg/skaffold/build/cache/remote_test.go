@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRemoteBackends(t *testing.T) {
+	tests := []struct {
+		description string
+		backend     func(t *testutil.T) remoteBackend
+	}{
+		{
+			description: "dir",
+			backend: func(t *testutil.T) remoteBackend {
+				return &dirBackend{dir: t.NewTempDir().Path("cache")}
+			},
+		},
+		{
+			description: "http",
+			backend: func(t *testutil.T) remoteBackend {
+				server := httptest.NewServer(&fakeKeyValueStore{entries: map[string][]byte{}})
+				t.Cleanup(server.Close)
+				t.Override(&authorizationHeader, func(string) (string, error) { return "Bearer TOKEN", nil })
+
+				backend, err := getRemoteBackend(&latest_v1.RemoteCacheConfig{HTTP: server.URL + "/skaffold"}, nil)
+				t.CheckNoError(err)
+				return backend
+			},
+		},
+		{
+			description: "registry",
+			backend: func(t *testutil.T) remoteBackend {
+				server := httptest.NewServer(registry.New())
+				t.Cleanup(server.Close)
+				host := strings.TrimPrefix(server.URL, "http://")
+				cfg := &mockConfig{RunContext: runcontext.RunContext{InsecureRegistries: map[string]bool{host: true}}}
+
+				backend, err := getRemoteBackend(&latest_v1.RemoteCacheConfig{Registry: host + "/skaffold-cache"}, cfg)
+				t.CheckNoError(err)
+				return backend
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			backend := test.backend(t)
+			ctx := context.Background()
+
+			_, err := backend.Get(ctx, "hash1")
+			t.CheckTrue(errors.Is(err, errRemoteMiss))
+
+			t.CheckNoError(backend.Put(ctx, "hash1", ImageDetails{Digest: "sha256:abc"}))
+			entry, err := backend.Get(ctx, "hash1")
+			t.CheckErrorAndDeepEqual(false, err, ImageDetails{Digest: "sha256:abc"}, entry)
+
+			t.CheckNoError(backend.Put(ctx, "hash1", ImageDetails{Digest: "sha256:def"}))
+			entry, err = backend.Get(ctx, "hash1")
+			t.CheckErrorAndDeepEqual(false, err, ImageDetails{Digest: "sha256:def"}, entry)
+		})
+	}
+}
+
+func TestGetRemoteBackendNoStorage(t *testing.T) {
+	_, err := getRemoteBackend(&latest_v1.RemoteCacheConfig{ReadOnly: true}, nil)
+
+	testutil.CheckError(t, true, err)
+}
+
+func TestCacheBuildRemoteCache(t *testing.T) {
+	tests := []struct {
+		description   string
+		readOnly      bool
+		remoteEntries map[string]ImageDetails
+		expectedBuilt int
+		expectedPuts  int
+	}{
+		{
+			description:   "miss: build and share",
+			expectedBuilt: 1,
+			expectedPuts:  1,
+		},
+		{
+			description:   "miss: build but don't share in read-only mode",
+			readOnly:      true,
+			expectedBuilt: 1,
+		},
+		{
+			description:   "hit: reuse the image built on another machine",
+			remoteEntries: map[string]ImageDetails{"thehash": {Digest: "sha256:51ae7fa00c92525c319404a3a6d400e52ff9372c5a39cb415e0486fe425f3165"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			tags := map[string]string{"artifact": "artifact:tag"}
+			artifacts := []*latest_v1.Artifact{{ImageName: "artifact", ArtifactType: latest_v1.ArtifactType{DockerArtifact: &latest_v1.DockerArtifact{}}}}
+
+			dockerDaemon := fakeLocalDaemon(&testutil.FakeAPIClient{})
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return dockerDaemon, nil
+			})
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			t.Override(&docker.RemoteDigest, func(ref string, _ docker.Config) (string, error) {
+				if ref == "artifact:tag" {
+					return "sha256:51ae7fa00c92525c319404a3a6d400e52ff9372c5a39cb415e0486fe425f3165", nil
+				}
+				return "", errors.New("unknown remote tag")
+			})
+			t.Override(&newArtifactHasherFunc, func(graph.ArtifactGraph, DependencyLister, config.RunMode) artifactHasher {
+				return mockHasher{"thehash"}
+			})
+			backend := &fakeRemoteBackend{entries: test.remoteEntries}
+			t.Override(&newRemoteBackend, func(*latest_v1.RemoteCacheConfig, docker.Config) (remoteBackend, error) {
+				return backend, nil
+			})
+
+			pipeline := latest_v1.Pipeline{Build: latest_v1.BuildConfig{
+				Artifacts:   artifacts,
+				RemoteCache: &latest_v1.RemoteCacheConfig{Dir: "shared", ReadOnly: test.readOnly},
+				BuildType:   latest_v1.BuildType{LocalBuild: &latest_v1.LocalBuild{}},
+			}}
+			cfg := &mockConfig{
+				RunContext: runcontext.RunContext{Pipelines: runcontext.NewPipelines([]latest_v1.Pipeline{pipeline})},
+				pipeline:   pipeline,
+				cacheFile:  tmpDir.Path("cache"),
+			}
+			artifactCache, err := NewCache(cfg, func(string) (bool, error) { return false, nil }, depLister(nil), graph.ToArtifactGraph(artifacts), make(mockArtifactStore))
+			t.CheckNoError(err)
+
+			builder := &mockBuilder{dockerDaemon: dockerDaemon, push: true}
+			bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(1, len(bRes))
+			t.CheckDeepEqual(test.expectedBuilt, len(builder.built))
+			t.CheckDeepEqual(test.expectedPuts, backend.puts)
+		})
+	}
+}
+
+type fakeRemoteBackend struct {
+	entries map[string]ImageDetails
+	puts    int
+}
+
+func (b *fakeRemoteBackend) Get(_ context.Context, hash string) (ImageDetails, error) {
+	if entry, found := b.entries[hash]; found {
+		return entry, nil
+	}
+	return ImageDetails{}, errRemoteMiss
+}
+
+func (b *fakeRemoteBackend) Put(_ context.Context, hash string, entry ImageDetails) error {
+	b.puts++
+	return nil
+}
+
+// fakeKeyValueStore is an in-memory HTTP key/value store that requires a bearer token.
+type fakeKeyValueStore struct {
+	entries map[string][]byte
+	lock    sync.Mutex
+}
+
+func (s *fakeKeyValueStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer TOKEN" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		content, found := s.entries[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(content)
+	case http.MethodPut:
+		content, _ := ioutil.ReadAll(r.Body)
+		s.entries[r.URL.Path] = content
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -133,6 +133,8 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		return append(bRes, alreadyBuilt...), nil
 	}
 
+	c.addToRemoteCaches(ctx, bRes, hashByName)
+
 	if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
 		logrus.Warnf("error saving cache file; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
@@ -158,7 +160,15 @@ func maintainArtifactOrder(built []graph.Artifact, artifacts []*latest_v1.Artifa
 
 func (c *cache) addArtifacts(ctx context.Context, bRes []graph.Artifact, hashByName map[string]string) error {
 	for _, a := range bRes {
-		entry := ImageDetails{}
+		hash := hashByName[a.ImageName]
+		c.cacheMutex.RLock()
+		entry := ImageDetails{
+			ImageName: a.ImageName,
+			Created:   time.Now(),
+			Inputs:    c.inputsByHash[hash],
+		}
+		c.cacheMutex.RUnlock()
+
 		isLocal, err := c.isLocalImage(a.ImageName)
 		if err != nil {
 			return err
@@ -180,8 +190,37 @@ func (c *cache) addArtifacts(ctx context.Context, bRes []graph.Artifact, hashByN
 			entry.Digest = ref.Digest
 		}
 		c.cacheMutex.Lock()
-		c.artifactCache[hashByName[a.ImageName]] = entry
+		// Only the most recent entry of an image keeps its inputs, so that the cache file doesn't grow with every build.
+		for h, e := range c.artifactCache {
+			if e.ImageName == entry.ImageName && len(e.Inputs) > 0 {
+				e.Inputs = nil
+				c.artifactCache[h] = e
+			}
+		}
+		c.artifactCache[hash] = entry
 		c.cacheMutex.Unlock()
 	}
 	return nil
 }
+
+// addToRemoteCaches shares the digests of the pushed images with the other users of the remote caches.
+func (c *cache) addToRemoteCaches(ctx context.Context, bRes []graph.Artifact, hashByName map[string]string) {
+	for _, a := range bRes {
+		rc := c.remoteCaches[a.ImageName]
+		if rc == nil || rc.readOnly {
+			continue
+		}
+
+		hash := hashByName[a.ImageName]
+		c.cacheMutex.RLock()
+		entry := c.artifactCache[hash]
+		c.cacheMutex.RUnlock()
+		if entry.Digest == "" {
+			continue
+		}
+
+		if err := rc.backend.Put(ctx, hash, ImageDetails{Digest: entry.Digest}); err != nil {
+			logrus.Warnf("error adding %s to the remote cache: %v", a.ImageName, err)
+		}
+	}
+}
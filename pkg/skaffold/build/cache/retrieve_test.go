@@ -176,6 +176,15 @@ func TestCacheBuildLocal(t *testing.T) {
 		t.CheckDeepEqual("artifact1", bRes[0].ImageName)
 		t.CheckDeepEqual("artifact2", bRes[1].ImageName)
 
+		// Only the most recent entry of an image keeps its inputs
+		entriesWithInputs := map[string]int{}
+		for _, entry := range artifactCache.(*cache).artifactCache {
+			if len(entry.Inputs) > 0 {
+				entriesWithInputs[entry.ImageName]++
+			}
+		}
+		t.CheckDeepEqual(map[string]int{"artifact1": 1, "artifact2": 1}, entriesWithInputs)
+
 		// Fourth build: change second artifact's dependency
 		// Artifacts should always be returned in their original order
 		tmpDir.Write("dep3", "new content")
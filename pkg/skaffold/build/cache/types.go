@@ -18,6 +18,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
@@ -29,6 +30,9 @@ type BuildAndTestFn func(context.Context, io.Writer, tag.ImageTags, []*latest_v1
 
 type Cache interface {
 	Build(context.Context, io.Writer, tag.ImageTags, []*latest_v1.Artifact, BuildAndTestFn) ([]graph.Artifact, error)
+
+	// Explain describes why artifacts need to be rebuilt.
+	Explain(context.Context, io.Writer, []*latest_v1.Artifact) error
 }
 
 type noCache struct{}
@@ -36,3 +40,7 @@ type noCache struct{}
 func (n *noCache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest_v1.Artifact, buildAndTest BuildAndTestFn) ([]graph.Artifact, error) {
 	return buildAndTest(ctx, out, tags, artifacts)
 }
+
+func (n *noCache) Explain(context.Context, io.Writer, []*latest_v1.Artifact) error {
+	return errors.New("the artifact cache is disabled")
+}
@@ -34,6 +34,7 @@ func TestRetrieveEnv(t *testing.T) {
 		tag           string
 		pushImages    bool
 		buildContext  string
+		platforms     []string
 		additionalEnv []string
 		environ       []string
 		expected      []string
@@ -62,6 +63,12 @@ func TestRetrieveEnv(t *testing.T) {
 			pushImages:    true,
 			additionalEnv: []string{"KUBECONTEXT=mycluster"},
 			expected:      []string{"IMAGE=gcr.io/image/push:tag", "PUSH_IMAGE=true", "BUILD_CONTEXT=", "IMAGE_REPO=gcr.io/image/push", "IMAGE_TAG=tag", "KUBECONTEXT=mycluster"},
+		}, {
+			description: "target platforms",
+			tag:         "gcr.io/image/push:tag",
+			pushImages:  true,
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			expected:    []string{"IMAGE=gcr.io/image/push:tag", "PUSH_IMAGE=true", "BUILD_CONTEXT=", "PLATFORMS=linux/amd64,linux/arm64", "IMAGE_REPO=gcr.io/image/push", "IMAGE_TAG=tag"},
 		},
 	}
 	for _, test := range tests {
@@ -70,7 +77,7 @@ func TestRetrieveEnv(t *testing.T) {
 			t.Override(&buildContext, func(string) (string, error) { return test.buildContext, nil })
 
 			builder := NewArtifactBuilder(nil, nil, test.pushImages, test.additionalEnv)
-			actual, err := builder.retrieveEnv(&latest_v1.Artifact{}, test.tag)
+			actual, err := builder.retrieveEnv(&latest_v1.Artifact{Platforms: test.platforms}, test.tag)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -45,6 +46,14 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest_v1.Artifac
 	}
 	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
 
+	if len(a.Platforms) > 0 {
+		digestOrImageID, err := b.dockerBuildx(ctx, color.GetWriter(out), a, dockerfile, opts)
+		if err != nil {
+			return "", newBuildError(err)
+		}
+		return digestOrImageID, nil
+	}
+
 	var imageID string
 
 	if b.useCLI || b.useBuildKit {
@@ -97,6 +106,46 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	return b.localDocker.ImageID(ctx, opts.Tag)
 }
 
+// dockerBuildx builds the artifact for its target platforms with `docker buildx`.
+// Multi-platform images can't be loaded into the local daemon, so they are pushed
+// as an image index and the digest of that index is returned.
+func (b *Builder) dockerBuildx(ctx context.Context, out io.Writer, a *latest_v1.Artifact, dockerfilePath string, opts docker.BuildOptions) (string, error) {
+	if !b.pushImages && len(a.Platforms) > 1 {
+		return "", multiPlatformNoPushErr(a.ImageName, a.Platforms)
+	}
+
+	args := []string{"buildx", "build", a.Workspace, "--file", dockerfilePath, "-t", opts.Tag, "--platform", strings.Join(a.Platforms, ",")}
+	ba, err := docker.EvalBuildArgs(b.mode, a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, opts.ExtraBuildArgs)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a.DockerArtifact, ba)
+	if err != nil {
+		return "", fmt.Errorf("getting docker build args: %w", err)
+	}
+	args = append(args, cliArgs...)
+
+	if b.pushImages {
+		args = append(args, "--push")
+	} else {
+		args = append(args, "--load")
+	}
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running buildx build: %w", err)
+	}
+
+	if b.pushImages {
+		return docker.RemoteDigest(opts.Tag, b.cfg)
+	}
+	return b.localDocker.ImageID(ctx, opts.Tag)
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest_v1.DockerArtifact) error {
 	if len(a.CacheFrom) == 0 {
 		return nil
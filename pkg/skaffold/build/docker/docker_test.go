@@ -106,7 +106,7 @@ func TestDockerCLIBuild(t *testing.T) {
 			}
 			t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
 
-			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, test.mode, nil, mockArtifactResolver{make(map[string]string)}, nil)
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), nil, test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, test.mode, nil, mockArtifactResolver{make(map[string]string)}, nil)
 
 			artifact := &latest_v1.Artifact{
 				Workspace: ".",
@@ -126,6 +126,67 @@ func TestDockerCLIBuild(t *testing.T) {
 	}
 }
 
+func TestDockerBuildx(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		pushImages  bool
+		expectedCmd string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single platform loaded into the local daemon",
+			platforms:   []string{"linux/arm64"},
+			expectedCmd: "docker buildx build . --file %s -t tag --platform linux/arm64 --load",
+			expected:    "sha256:local",
+		},
+		{
+			description: "multiple platforms pushed as an image index",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			pushImages:  true,
+			expectedCmd: "docker buildx build . --file %s -t tag --platform linux/amd64,linux/arm64 --push",
+			expected:    "sha256:index",
+		},
+		{
+			description: "multiple platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) {
+				return "sha256:index", nil
+			})
+			mockCmd := testutil.CmdRun(fmt.Sprintf(test.expectedCmd, dockerfilePath))
+			t.Override(&util.DefaultExecCommand, mockCmd)
+
+			localDocker := docker.NewLocalDaemon((&testutil.FakeAPIClient{}).Add("tag", "sha256:local"), nil, false, nil)
+			builder := NewArtifactBuilder(localDocker, nil, false, false, test.pushImages, false, config.RunModes.Build, nil, mockArtifactResolver{make(map[string]string)}, nil)
+
+			artifact := &latest_v1.Artifact{
+				Workspace: ".",
+				Platforms: test.platforms,
+				ArtifactType: latest_v1.ArtifactType{
+					DockerArtifact: &latest_v1.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+			}
+
+			digest, err := builder.Build(context.Background(), ioutil.Discard, artifact, "tag")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, digest)
+		})
+	}
+}
+
 func fakeLocalDaemonWithExtraEnv(extraEnv []string) docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, extraEnv, false, nil)
 }
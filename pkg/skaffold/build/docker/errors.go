@@ -123,3 +123,11 @@ func cacheFromPullErr(err error, artifact string) error {
 			},
 		})
 }
+
+func multiPlatformNoPushErr(artifact string, platforms []string) error {
+	return sErrors.NewError(fmt.Errorf("cannot build %q for multiple platforms %v without pushing", artifact, platforms),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("building artifact %s for platforms %v requires pushing the resulting image index", artifact, platforms),
+			ErrCode: proto.StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR,
+		})
+}
@@ -27,6 +27,7 @@ import (
 // Builder is an artifact builder that uses docker
 type Builder struct {
 	localDocker        docker.LocalDaemon
+	cfg                docker.Config
 	pushImages         bool
 	prune              bool
 	useCLI             bool
@@ -48,9 +49,10 @@ type TransitiveSourceDependenciesResolver interface {
 }
 
 // NewBuilder returns an new instance of a docker builder
-func NewArtifactBuilder(localDocker docker.LocalDaemon, useCLI, useBuildKit, pushImages, prune bool, mode config.RunMode, insecureRegistries map[string]bool, ar ArtifactResolver, dr TransitiveSourceDependenciesResolver) *Builder {
+func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, useCLI, useBuildKit, pushImages, prune bool, mode config.RunMode, insecureRegistries map[string]bool, ar ArtifactResolver, dr TransitiveSourceDependenciesResolver) *Builder {
 	return &Builder{
 		localDocker:        localDocker,
+		cfg:                cfg,
 		pushImages:         pushImages,
 		prune:              prune,
 		useCLI:             useCLI,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -43,8 +44,8 @@ const MinimumJibGradleVersionForSync = "2.0.0"
 // GradleCommand stores Gradle executable and wrapper name
 var GradleCommand = util.CommandWrapper{Executable: "gradle", Wrapper: "gradlew"}
 
-func (b *Builder) buildJibGradleToDocker(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
-	args := GenerateGradleBuildArgs("jibDockerBuild", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+func (b *Builder) buildJibGradleToDocker(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, platforms []string, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
+	args := GenerateGradleBuildArgs("jibDockerBuild", tag, artifact, platforms, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runGradleCommand(ctx, out, workspace, args); err != nil {
 		return "", jibToolErr(err)
 	}
@@ -52,8 +53,8 @@ func (b *Builder) buildJibGradleToDocker(ctx context.Context, out io.Writer, wor
 	return b.localDocker.ImageID(ctx, tag)
 }
 
-func (b *Builder) buildJibGradleToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
-	args := GenerateGradleBuildArgs("jib", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+func (b *Builder) buildJibGradleToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, platforms []string, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
+	args := GenerateGradleBuildArgs("jib", tag, artifact, platforms, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runGradleCommand(ctx, out, workspace, args); err != nil {
 		return "", jibToolErr(err)
 	}
@@ -98,7 +99,7 @@ func getSyncMapCommandGradle(ctx context.Context, workspace string, a *latest_v1
 }
 
 // GenerateGradleBuildArgs generates the arguments to Gradle for building the project as an image.
-func GenerateGradleBuildArgs(task string, imageName string, a *latest_v1.JibArtifact, skipTests, pushImages bool, deps []*latest_v1.ArtifactDependency, r ArtifactResolver, insecureRegistries map[string]bool, showColors bool) []string {
+func GenerateGradleBuildArgs(task string, imageName string, a *latest_v1.JibArtifact, platforms []string, skipTests, pushImages bool, deps []*latest_v1.ArtifactDependency, r ArtifactResolver, insecureRegistries map[string]bool, showColors bool) []string {
 	args := gradleBuildArgsFunc(task, a, skipTests, showColors, MinimumJibGradleVersion)
 	if insecure, err := isOnInsecureRegistry(imageName, insecureRegistries); err == nil && insecure {
 		// jib doesn't support marking specific registries as insecure
@@ -107,6 +108,9 @@ func GenerateGradleBuildArgs(task string, imageName string, a *latest_v1.JibArti
 	if baseImg, found := baseImageArg(a, r, deps, pushImages); found {
 		args = append(args, baseImg)
 	}
+	if len(platforms) > 0 {
+		args = append(args, "-Djib.from.platforms="+strings.Join(platforms, ","))
+	}
 	args = append(args, "--image="+imageName)
 	return args
 }
@@ -345,6 +345,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 		in                 latest_v1.JibArtifact
 		deps               []*latest_v1.ArtifactDependency
 		image              string
+		platforms          []string
 		skipTests          bool
 		pushImages         bool
 		r                  ArtifactResolver
@@ -352,6 +353,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 		out                []string
 	}{
 		{description: "single module", image: "image", out: []string{"fake-gradleBuildArgs-for-testTask", "--image=image"}},
+		{description: "single module with platforms", image: "image", platforms: []string{"linux/amd64", "linux/arm64"}, out: []string{"fake-gradleBuildArgs-for-testTask", "-Djib.from.platforms=linux/amd64,linux/arm64", "--image=image"}},
 		{description: "single module without tests", image: "image", skipTests: true, out: []string{"fake-gradleBuildArgs-for-testTask-skipTests", "--image=image"}},
 		{description: "multi module", in: latest_v1.JibArtifact{Project: "project"}, image: "image", out: []string{"fake-gradleBuildArgs-for-project-for-testTask", "--image=image"}},
 		{description: "multi module without tests", in: latest_v1.JibArtifact{Project: "project"}, image: "image", skipTests: true, out: []string{"fake-gradleBuildArgs-for-project-for-testTask-skipTests", "--image=image"}},
@@ -395,7 +397,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&gradleBuildArgsFunc, getGradleBuildArgsFuncFake(t, MinimumJibGradleVersion))
-			command := GenerateGradleBuildArgs("testTask", test.image, &test.in, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
+			command := GenerateGradleBuildArgs("testTask", test.image, &test.in, test.platforms, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
 			t.CheckDeepEqual(test.out, command)
 		})
 	}
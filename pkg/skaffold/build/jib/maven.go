@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -43,8 +44,8 @@ const MinimumJibMavenVersionForSync = "2.0.0"
 // MavenCommand stores Maven executable and wrapper name
 var MavenCommand = util.CommandWrapper{Executable: "mvn", Wrapper: "mvnw"}
 
-func (b *Builder) buildJibMavenToDocker(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
-	args := GenerateMavenBuildArgs("dockerBuild", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+func (b *Builder) buildJibMavenToDocker(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, platforms []string, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
+	args := GenerateMavenBuildArgs("dockerBuild", tag, artifact, platforms, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runMavenCommand(ctx, out, workspace, args); err != nil {
 		return "", jibToolErr(err)
 	}
@@ -52,8 +53,8 @@ func (b *Builder) buildJibMavenToDocker(ctx context.Context, out io.Writer, work
 	return b.localDocker.ImageID(ctx, tag)
 }
 
-func (b *Builder) buildJibMavenToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
-	args := GenerateMavenBuildArgs("build", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+func (b *Builder) buildJibMavenToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest_v1.JibArtifact, platforms []string, deps []*latest_v1.ArtifactDependency, tag string) (string, error) {
+	args := GenerateMavenBuildArgs("build", tag, artifact, platforms, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runMavenCommand(ctx, out, workspace, args); err != nil {
 		return "", jibToolErr(err)
 	}
@@ -99,7 +100,7 @@ func getSyncMapCommandMaven(ctx context.Context, workspace string, a *latest_v1.
 }
 
 // GenerateMavenBuildArgs generates the arguments to Maven for building the project as an image.
-func GenerateMavenBuildArgs(goal string, imageName string, a *latest_v1.JibArtifact, skipTests, pushImages bool, deps []*latest_v1.ArtifactDependency, r ArtifactResolver, insecureRegistries map[string]bool, showColors bool) []string {
+func GenerateMavenBuildArgs(goal string, imageName string, a *latest_v1.JibArtifact, platforms []string, skipTests, pushImages bool, deps []*latest_v1.ArtifactDependency, r ArtifactResolver, insecureRegistries map[string]bool, showColors bool) []string {
 	args := mavenBuildArgsFunc(goal, a, skipTests, showColors, MinimumJibMavenVersion)
 	if insecure, err := isOnInsecureRegistry(imageName, insecureRegistries); err == nil && insecure {
 		// jib doesn't support marking specific registries as insecure
@@ -108,6 +109,9 @@ func GenerateMavenBuildArgs(goal string, imageName string, a *latest_v1.JibArtif
 	if baseImg, found := baseImageArg(a, r, deps, pushImages); found {
 		args = append(args, baseImg)
 	}
+	if len(platforms) > 0 {
+		args = append(args, "-Djib.from.platforms="+strings.Join(platforms, ","))
+	}
 	args = append(args, "-Dimage="+imageName)
 
 	return args
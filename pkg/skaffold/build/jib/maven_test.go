@@ -331,12 +331,14 @@ func TestGenerateMavenBuildArgs(t *testing.T) {
 		deps               []*latest_v1.ArtifactDependency
 		image              string
 		r                  ArtifactResolver
+		platforms          []string
 		skipTests          bool
 		pushImages         bool
 		insecureRegistries map[string]bool
 		out                []string
 	}{
 		{description: "single module", image: "image", out: []string{"fake-mavenBuildArgs-for-test-goal", "-Dimage=image"}},
+		{description: "single module with platforms", image: "image", platforms: []string{"linux/amd64", "linux/arm64"}, out: []string{"fake-mavenBuildArgs-for-test-goal", "-Djib.from.platforms=linux/amd64,linux/arm64", "-Dimage=image"}},
 		{description: "single module without tests", image: "image", skipTests: true, out: []string{"fake-mavenBuildArgs-for-test-goal-skipTests", "-Dimage=image"}},
 		{description: "multi module", a: latest_v1.JibArtifact{Project: "module"}, image: "image", out: []string{"fake-mavenBuildArgs-for-module-for-test-goal", "-Dimage=image"}},
 		{description: "multi module without tests", a: latest_v1.JibArtifact{Project: "module"}, image: "image", skipTests: true, out: []string{"fake-mavenBuildArgs-for-module-for-test-goal-skipTests", "-Dimage=image"}},
@@ -381,7 +383,7 @@ func TestGenerateMavenBuildArgs(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&mavenBuildArgsFunc, getMavenBuildArgsFuncFake(t, MinimumJibMavenVersion))
-			args := GenerateMavenBuildArgs("test-goal", test.image, &test.a, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
+			args := GenerateMavenBuildArgs("test-goal", test.image, &test.a, test.platforms, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
 			t.CheckDeepEqual(test.out, args)
 		})
 	}
@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// appDir is the directory where the Go binary is added in the image.
+	appDir = "/ko-app"
+
+	// dataPath is the directory where the content of the kodata directory is added in the image.
+	dataPath = "/var/run/ko"
+
+	// kodataDir is the directory, next to the main package, holding static files to add to the image.
+	kodataDir = "kodata"
+)
+
+// For testing
+var (
+	goBuildFunc      = goBuild
+	remoteImage      = docker.RemoteImage
+	writeRemoteImage = docker.WriteRemoteImage
+	writeRemoteIndex = docker.WriteRemoteIndex
+)
+
+// Build compiles the Go binary of the artifact and layers it on top of the base image.
+// Images built for more than one platform are pushed as an image index.
+func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest_v1.Artifact, tag string) (string, error) {
+	platforms, err := parsePlatforms(a.Platforms)
+	if err != nil {
+		return "", err
+	}
+
+	if len(platforms) > 1 {
+		if !b.pushImages {
+			return "", multiPlatformNoPushErr(a.ImageName, a.Platforms)
+		}
+		return b.buildIndex(ctx, out, a, tag, platforms)
+	}
+
+	var platform *v1.Platform
+	if len(platforms) == 1 {
+		platform = &platforms[0]
+	}
+	img, err := b.buildImage(ctx, out, a, platform)
+	if err != nil {
+		return "", err
+	}
+
+	if b.pushImages {
+		return writeRemoteImage(tag, img, b.cfg)
+	}
+	return b.loadImage(ctx, out, img, tag)
+}
+
+func (b *Builder) buildIndex(ctx context.Context, out io.Writer, a *latest_v1.Artifact, tag string, platforms []v1.Platform) (string, error) {
+	var idx v1.ImageIndex = empty.Index
+	for i := range platforms {
+		platform := platforms[i]
+		img, err := b.buildImage(ctx, out, a, &platform)
+		if err != nil {
+			return "", err
+		}
+		idx = mutate.AppendManifests(idx, mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: v1.Descriptor{Platform: &platform},
+		})
+	}
+
+	return writeRemoteIndex(tag, idx, b.cfg)
+}
+
+// buildImage compiles the Go binary for the platform of the base image and adds it,
+// along with the kodata directory, as a new layer on top of the base image.
+func (b *Builder) buildImage(ctx context.Context, out io.Writer, a *latest_v1.Artifact, platform *v1.Platform) (v1.Image, error) {
+	base, err := remoteImage(a.KoArtifact.BaseImage, platform, b.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting base image %q: %w", a.KoArtifact.BaseImage, err)
+	}
+	cf, err := base.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("getting config of base image %q: %w", a.KoArtifact.BaseImage, err)
+	}
+
+	target := v1.Platform{OS: cf.OS, Architecture: cf.Architecture}
+	if platform != nil {
+		target = *platform
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-ko")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binaryName, err := binaryName(a)
+	if err != nil {
+		return nil, err
+	}
+	binary := filepath.Join(tmpDir, binaryName)
+	if err := goBuildFunc(ctx, out, a, target, b.mode, binary); err != nil {
+		return nil, err
+	}
+
+	layer, err := newLayer(binary, filepath.Join(a.Workspace, a.KoArtifact.Main, kodataDir))
+	if err != nil {
+		return nil, fmt.Errorf("creating image layer: %w", err)
+	}
+
+	cf = cf.DeepCopy()
+	cf.OS = target.OS
+	cf.Architecture = target.Architecture
+	cf.Config.Entrypoint = []string{path.Join(appDir, binaryName)}
+	cf.Config.Cmd = nil
+	cf.Config.Env = append(cf.Config.Env, "KO_DATA_PATH="+dataPath)
+
+	img, err := mutate.ConfigFile(base, cf)
+	if err != nil {
+		return nil, fmt.Errorf("setting image config: %w", err)
+	}
+	return mutate.AppendLayers(img, layer)
+}
+
+// loadImage loads the image into the local Docker daemon and returns its image ID.
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, img v1.Image, tag string) (string, error) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	defer r.Close()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+
+	return b.localDocker.Load(ctx, out, r, tag)
+}
+
+// goBuild compiles the main package of the artifact for the given platform.
+func goBuild(ctx context.Context, out io.Writer, a *latest_v1.Artifact, platform v1.Platform, mode config.RunMode, output string) error {
+	args, err := goBuildArgs(a.KoArtifact, mode, output)
+	if err != nil {
+		return err
+	}
+	env, err := goBuildEnv(a.KoArtifact, platform)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = a.Workspace
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("building go binary for %q: %w", a.ImageName, err)
+	}
+	return nil
+}
+
+func goBuildArgs(a *latest_v1.KoArtifact, mode config.RunMode, output string) ([]string, error) {
+	args := []string{"build", "-o", output}
+	args = append(args, a.Flags...)
+
+	if len(a.Ldflags) > 0 {
+		var ldflags []string
+		for _, flag := range a.Ldflags {
+			expanded, err := util.ExpandEnvTemplate(flag, nil)
+			if err != nil {
+				return nil, fmt.Errorf("unable to evaluate ldflags %q: %w", flag, err)
+			}
+			ldflags = append(ldflags, expanded)
+		}
+		args = append(args, "-ldflags", strings.Join(ldflags, " "))
+	}
+
+	// disable optimizations and inlining so that the binary can be debugged with dlv
+	if mode == config.RunModes.Debug {
+		args = append(args, "-gcflags", "all=-N -l")
+	}
+
+	return append(args, a.Main), nil
+}
+
+func goBuildEnv(a *latest_v1.KoArtifact, platform v1.Platform) ([]string, error) {
+	env := append(util.OSEnviron(), "CGO_ENABLED=0", "GOOS="+platform.OS, "GOARCH="+platform.Architecture)
+	if platform.Architecture == "arm" && platform.Variant != "" {
+		env = append(env, "GOARM="+strings.TrimPrefix(platform.Variant, "v"))
+	}
+
+	userEnv, err := evalEnv(a)
+	if err != nil {
+		return nil, err
+	}
+	return append(env, userEnv...), nil
+}
+
+func evalEnv(a *latest_v1.KoArtifact) ([]string, error) {
+	var env []string
+	for _, kv := range a.Env {
+		expanded, err := util.ExpandEnvTemplate(kv, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to evaluate env variable %q: %w", kv, err)
+		}
+		env = append(env, expanded)
+	}
+	return env, nil
+}
+
+// GetBuildArgs returns the evaluated `go build` arguments and environment variables of the artifact.
+// Along with the target platforms, they determine the resulting binary.
+func GetBuildArgs(a *latest_v1.KoArtifact, mode config.RunMode) ([]string, error) {
+	args, err := goBuildArgs(a, mode, "")
+	if err != nil {
+		return nil, err
+	}
+	env, err := evalEnv(a)
+	if err != nil {
+		return nil, err
+	}
+	return append(args, env...), nil
+}
+
+// binaryName is the name of the Go binary, which is the name of the directory of its main package.
+func binaryName(a *latest_v1.Artifact) (string, error) {
+	mainDir, err := filepath.Abs(filepath.Join(a.Workspace, a.KoArtifact.Main))
+	if err != nil {
+		return "", fmt.Errorf("getting absolute path of main package %q: %w", a.KoArtifact.Main, err)
+	}
+	return filepath.Base(mainDir), nil
+}
+
+// newLayer creates an image layer holding the Go binary and the content of the kodata directory, if any.
+func newLayer(binary, kodata string) (v1.Layer, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	if err := addDirectory(tw, appDir); err != nil {
+		return nil, err
+	}
+	if err := addFile(tw, binary, path.Join(appDir, filepath.Base(binary)), 0755); err != nil {
+		return nil, err
+	}
+
+	if util.IsDir(kodata) {
+		if err := addDirectory(tw, dataPath); err != nil {
+			return nil, err
+		}
+		if err := filepath.Walk(kodata, func(p string, info os.FileInfo, err error) error {
+			if err != nil || p == kodata {
+				return err
+			}
+			rel, err := filepath.Rel(kodata, p)
+			if err != nil {
+				return err
+			}
+			target := path.Join(dataPath, filepath.ToSlash(rel))
+			if info.IsDir() {
+				return addDirectory(tw, target)
+			}
+			return addFile(tw, p, target, info.Mode().Perm())
+		}); err != nil {
+			return nil, fmt.Errorf("adding %q: %w", kodata, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	content := buf.Bytes()
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	})
+}
+
+func addDirectory(tw *tar.Writer, dir string) error {
+	return tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(dir, "/") + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0755,
+	})
+}
+
+func addFile(tw *tar.Writer, src, target string, mode os.FileMode) error {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(target, "/"),
+		Typeflag: tar.TypeReg,
+		Mode:     int64(mode),
+		Size:     int64(len(content)),
+	}); err != nil {
+		return err
+	}
+	_, err = tw.Write(content)
+	return err
+}
+
+// parsePlatforms parses platforms in the `os/arch[/variant]` form.
+func parsePlatforms(platforms []string) ([]v1.Platform, error) {
+	var parsed []v1.Platform
+	for _, p := range platforms {
+		parts := strings.Split(p, "/")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid platform %q, expected the form `os/arch[/variant]`", p)
+		}
+		platform := v1.Platform{OS: parts[0], Architecture: parts[1]}
+		if len(parts) == 3 {
+			platform.Variant = parts[2]
+		}
+		parsed = append(parsed, platform)
+	}
+	return parsed, nil
+}
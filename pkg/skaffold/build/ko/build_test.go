@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGoBuildArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest_v1.KoArtifact
+		mode        config.RunMode
+		env         []string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "default",
+			artifact:    latest_v1.KoArtifact{Main: "."},
+			mode:        config.RunModes.Dev,
+			expected:    []string{"build", "-o", "out", "."},
+		},
+		{
+			description: "flags and ldflags",
+			artifact:    latest_v1.KoArtifact{Main: "./cmd/app", Flags: []string{"-trimpath"}, Ldflags: []string{"-s", "-w", "-X main.version={{.VERSION}}"}},
+			mode:        config.RunModes.Build,
+			env:         []string{"VERSION=1.0"},
+			expected:    []string{"build", "-o", "out", "-trimpath", "-ldflags", "-s -w -X main.version=1.0", "./cmd/app"},
+		},
+		{
+			description: "debug",
+			artifact:    latest_v1.KoArtifact{Main: "."},
+			mode:        config.RunModes.Debug,
+			expected:    []string{"build", "-o", "out", "-gcflags", "all=-N -l", "."},
+		},
+		{
+			description: "invalid ldflags template",
+			artifact:    latest_v1.KoArtifact{Main: ".", Ldflags: []string{"{{"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return test.env })
+
+			args, err := goBuildArgs(&test.artifact, test.mode, "out")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, args)
+		})
+	}
+}
+
+func TestGoBuildEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest_v1.KoArtifact
+		platform    v1.Platform
+		expected    []string
+	}{
+		{
+			description: "platform",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm64"},
+			expected:    []string{"PATH=/bin", "CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm64"},
+		},
+		{
+			description: "arm variant",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			expected:    []string{"PATH=/bin", "CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"},
+		},
+		{
+			description: "user env",
+			artifact:    latest_v1.KoArtifact{Env: []string{"GOPRIVATE=example.com", "PREFIX={{.PATH}}"}},
+			platform:    v1.Platform{OS: "linux", Architecture: "amd64"},
+			expected:    []string{"PATH=/bin", "CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64", "GOPRIVATE=example.com", "PREFIX=/bin"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return []string{"PATH=/bin"} })
+
+			env, err := goBuildEnv(&test.artifact, test.platform)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, env)
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		description     string
+		platforms       []string
+		pushImages      bool
+		expectedTargets []v1.Platform
+		expectedIndex   bool
+		shouldErr       bool
+	}{
+		{
+			description:     "platform of the base image",
+			pushImages:      true,
+			expectedTargets: []v1.Platform{{OS: "linux", Architecture: "amd64"}},
+		},
+		{
+			description:     "single platform",
+			platforms:       []string{"linux/arm64"},
+			pushImages:      true,
+			expectedTargets: []v1.Platform{{OS: "linux", Architecture: "arm64"}},
+		},
+		{
+			description:     "multiple platforms",
+			platforms:       []string{"linux/amd64", "linux/arm/v7"},
+			pushImages:      true,
+			expectedTargets: []v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm", Variant: "v7"}},
+			expectedIndex:   true,
+		},
+		{
+			description: "multiple platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid platform",
+			platforms:   []string{"linux"},
+			pushImages:  true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("app/kodata/index.html", "hello")
+
+			var targets []v1.Platform
+			t.Override(&goBuildFunc, func(_ context.Context, _ io.Writer, _ *latest_v1.Artifact, platform v1.Platform, _ config.RunMode, output string) error {
+				targets = append(targets, platform)
+				return ioutil.WriteFile(output, []byte("binary"), 0755)
+			})
+			t.Override(&remoteImage, func(string, *v1.Platform, docker.Config) (v1.Image, error) {
+				img, err := random.Image(1024, 1)
+				if err != nil {
+					return nil, err
+				}
+				cf, err := img.ConfigFile()
+				if err != nil {
+					return nil, err
+				}
+				cf.OS = "linux"
+				cf.Architecture = "amd64"
+				return mutate.ConfigFile(img, cf)
+			})
+			var pushed v1.Image
+			t.Override(&writeRemoteImage, func(_ string, img v1.Image, _ docker.Config) (string, error) {
+				pushed = img
+				return "sha256:image", nil
+			})
+			var pushedIndex v1.ImageIndex
+			t.Override(&writeRemoteIndex, func(_ string, idx v1.ImageIndex, _ docker.Config) (string, error) {
+				pushedIndex = idx
+				return "sha256:index", nil
+			})
+
+			builder := NewArtifactBuilder(nil, nil, test.pushImages, config.RunModes.Build)
+			artifact := &latest_v1.Artifact{
+				ImageName: "app",
+				Workspace: tmpDir.Root(),
+				Platforms: test.platforms,
+				ArtifactType: latest_v1.ArtifactType{
+					KoArtifact: &latest_v1.KoArtifact{BaseImage: "base", Main: "app"},
+				},
+			}
+			digest, err := builder.Build(context.Background(), ioutil.Discard, artifact, "app:tag")
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(test.expectedTargets, targets)
+			if test.expectedIndex {
+				t.CheckDeepEqual("sha256:index", digest)
+				manifest, err := pushedIndex.IndexManifest()
+				t.CheckNoError(err)
+				t.CheckDeepEqual(len(test.expectedTargets), len(manifest.Manifests))
+				return
+			}
+
+			t.CheckDeepEqual("sha256:image", digest)
+			cf, err := pushed.ConfigFile()
+			t.CheckNoError(err)
+			t.CheckDeepEqual([]string{"/ko-app/app"}, cf.Config.Entrypoint)
+			t.CheckDeepEqual("KO_DATA_PATH=/var/run/ko", cf.Config.Env[len(cf.Config.Env)-1])
+			t.CheckDeepEqual(test.expectedTargets[0].Architecture, cf.Architecture)
+			layers, err := pushed.Layers()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(2, len(layers))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// goPackage holds the fields of `go list -json` used to find the source dependencies.
+type goPackage struct {
+	Dir      string
+	Standard bool
+	GoFiles  []string
+	CgoFiles []string
+}
+
+// GetDependencies finds the source dependencies for the given ko artifact: the Go files of
+// the main package and of all the packages it imports from the workspace, the module files
+// and the content of the kodata directory.
+// All paths are relative to the workspace.
+func GetDependencies(ctx context.Context, workspace string, a *latest_v1.KoArtifact) ([]string, error) {
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find absolute path for %q: %w", workspace, err)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "list", "-deps", "-json", a.Main)
+	cmd.Dir = workspace
+	stdout, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("listing go packages: %w", err)
+	}
+
+	var deps []string
+	decoder := json.NewDecoder(bytes.NewReader(stdout))
+	for {
+		var pkg goPackage
+		if err := decoder.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("parsing go packages: %w", err)
+		}
+		if pkg.Standard {
+			continue
+		}
+
+		rel, err := filepath.Rel(absWorkspace, pkg.Dir)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			// packages outside of the workspace come from the module cache
+			continue
+		}
+		for _, files := range [][]string{pkg.GoFiles, pkg.CgoFiles} {
+			for _, f := range files {
+				deps = append(deps, filepath.Join(rel, f))
+			}
+		}
+	}
+
+	for _, f := range []string{"go.mod", "go.sum"} {
+		if util.IsFile(filepath.Join(workspace, f)) {
+			deps = append(deps, f)
+		}
+	}
+
+	kodata := filepath.Join(a.Main, kodataDir)
+	if util.IsDir(filepath.Join(workspace, kodata)) {
+		if err := filepath.Walk(filepath.Join(workspace, kodata), func(p string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(workspace, p)
+			if err != nil {
+				return err
+			}
+			deps = append(deps, rel)
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("walking %q: %w", kodata, err)
+		}
+	}
+
+	return deps, nil
+}
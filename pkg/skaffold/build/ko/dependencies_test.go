@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		main        string
+		files       map[string]string
+		packages    []string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "main package with local and external dependencies",
+			main:        ".",
+			files: map[string]string{
+				"go.mod":          "module example.com/app",
+				"go.sum":          "",
+				"main.go":         "",
+				"pkg/lib/lib.go":  "",
+				"pkg/lib/cgo.go":  "",
+				"kodata/app.html": "",
+			},
+			packages: []string{
+				`{"Dir": "/usr/local/go/src/fmt", "Standard": true, "GoFiles": ["print.go"]}`,
+				`{"Dir": "/root/go/pkg/mod/github.com/pkg/errors@v0.9.1", "GoFiles": ["errors.go"]}`,
+				`{"Dir": "{{.Workspace}}/pkg/lib", "GoFiles": ["lib.go"], "CgoFiles": ["cgo.go"]}`,
+				`{"Dir": "{{.Workspace}}", "GoFiles": ["main.go"]}`,
+			},
+			expected: []string{filepath.Join("pkg", "lib", "lib.go"), filepath.Join("pkg", "lib", "cgo.go"), "main.go", "go.mod", "go.sum", filepath.Join("kodata", "app.html")},
+		},
+		{
+			description: "main package in a sub directory",
+			main:        "./cmd/app",
+			files: map[string]string{
+				"go.mod":                  "module example.com/app",
+				"cmd/app/main.go":         "",
+				"cmd/app/kodata/app.html": "",
+			},
+			packages: []string{
+				`{"Dir": "{{.Workspace}}/cmd/app", "GoFiles": ["main.go"]}`,
+			},
+			expected: []string{filepath.Join("cmd", "app", "main.go"), "go.mod", filepath.Join("cmd", "app", "kodata", "app.html")},
+		},
+		{
+			description: "invalid go list output",
+			main:        ".",
+			packages:    []string{"not json"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			for path, content := range test.files {
+				tmpDir.Write(path, content)
+			}
+			workspace := filepath.ToSlash(tmpDir.Root())
+			output := strings.ReplaceAll(strings.Join(test.packages, "\n"), "{{.Workspace}}", workspace)
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(fmt.Sprintf("go list -deps -json %s", test.main), output))
+
+			deps, err := GetDependencies(context.Background(), tmpDir.Root(), &latest_v1.KoArtifact{Main: test.main})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, deps)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func multiPlatformNoPushErr(artifact string, platforms []string) error {
+	return sErrors.NewError(fmt.Errorf("cannot build %q for multiple platforms %v without pushing", artifact, platforms),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("building artifact %s for platforms %v requires pushing the resulting image index", artifact, platforms),
+			ErrCode: proto.StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR,
+		})
+}
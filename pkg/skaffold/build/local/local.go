@@ -72,8 +72,9 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest_v1
 
 	if b.pushImages {
 		// only track images for pruning when building with docker
+		// multi-platform images built with buildx are pushed directly to the registry
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		if a.DockerArtifact != nil && len(a.Platforms) == 0 {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				logrus.Warnf("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -37,6 +37,9 @@ type ContextConfig struct {
 	KindDisableLoad      *bool         `yaml:"kind-disable-load,omitempty"`
 	K3dDisableLoad       *bool         `yaml:"k3d-disable-load,omitempty"`
 	CollectMetrics       *bool         `yaml:"collect-metrics,omitempty"`
+	// NativeKubeClient makes Skaffold talk to the Kubernetes API directly, instead of running `kubectl`,
+	// to sync files, port forward and stream logs.
+	NativeKubeClient *bool `yaml:"native-kube-client,omitempty"`
 }
 
 // SurveyConfig is the survey config information
@@ -53,6 +53,8 @@ type SkaffoldOptions struct {
 	AutoBuild             bool
 	AutoSync              bool
 	AutoDeploy            bool
+	AttachDebuggers       bool
+	IDELaunchConfigs      bool
 	RenderOnly            bool
 	AutoCreateConfig      bool
 	AssumeYes             bool
@@ -74,6 +76,7 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	TestReport         string
 	Trigger            string
 	KubeContext        string
 	KubeConfig         string
@@ -85,11 +88,13 @@ type SkaffoldOptions struct {
 	TargetImages       []string
 	Profiles           []string
 	InsecureRegistries []string
+	TestFilter         []string
 	Muted              Muted
 	Command            string
 	RPCPort            int
 	RPCHTTPPort        int
 	BuildConcurrency   int
+	TestConcurrency    int
 
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
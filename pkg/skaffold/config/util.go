@@ -304,6 +304,16 @@ func IsUpdateCheckEnabled(configfile string) bool {
 	return cfg == nil || cfg.UpdateCheck == nil || *cfg.UpdateCheck
 }
 
+// UseNativeKubeClient returns true if file sync, port forwarding and log streaming
+// should use the Kubernetes API directly rather than `kubectl`.
+func UseNativeKubeClient(configFile string) bool {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return false
+	}
+	return cfg != nil && cfg.NativeKubeClient != nil && *cfg.NativeKubeClient
+}
+
 func ShouldDisplayPrompt(configfile string) bool {
 	cfg, disabled := isSurveyPromptDisabled(configfile)
 	return !disabled && !recentlyPromptedOrTaken(cfg)
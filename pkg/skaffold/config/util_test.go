@@ -265,6 +265,46 @@ func TestIsUpdateCheckEnabled(t *testing.T) {
 	}
 }
 
+func TestUseNativeKubeClient(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         *ContextConfig
+		readErr     error
+		expected    bool
+	}{
+		{
+			description: "not set",
+			cfg:         &ContextConfig{},
+		},
+		{
+			description: "enabled",
+			cfg:         &ContextConfig{NativeKubeClient: util.BoolPtr(true)},
+			expected:    true,
+		},
+		{
+			description: "disabled",
+			cfg:         &ContextConfig{NativeKubeClient: util.BoolPtr(false)},
+		},
+		{
+			description: "config is nil",
+			cfg:         nil,
+		},
+		{
+			description: "config has err",
+			readErr:     fmt.Errorf("error while reading"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetConfigForCurrentKubectx, func(string) (*ContextConfig, error) { return test.cfg, test.readErr })
+
+			actual := UseNativeKubeClient("dummyconfig")
+
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
 type fakeClient struct{}
 
 func (fakeClient) IsMinikube(kubeContext string) bool        { return kubeContext == "minikube" }
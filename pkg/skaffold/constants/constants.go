@@ -28,8 +28,11 @@ const (
 	Init        = Phase("Init")
 	Build       = Phase("Build")
 	Test        = Phase("Test")
+	Render      = Phase("Render")
 	Deploy      = Phase("Deploy")
 	StatusCheck = Phase("StatusCheck")
+	Verify      = Phase("Verify") // the tests of the `verify` section, not the signature verification of `deploy`
+	Rollback    = Phase("Rollback")
 	PortForward = Phase("PortForward")
 	Sync        = Phase("Sync")
 	DevInit     = Phase("DevInit")
@@ -52,10 +55,17 @@ const (
 	DefaultKustomizationPath = "."
 
 	DefaultBusyboxImage = "gcr.io/k8s-skaffold/skaffold-helpers/busybox"
+	DefaultKoBaseImage  = "gcr.io/distroless/static:nonroot"
+
+	DefaultSBOMFormat = "spdx"
 
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/k8s-skaffold/skaffold-debug-support"
 
+	// DefaultSyncHelperImage is the image providing the static helper used by the `helper` sync transport.
+	// Its tag must match SYNC_HELPER_VERSION in the Makefile.
+	DefaultSyncHelperImage = "gcr.io/k8s-skaffold/skaffold-sync-helper:v1"
+
 	DefaultSkaffoldDir = ".skaffold"
 	DefaultCacheFile   = "cache"
 	DefaultMetricFile  = "metrics"
@@ -77,6 +87,8 @@ type Phase string
 var (
 	Pod     latest_v1.ResourceType = "pod"
 	Service latest_v1.ResourceType = "service"
+	// DockerContainer is the resource type of the containers of the local Docker daemon.
+	DockerContainer latest_v1.ResourceType = "docker"
 
 	DefaultLocalConcurrency = 1
 )
@@ -91,6 +103,9 @@ var (
 	// BuildContext is the absolute path to a directory this artifact is meant to be built from for custom artifacts
 	BuildContext = "BUILD_CONTEXT"
 
+	// Platforms is the comma-separated list of target platforms the custom build script is expected to build the image for
+	Platforms = "PLATFORMS"
+
 	// KubeContext is the expected kubecontext to build an artifact with a custom build script on cluster
 	KubeContext = "KUBE_CONTEXT"
 
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+)
+
+// EphemeralContainerPrefix prefixes the names of the ephemeral containers that attach debuggers.
+const EphemeralContainerPrefix = "skaffold-debug-"
+
+// attacher describes how a debugger attaches to a running process.  The support images hold
+// their files in `/duct-tape/<runtimeId>` and, since ephemeral containers share the target
+// container's process namespace, the target container's entrypoint is PID 1.
+type attacher struct {
+	portName    string
+	defaultPort int32
+	command     func(port int32) []string
+}
+
+// attachers are the runtimes that support attaching a debugger to a running process, keyed by runtime ID.
+var attachers = map[string]attacher{
+	"go": {
+		portName:    "dlv",
+		defaultPort: defaultDlvPort,
+		command: func(port int32) []string {
+			return []string{"/duct-tape/go/bin/dlv", "attach", "1", "--headless", "--continue", "--accept-multiclient",
+				fmt.Sprintf("--listen=:%d", port), fmt.Sprintf("--api-version=%d", defaultAPIVersion)}
+		},
+	},
+	"rust": {
+		portName:    "gdbserver",
+		defaultPort: defaultGdbserverPort,
+		command: func(port int32) []string {
+			return []string{"/duct-tape/rust/bin/gdbserver", "--attach", fmt.Sprintf(":%d", port), "1"}
+		},
+	},
+}
+
+// EphemeralDebugContainer returns an ephemeral container that attaches a debugger to the process of
+// a running container, without changing the pod, and the debug configuration describing the container.
+func EphemeralDebugContainer(ctx context.Context, pod *v1.Pod, container v1.Container, artifact string, registries manifest.Registries) (*v1.EphemeralContainer, ContainerDebugConfiguration, error) {
+	config, err := retrieveImageConfiguration(ctx, &graph.Artifact{ImageName: artifact, Tag: container.Image}, registries.InsecureRegistries)
+	if err != nil {
+		return nil, ContainerDebugConfiguration{}, err
+	}
+	return ephemeralDebugContainer(&pod.Spec, container, config, registries.DebugHelpersRegistry)
+}
+
+func ephemeralDebugContainer(podSpec *v1.PodSpec, container v1.Container, config imageConfiguration, debugHelpersRegistry string) (*v1.EphemeralContainer, ContainerDebugConfiguration, error) {
+	// detect the runtime with the usual transforms, on a copy of the container
+	identity := func(port int32) int32 { return port }
+	configuration, _, err := transformContainer(container.DeepCopy(), config, identity)
+	if err != nil {
+		return nil, ContainerDebugConfiguration{}, err
+	}
+
+	attacher, found := attachers[configuration.Runtime]
+	if !found {
+		return nil, ContainerDebugConfiguration{}, fmt.Errorf("runtime %q of container %q doesn't support attaching a debugger", configuration.Runtime, container.Name)
+	}
+	logrus.Infof("Attaching a %s debugger to %q", configuration.Runtime, container.Name)
+
+	port := allocatePort(podSpec, attacher.defaultPort)
+	ephemeral := &v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:    EphemeralContainerPrefix + container.Name,
+			Image:   fmt.Sprintf("%s/%s", debugHelpersRegistry, configuration.Runtime),
+			Command: attacher.command(port),
+			// attaching to a process requires ptrace
+			SecurityContext: &v1.SecurityContext{
+				Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}},
+			},
+		},
+		TargetContainerName: container.Name,
+	}
+
+	return ephemeral, ContainerDebugConfiguration{
+		Artifact:   config.artifact,
+		Runtime:    configuration.Runtime,
+		WorkingDir: config.workingDir,
+		Ports:      map[string]uint32{attacher.portName: uint32(port)},
+	}, nil
+}
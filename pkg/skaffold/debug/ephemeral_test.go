@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestEphemeralDebugContainer(t *testing.T) {
+	tests := []struct {
+		description       string
+		podSpec           v1.PodSpec
+		container         v1.Container
+		config            imageConfiguration
+		shouldErr         bool
+		expectedContainer *v1.EphemeralContainer
+		expectedConfig    ContainerDebugConfiguration
+	}{
+		{
+			description: "go",
+			container:   v1.Container{Name: "app", Image: "app:tag"},
+			config:      imageConfiguration{artifact: "app", env: map[string]string{"GOMAXPROCS": "1"}, entrypoint: []string{"/app"}, workingDir: "/"},
+			expectedContainer: &v1.EphemeralContainer{
+				EphemeralContainerCommon: v1.EphemeralContainerCommon{
+					Name:    "skaffold-debug-app",
+					Image:   "HELPERS/go",
+					Command: []string{"/duct-tape/go/bin/dlv", "attach", "1", "--headless", "--continue", "--accept-multiclient", "--listen=:56268", "--api-version=2"},
+					SecurityContext: &v1.SecurityContext{
+						Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}},
+					},
+				},
+				TargetContainerName: "app",
+			},
+			expectedConfig: ContainerDebugConfiguration{Artifact: "app", Runtime: "go", WorkingDir: "/", Ports: map[string]uint32{"dlv": 56268}},
+		},
+		{
+			description: "rust with port already in use",
+			podSpec:     v1.PodSpec{Containers: []v1.Container{{Name: "app", Ports: []v1.ContainerPort{{ContainerPort: 2345}}}}},
+			container:   v1.Container{Name: "app", Image: "app:tag"},
+			config:      imageConfiguration{artifact: "app", entrypoint: []string{"/app/target/debug/server"}},
+			expectedContainer: &v1.EphemeralContainer{
+				EphemeralContainerCommon: v1.EphemeralContainerCommon{
+					Name:    "skaffold-debug-app",
+					Image:   "HELPERS/rust",
+					Command: []string{"/duct-tape/rust/bin/gdbserver", "--attach", ":2346", "1"},
+					SecurityContext: &v1.SecurityContext{
+						Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}},
+					},
+				},
+				TargetContainerName: "app",
+			},
+			expectedConfig: ContainerDebugConfiguration{Artifact: "app", Runtime: "rust", Ports: map[string]uint32{"gdbserver": 2346}},
+		},
+		{
+			description: "runtime that can't be attached to",
+			container:   v1.Container{Name: "app", Image: "app:tag"},
+			config:      imageConfiguration{artifact: "app", env: map[string]string{"JAVA_VERSION": "8"}, entrypoint: []string{"java", "-jar", "app.jar"}},
+			shouldErr:   true,
+		},
+		{
+			description: "unknown runtime",
+			container:   v1.Container{Name: "app", Image: "app:tag"},
+			config:      imageConfiguration{artifact: "app", entrypoint: []string{"/app"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			container, config, err := ephemeralDebugContainer(&test.podSpec, test.container, test.config, "HELPERS")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedContainer, container)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedConfig, config)
+			}
+		})
+	}
+}
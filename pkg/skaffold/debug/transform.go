@@ -72,7 +72,7 @@ import (
 type ContainerDebugConfiguration struct {
 	// Artifact is the corresponding artifact's image name used in the skaffold.yaml
 	Artifact string `json:"artifact,omitempty"`
-	// Runtime represents the underlying language runtime (`go`, `jvm`, `nodejs`, `python`, `netcore`)
+	// Runtime represents the underlying language runtime (`go`, `jvm`, `nodejs`, `python`, `netcore`, `ruby`, `php`, `rust`)
 	Runtime string `json:"runtime,omitempty"`
 	// WorkingDir is the working directory in the image configuration; may be empty
 	WorkingDir string `json:"workingDir,omitempty"`
@@ -452,6 +452,9 @@ func isShDashC(cmd, arg string) bool {
 
 func performContainerTransform(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
 	logrus.Tracef("Examining container %q with config %v", container.Name, config)
+	if transform, found := customTransformers[config.artifact]; found && transform.IsApplicable(config) {
+		return transform.Apply(container, config, portAlloc)
+	}
 	for _, transform := range containerTransforms {
 		if transform.IsApplicable(config) {
 			return transform.Apply(container, config, portAlloc)
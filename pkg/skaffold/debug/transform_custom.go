@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// commandLinePlaceholder is the `command` argument that is replaced with the original command-line.
+const commandLinePlaceholder = "{{.CommandLine}}"
+
+// customTransformers holds the user-defined transformers of the artifacts, keyed by image name.
+// They're tried before the built-in transformers.
+var customTransformers = map[string]containerTransformer{}
+
+// customTransformer is a transformer declared in an artifact's `debug` configuration.
+type customTransformer struct {
+	spec         latest_v1.DebugTransformer
+	commandMatch *regexp.Regexp
+	envMatch     map[string]*regexp.Regexp
+}
+
+// templateData is the data available to the templates of a custom transformer.
+type templateData struct {
+	Ports map[string]int32
+}
+
+// Init registers the custom transformers declared by the artifacts.
+func Init(artifacts []*latest_v1.Artifact) error {
+	customTransformers = map[string]containerTransformer{}
+
+	for _, a := range artifacts {
+		if a.Debug == nil {
+			continue
+		}
+
+		t, err := newCustomTransformer(*a.Debug)
+		if err != nil {
+			return fmt.Errorf("debug transformer for %q: %w", a.ImageName, err)
+		}
+		customTransformers[a.ImageName] = t
+	}
+	return nil
+}
+
+func newCustomTransformer(spec latest_v1.DebugTransformer) (customTransformer, error) {
+	t := customTransformer{spec: spec, envMatch: map[string]*regexp.Regexp{}}
+
+	if spec.CommandMatch != "" {
+		re, err := regexp.Compile(spec.CommandMatch)
+		if err != nil {
+			return t, fmt.Errorf("invalid commandMatch %q: %w", spec.CommandMatch, err)
+		}
+		t.commandMatch = re
+	}
+	for name, pattern := range spec.EnvMatch {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return t, fmt.Errorf("invalid envMatch %q for %q: %w", pattern, name, err)
+		}
+		t.envMatch[name] = re
+	}
+	return t, nil
+}
+
+func (t customTransformer) IsApplicable(config imageConfiguration) bool {
+	for name, re := range t.envMatch {
+		if value, found := config.env[name]; found && re.MatchString(value) {
+			logrus.Infof("Artifact %q has %s runtime: env %q matches", config.artifact, t.spec.Runtime, name)
+			return true
+		}
+	}
+
+	if t.commandMatch == nil {
+		return false
+	}
+	commandLine := config.arguments
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		commandLine = config.entrypoint
+	}
+	return len(commandLine) > 0 && t.commandMatch.MatchString(strings.Join(commandLine, " "))
+}
+
+// Apply configures a container definition with the ports, environment variables and
+// command-line declared by the custom transformer.
+// Returns the debug configuration details, with the transformer's support image.
+func (t customTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for %s debugging", container.Name, t.spec.Runtime)
+
+	// allocate the ports in a stable order
+	var names []string
+	for name := range t.spec.Ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := templateData{Ports: map[string]int32{}}
+	ports := map[string]uint32{}
+	for _, name := range names {
+		port := portAlloc(t.spec.Ports[name])
+		data.Ports[name] = port
+		ports[name] = uint32(port)
+	}
+
+	if len(t.spec.Command) > 0 {
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+			commandLine, err := t.rewriteCommandLine(config.entrypoint, data)
+			if err != nil {
+				return ContainerDebugConfiguration{}, "", err
+			}
+			container.Command = commandLine
+
+		case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && len(config.arguments) > 0:
+			commandLine, err := t.rewriteCommandLine(config.arguments, data)
+			if err != nil {
+				return ContainerDebugConfiguration{}, "", err
+			}
+			container.Args = commandLine
+
+		default:
+			return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q has no command-line", container.Name)
+		}
+	}
+
+	var envNames []string
+	for name := range t.spec.Env {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+	for _, name := range envNames {
+		value, err := expandTemplate(t.spec.Env[name], data)
+		if err != nil {
+			return ContainerDebugConfiguration{}, "", err
+		}
+		container.Env = setEnvVar(container.Env, name, value)
+	}
+
+	for _, name := range names {
+		container.Ports = exposePort(container.Ports, name, data.Ports[name])
+	}
+
+	return ContainerDebugConfiguration{
+		Runtime: t.spec.Runtime,
+		Ports:   ports,
+	}, t.spec.Image, nil
+}
+
+// rewriteCommandLine expands the transformer's command template, splicing in the original command-line.
+func (t customTransformer) rewriteCommandLine(commandLine []string, data templateData) ([]string, error) {
+	var rewritten []string
+	for _, arg := range t.spec.Command {
+		if arg == commandLinePlaceholder {
+			rewritten = append(rewritten, commandLine...)
+			continue
+		}
+
+		expanded, err := expandTemplate(arg, data)
+		if err != nil {
+			return nil, err
+		}
+		rewritten = append(rewritten, expanded)
+	}
+	return rewritten, nil
+}
+
+func expandTemplate(text string, data templateData) (string, error) {
+	tmpl, err := template.New("debug").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parsing template %q: %w", text, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("expanding template %q: %w", text, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var myRuntime = latest_v1.DebugTransformer{
+	Runtime:      "myruntime",
+	CommandMatch: "^/opt/myruntime/bin/run",
+	EnvMatch:     map[string]string{"MYRUNTIME_MODE": "^(dev|test)$"},
+	Command:      []string{"/dbg/myruntime/debug", "--port={{.Ports.dap}}", "--", "{{.CommandLine}}"},
+	Env:          map[string]string{"MYRUNTIME_DEBUG": "port={{.Ports.dap}}", "MYRUNTIME_ADMIN": "{{.Ports.admin}}"},
+	Ports:        map[string]int32{"dap": 5005, "admin": 7000},
+	Image:        "myruntime",
+}
+
+func TestCustomTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "matching env",
+			source:      imageConfiguration{env: map[string]string{"MYRUNTIME_MODE": "dev"}},
+			result:      true,
+		},
+		{
+			description: "env not matching",
+			source:      imageConfiguration{env: map[string]string{"MYRUNTIME_MODE": "prod"}},
+			result:      false,
+		},
+		{
+			description: "matching entrypoint",
+			source:      imageConfiguration{entrypoint: []string{"/opt/myruntime/bin/run", "app"}},
+			result:      true,
+		},
+		{
+			description: "launcher entrypoint with matching arguments",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"/opt/myruntime/bin/run", "app"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "other entrypoint",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}, arguments: []string{"/opt/myruntime/bin/run"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			transformer, err := newCustomTransformer(myRuntime)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.result, transformer.IsApplicable(test.source))
+		})
+	}
+}
+
+func TestCustomTransformerApply(t *testing.T) {
+	tests := []struct {
+		description   string
+		spec          latest_v1.DebugTransformer
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+		image         string
+	}{
+		{
+			description:   "entrypoint",
+			spec:          myRuntime,
+			configuration: imageConfiguration{entrypoint: []string{"/opt/myruntime/bin/run", "app"}},
+
+			result: v1.Container{
+				Command: []string{"/dbg/myruntime/debug", "--port=5005", "--", "/opt/myruntime/bin/run", "app"},
+				Env: []v1.EnvVar{
+					{Name: "MYRUNTIME_ADMIN", Value: "7000"},
+					{Name: "MYRUNTIME_DEBUG", Value: "port=5005"},
+				},
+				Ports: []v1.ContainerPort{{Name: "admin", ContainerPort: 7000}, {Name: "dap", ContainerPort: 5005}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "myruntime", Ports: map[string]uint32{"dap": 5005, "admin": 7000}},
+			image:       "myruntime",
+		},
+		{
+			description:   "arguments",
+			spec:          myRuntime,
+			configuration: imageConfiguration{arguments: []string{"/opt/myruntime/bin/run"}},
+
+			result: v1.Container{
+				Args: []string{"/dbg/myruntime/debug", "--port=5005", "--", "/opt/myruntime/bin/run"},
+				Env: []v1.EnvVar{
+					{Name: "MYRUNTIME_ADMIN", Value: "7000"},
+					{Name: "MYRUNTIME_DEBUG", Value: "port=5005"},
+				},
+				Ports: []v1.ContainerPort{{Name: "admin", ContainerPort: 7000}, {Name: "dap", ContainerPort: 5005}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "myruntime", Ports: map[string]uint32{"dap": 5005, "admin": 7000}},
+			image:       "myruntime",
+		},
+		{
+			description:   "env only",
+			spec:          latest_v1.DebugTransformer{Runtime: "myruntime", Env: map[string]string{"MYRUNTIME_DEBUG": "true"}},
+			configuration: imageConfiguration{entrypoint: []string{"/opt/myruntime/bin/run"}},
+
+			result:      v1.Container{Env: []v1.EnvVar{{Name: "MYRUNTIME_DEBUG", Value: "true"}}},
+			debugConfig: ContainerDebugConfiguration{Runtime: "myruntime", Ports: map[string]uint32{}},
+		},
+		{
+			description:   "no command-line",
+			spec:          myRuntime,
+			configuration: imageConfiguration{env: map[string]string{"MYRUNTIME_MODE": "dev"}},
+			shouldErr:     true,
+		},
+		{
+			description:   "unknown port",
+			spec:          latest_v1.DebugTransformer{Runtime: "myruntime", Env: map[string]string{"MYRUNTIME_DEBUG": "{{.Ports.unknown}}"}},
+			configuration: imageConfiguration{},
+			shouldErr:     true,
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			transformer, err := newCustomTransformer(test.spec)
+			t.CheckNoError(err)
+
+			config, image, err := transformer.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.result, test.containerSpec)
+				t.CheckDeepEqual(test.debugConfig, config)
+				t.CheckDeepEqual(test.image, image)
+			}
+		})
+	}
+}
+
+func TestCustomTransformerPrecedence(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&customTransformers, map[string]containerTransformer{})
+		err := Init([]*latest_v1.Artifact{
+			{ImageName: "custom", Debug: &latest_v1.DebugTransformer{Runtime: "myruntime", EnvMatch: map[string]string{"GOGC": ".*"}}},
+			{ImageName: "builtin"},
+		})
+		t.CheckNoError(err)
+
+		var identity portAllocator = func(port int32) int32 { return port }
+		env := map[string]string{"GOGC": "off"}
+
+		config, _, err := performContainerTransform(&v1.Container{Args: []string{"app"}}, imageConfiguration{artifact: "custom", env: env, arguments: []string{"app"}}, identity)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("myruntime", config.Runtime)
+
+		config, _, err = performContainerTransform(&v1.Container{Args: []string{"app"}}, imageConfiguration{artifact: "builtin", env: env, arguments: []string{"app"}}, identity)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("go", config.Runtime)
+	})
+}
+
+func TestInitInvalidRegex(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&customTransformers, map[string]containerTransformer{})
+		err := Init([]*latest_v1.Artifact{{ImageName: "custom", Debug: &latest_v1.DebugTransformer{Runtime: "myruntime", CommandMatch: "run("}}})
+
+		t.CheckError(true, err)
+	})
+}
@@ -66,6 +66,12 @@ func (t dlvTransformer) IsApplicable(config imageConfiguration) bool {
 		}
 	}
 
+	// images built by ko hold a Go binary and define KO_DATA_PATH
+	if _, found := config.env["KO_DATA_PATH"]; found {
+		logrus.Infof("Artifact %q has Go runtime: built by ko", config.artifact)
+		return true
+	}
+
 	// FIXME: as there is currently no way to identify a buildpacks-produced image as holding a Go binary,
 	// nor to cause certain environment variables to be defined in the resulting image, look at the image's
 	// CNB metadata to see if any well-known Go-related buildpacks had been involved.
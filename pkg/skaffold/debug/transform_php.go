@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type phpTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, phpTransformer{})
+}
+
+const (
+	// Xdebug 3 connects to port 9003 by default
+	defaultXdebugPort = 9003
+)
+
+// isLaunchingPHP determines if the arguments seems to be invoking php or php-fpm
+func isLaunchingPHP(args []string) bool {
+	return len(args) > 0 &&
+		(args[0] == "php" || strings.HasSuffix(args[0], "/php") ||
+			args[0] == "php-fpm" || strings.HasSuffix(args[0], "/php-fpm") ||
+			args[0] == "apache2-foreground" || strings.HasSuffix(args[0], "/apache2-foreground"))
+}
+
+func (t phpTransformer) IsApplicable(config imageConfiguration) bool {
+	// The official php images define these variables
+	for _, name := range []string{"PHP_VERSION", "PHP_INI_DIR"} {
+		if _, found := config.env[name]; found {
+			logrus.Infof("Artifact %q has PHP runtime: has env %q", config.artifact, name)
+			return true
+		}
+	}
+
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingPHP(config.entrypoint)
+	}
+	return isLaunchingPHP(config.arguments)
+}
+
+// Apply configures a container definition for PHP with Xdebug.  Xdebug is enabled through
+// `PHP_INI_SCAN_DIR`, which adds the `xdebug.ini` installed by the support image, and is
+// configured through the `XDEBUG_MODE` and `XDEBUG_CONFIG` environment variables.
+// Xdebug connects to the debugger, so the recorded port is the one the IDE listens on.
+// Skaffold doesn't tunnel this connection back from the pod: the IDE's address must be set
+// with `client_host` in `XDEBUG_CONFIG`, or Xdebug connects to the pod itself.
+// There is no support image: the image provides Xdebug, optionally with its configuration under `/dbg/php/conf.d`.
+// Returns the debug configuration details.
+func (t phpTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for PHP debugging", container.Name)
+
+	// Xdebug may have already been configured
+	if mode, found := config.env["XDEBUG_MODE"]; found && strings.Contains(mode, "debug") {
+		port := xdebugClientPort(config.env["XDEBUG_CONFIG"])
+		warnMissingXdebugClientHost(container.Name, config.env["XDEBUG_CONFIG"])
+		return ContainerDebugConfiguration{
+			Runtime: "php",
+			Ports:   map[string]uint32{"dbgp": uint32(port)},
+		}, "", nil
+	}
+
+	port := portAlloc(defaultXdebugPort)
+
+	// the default scan dir is compiled in and is only kept if listed as an empty entry
+	scanDir := ":/dbg/php/conf.d"
+	if existing, found := config.env["PHP_INI_SCAN_DIR"]; found {
+		scanDir = existing + ":/dbg/php/conf.d"
+	}
+	xdebugConfig := fmt.Sprintf("client_port=%d start_with_request=yes", port)
+	if existing, found := config.env["XDEBUG_CONFIG"]; found {
+		xdebugConfig = existing + " " + xdebugConfig
+	}
+	warnMissingXdebugClientHost(container.Name, xdebugConfig)
+
+	container.Env = setEnvVar(container.Env, "PHP_INI_SCAN_DIR", scanDir)
+	container.Env = setEnvVar(container.Env, "XDEBUG_MODE", "debug")
+	container.Env = setEnvVar(container.Env, "XDEBUG_CONFIG", xdebugConfig)
+
+	return ContainerDebugConfiguration{
+		Runtime: "php",
+		Ports:   map[string]uint32{"dbgp": uint32(port)},
+	}, "", nil
+}
+
+// warnMissingXdebugClientHost warns that Xdebug can't reach the IDE when `client_host` isn't set,
+// as it then defaults to `localhost`, which is the pod itself.
+func warnMissingXdebugClientHost(containerName, xdebugConfig string) {
+	if _, found := xdebugSetting(xdebugConfig, "client_host"); !found {
+		logrus.Warnf("Xdebug in container %q connects to localhost: set `client_host` in the XDEBUG_CONFIG environment variable to the address of the IDE, as seen from the pod", containerName)
+	}
+}
+
+// xdebugClientPort returns the `client_port` set in an `XDEBUG_CONFIG` value.
+func xdebugClientPort(xdebugConfig string) int32 {
+	if value, found := xdebugSetting(xdebugConfig, "client_port"); found {
+		if port, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return int32(port)
+		}
+	}
+	return defaultXdebugPort
+}
+
+// xdebugSetting returns the value of a setting in an `XDEBUG_CONFIG` value.
+func xdebugSetting(xdebugConfig, name string) (string, bool) {
+	for _, setting := range strings.Fields(xdebugConfig) {
+		if kv := strings.SplitN(setting, "=", 2); len(kv) == 2 && kv[0] == name {
+			return kv[1], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPHPTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "PHP_VERSION",
+			source:      imageConfiguration{env: map[string]string{"PHP_VERSION": "8.0.7"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint php",
+			source:      imageConfiguration{entrypoint: []string{"php", "server.php"}},
+			result:      true,
+		},
+		{
+			description: "launcher entrypoint with php-fpm",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"php-fpm"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := phpTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestPHPTransformerApply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+		image         string
+	}{
+		{
+			description:   "basic",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"PHP_VERSION": "8.0.7"}},
+
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "PHP_INI_SCAN_DIR", Value: ":/dbg/php/conf.d"},
+					{Name: "XDEBUG_MODE", Value: "debug"},
+					{Name: "XDEBUG_CONFIG", Value: "client_port=9003 start_with_request=yes"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9003}},
+		},
+		{
+			description:   "existing PHP_INI_SCAN_DIR and XDEBUG_CONFIG",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"PHP_INI_SCAN_DIR": "/usr/local/etc/php/conf.d", "XDEBUG_CONFIG": "client_host=10.0.0.1"}},
+
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "PHP_INI_SCAN_DIR", Value: "/usr/local/etc/php/conf.d:/dbg/php/conf.d"},
+					{Name: "XDEBUG_MODE", Value: "debug"},
+					{Name: "XDEBUG_CONFIG", Value: "client_host=10.0.0.1 client_port=9003 start_with_request=yes"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9003}},
+		},
+		{
+			description:   "already configured",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"XDEBUG_MODE": "develop,debug", "XDEBUG_CONFIG": "client_port=9000"}},
+
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := phpTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual(test.image, image)
+		})
+	}
+}
+
+func TestXdebugSetting(t *testing.T) {
+	tests := []struct {
+		description  string
+		xdebugConfig string
+		name         string
+		value        string
+		found        bool
+	}{
+		{description: "empty", name: "client_host"},
+		{description: "client_host", xdebugConfig: "client_host=10.0.0.1 client_port=9000", name: "client_host", value: "10.0.0.1", found: true},
+		{description: "client_port", xdebugConfig: "client_host=10.0.0.1 client_port=9000", name: "client_port", value: "9000", found: true},
+		{description: "missing", xdebugConfig: "client_port=9000", name: "client_host"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			value, found := xdebugSetting(test.xdebugConfig, test.name)
+
+			t.CheckDeepEqual(test.value, value)
+			t.CheckDeepEqual(test.found, found)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type rubyTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rubyTransformer{})
+}
+
+const (
+	// rdbg has no default port, 12345 is used in its documentation
+	defaultRdbgPort = 12345
+)
+
+// isLaunchingRuby determines if the arguments seems to be invoking ruby or a ruby launcher
+func isLaunchingRuby(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for _, name := range []string{"ruby", "bundle", "rails", "rackup", "puma", "rdbg"} {
+		if args[0] == name || strings.HasSuffix(args[0], "/"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t rubyTransformer) IsApplicable(config imageConfiguration) bool {
+	// The environment of the official ruby images isn't enough: loading the debugger
+	// fails when the `debug` gem isn't installed, so only apps launched with Ruby are transformed.
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingRuby(config.entrypoint)
+	}
+	return isLaunchingRuby(config.arguments)
+}
+
+// Apply configures a container definition for Ruby with the `debug` gem.  The debugger is
+// loaded through `RUBYOPT` and configured through environment variables, so that it's also
+// loaded when the app is started through a launcher like `bundle` or `rails`.
+// There is no support image: the `debug` gem is either installed in the image or under `/dbg/ruby`.
+// Returns the debug configuration details.
+func (t rubyTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Ruby debugging", container.Name)
+
+	// the debugger may have already been configured
+	if value, found := config.env["RUBY_DEBUG_PORT"]; found {
+		if port, err := strconv.ParseInt(value, 10, 32); err == nil {
+			container.Ports = exposePort(container.Ports, "dap", int32(port))
+			return ContainerDebugConfiguration{
+				Runtime: "ruby",
+				Ports:   map[string]uint32{"dap": uint32(port)},
+			}, "", nil
+		}
+		logrus.Warnf("Ignoring invalid RUBY_DEBUG_PORT %q", value)
+	}
+
+	port := portAlloc(defaultRdbgPort)
+
+	rubyOpt := "-rdebug/open_nonstop"
+	if existing, found := config.env["RUBYOPT"]; found {
+		rubyOpt = existing + " " + rubyOpt
+	}
+	gemPath := "/dbg/ruby"
+	if existing, found := config.env["GEM_PATH"]; found {
+		gemPath = gemPath + ":" + existing
+	} else if gemHome, found := config.env["GEM_HOME"]; found {
+		// GEM_PATH replaces the default gem locations
+		gemPath = gemPath + ":" + gemHome
+	}
+
+	container.Env = setEnvVar(container.Env, "RUBYOPT", rubyOpt)
+	container.Env = setEnvVar(container.Env, "GEM_PATH", gemPath)
+	container.Env = setEnvVar(container.Env, "RUBY_DEBUG_HOST", "0.0.0.0")
+	container.Env = setEnvVar(container.Env, "RUBY_DEBUG_PORT", strconv.FormatInt(int64(port), 10))
+	container.Ports = exposePort(container.Ports, "dap", port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "ruby",
+		Ports:   map[string]uint32{"dap": uint32(port)},
+	}, "", nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRubyTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "official ruby image without ruby command-line",
+			source:      imageConfiguration{env: map[string]string{"RUBY_VERSION": "3.0.1", "GEM_HOME": "/usr/local/bundle"}, entrypoint: []string{"irb"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint ruby",
+			source:      imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint /usr/local/bin/bundle",
+			source:      imageConfiguration{entrypoint: []string{"/usr/local/bin/bundle", "exec", "puma"}},
+			result:      true,
+		},
+		{
+			description: "launcher entrypoint with rails",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"rails", "server"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}, arguments: []string{"ruby", "app.rb"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := rubyTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRubyTransformerApply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+		image         string
+	}{
+		{
+			description:   "basic",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "RUBYOPT", Value: "-rdebug/open_nonstop"},
+					{Name: "GEM_PATH", Value: "/dbg/ruby"},
+					{Name: "RUBY_DEBUG_HOST", Value: "0.0.0.0"},
+					{Name: "RUBY_DEBUG_PORT", Value: "12345"},
+				},
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "existing RUBYOPT and GEM_HOME",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"RUBYOPT": "-W0", "GEM_HOME": "/usr/local/bundle"}},
+
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "RUBYOPT", Value: "-W0 -rdebug/open_nonstop"},
+					{Name: "GEM_PATH", Value: "/dbg/ruby:/usr/local/bundle"},
+					{Name: "RUBY_DEBUG_HOST", Value: "0.0.0.0"},
+					{Name: "RUBY_DEBUG_PORT", Value: "12345"},
+				},
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "already configured",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"RUBY_DEBUG_PORT": "4000"}},
+
+			result:      v1.Container{Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 4000}}},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 4000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := rubyTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual(test.image, image)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type rustTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rustTransformer{})
+}
+
+const (
+	// gdbserver has no default port, 2345 is commonly used
+	defaultGdbserverPort = 2345
+)
+
+// rustBinaryDirs are the directories where cargo writes the Rust binaries that it builds or installs
+var rustBinaryDirs = []string{"/target/debug/", "/target/release/", "/usr/local/cargo/bin/"}
+
+// isLaunchingGdbserver determines if the arguments seems to be invoking gdbserver or lldb-server
+func isLaunchingGdbserver(args []string) bool {
+	return len(args) > 0 &&
+		(args[0] == "gdbserver" || strings.HasSuffix(args[0], "/gdbserver") ||
+			args[0] == "lldb-server" || strings.HasSuffix(args[0], "/lldb-server"))
+}
+
+// isLaunchingRustBinary determines if the arguments seems to be invoking a binary built by cargo
+func isLaunchingRustBinary(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for _, dir := range rustBinaryDirs {
+		if strings.Contains(args[0], dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t rustTransformer) IsApplicable(config imageConfiguration) bool {
+	// Rust binaries have no runtime environment: environment variables like `RUST_LOG` are
+	// commonly set for any workload, so look at the buildpacks and the command-line instead.
+	cnbBuildMetadata := config.labels["io.buildpacks.build.metadata"]
+	for _, id := range []string{"paketo-community/rust", "paketo-community/cargo"} {
+		if strings.Contains(cnbBuildMetadata, id) {
+			logrus.Infof("Artifact %q has Rust buildpacks %q", config.artifact, id)
+			return true
+		}
+	}
+
+	args := config.arguments
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		args = config.entrypoint
+	}
+	if isLaunchingRustBinary(args) {
+		logrus.Infof("Artifact %q has Rust runtime: launches cargo binary %q", config.artifact, args[0])
+		return true
+	}
+	return isLaunchingGdbserver(args)
+}
+
+// Apply configures a container definition for Rust by launching the binary under gdbserver,
+// which both gdb and lldb can connect to.
+// There is no support image: the image provides `gdbserver` at `/dbg/rust/bin/gdbserver`.
+// Returns the debug configuration details.
+func (t rustTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Rust debugging", container.Name)
+
+	// try to find an existing `gdbserver` or `lldb-server` command
+	if port := retrieveGdbserverPort(config); port > 0 {
+		container.Ports = exposePort(container.Ports, "gdbserver", port)
+		return ContainerDebugConfiguration{
+			Runtime: "rust",
+			Ports:   map[string]uint32{"gdbserver": uint32(port)},
+		}, "", nil
+	}
+
+	port := portAlloc(defaultGdbserverPort)
+	switch {
+	case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+		container.Command = rewriteGdbserverCommandLine(config.entrypoint, port)
+
+	case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && len(config.arguments) > 0:
+		container.Args = rewriteGdbserverCommandLine(config.arguments, port)
+
+	default:
+		return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q has no command-line", container.Name)
+	}
+
+	container.Ports = exposePort(container.Ports, "gdbserver", port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "rust",
+		Ports:   map[string]uint32{"gdbserver": uint32(port)},
+	}, "", nil
+}
+
+func retrieveGdbserverPort(config imageConfiguration) int32 {
+	if port := extractGdbserverPort(config.entrypoint); port > 0 {
+		return port
+	}
+	return extractGdbserverPort(config.arguments)
+}
+
+// extractGdbserverPort returns the port of a `gdbserver [host]:port` or `lldb-server gdbserver [host]:port`
+// command-line, or 0 if the arguments don't invoke one of them.
+func extractGdbserverPort(args []string) int32 {
+	if !isLaunchingGdbserver(args) {
+		return 0
+	}
+	for _, arg := range args[1:] {
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "-") || !strings.Contains(arg, ":") {
+			continue
+		}
+		address := strings.Split(arg, ":")
+		port, err := strconv.ParseInt(address[len(address)-1], 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid gdbserver address %q: %s\n", arg, err)
+			return 0
+		}
+		return int32(port)
+	}
+	return 0
+}
+
+// rewriteGdbserverCommandLine rewrites a command-line to launch the binary under gdbserver
+func rewriteGdbserverCommandLine(commandLine []string, port int32) []string {
+	return append([]string{"/dbg/rust/bin/gdbserver", fmt.Sprintf(":%d", port)}, commandLine...)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRustTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUST_BACKTRACE is not enough",
+			source:      imageConfiguration{env: map[string]string{"RUST_BACKTRACE": "1"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint cargo debug binary",
+			source:      imageConfiguration{entrypoint: []string{"/app/target/debug/server"}},
+			result:      true,
+		},
+		{
+			description: "arguments cargo installed binary",
+			source:      imageConfiguration{arguments: []string{"/usr/local/cargo/bin/server", "--port", "8080"}},
+			result:      true,
+		},
+		{
+			description: "launcher entrypoint with cargo release binary",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"/app/target/release/server"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "buildpacks",
+			source:      imageConfiguration{labels: map[string]string{"io.buildpacks.build.metadata": `{"buildpacks":[{"id":"paketo-community/rust"}]}`}},
+			result:      true,
+		},
+		{
+			description: "entrypoint gdbserver",
+			source:      imageConfiguration{entrypoint: []string{"gdbserver", ":2345", "/app/server"}},
+			result:      true,
+		},
+		{
+			description: "launcher entrypoint with lldb-server",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"lldb-server", "gdbserver", "*:2345", "--", "/app/server"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint binary",
+			source:      imageConfiguration{entrypoint: []string{"/app/server"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := rustTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRustTransformerApply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+		image         string
+	}{
+		{
+			description:   "empty",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{},
+			shouldErr:     true,
+		},
+		{
+			description:   "entrypoint",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"/app/server", "--port", "8080"}},
+
+			result: v1.Container{
+				Command: []string{"/dbg/rust/bin/gdbserver", ":2345", "/app/server", "--port", "8080"},
+				Ports:   []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 2345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 2345}},
+		},
+		{
+			description:   "arguments",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{arguments: []string{"/app/server"}},
+
+			result: v1.Container{
+				Args:  []string{"/dbg/rust/bin/gdbserver", ":2345", "/app/server"},
+				Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 2345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 2345}},
+		},
+		{
+			description:   "existing lldb-server",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"lldb-server", "gdbserver", "*:1234", "--", "/app/server"}},
+
+			result:      v1.Container{Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 1234}}},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 1234}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := rustTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.result, test.containerSpec)
+				t.CheckDeepEqual(test.debugConfig, config)
+				t.CheckDeepEqual(test.image, image)
+			}
+		})
+	}
+}
@@ -37,6 +37,9 @@ type Deployer interface {
 	// Cleanup deletes what was deployed by calling Deploy.
 	Cleanup(context.Context, io.Writer) error
 
+	// Rollback reverts what was deployed by the last call to Deploy to its previous state.
+	Rollback(context.Context, io.Writer) error
+
 	// Render generates the Kubernetes manifests replacing the build results and
 	// writes them to the given file path
 	Render(context.Context, io.Writer, []graph.Artifact, bool, string) error
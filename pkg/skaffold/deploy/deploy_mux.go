@@ -67,6 +67,15 @@ func (m DeployerMux) Cleanup(ctx context.Context, w io.Writer) error {
 	return nil
 }
 
+func (m DeployerMux) Rollback(ctx context.Context, w io.Writer) error {
+	for _, deployer := range m {
+		if err := deployer.Rollback(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m DeployerMux) Render(ctx context.Context, w io.Writer, as []graph.Artifact, offline bool, filepath string) error {
 	resources, buf := []string{}, &bytes.Buffer{}
 	for _, deployer := range m {
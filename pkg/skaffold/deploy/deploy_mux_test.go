@@ -38,6 +38,8 @@ type MockDeployer struct {
 	dependencies     []string
 	dependenciesErr  error
 	cleanupErr       error
+	rollbackErr      error
+	rolledBack       bool
 	renderResult     string
 	renderErr        error
 }
@@ -50,6 +52,11 @@ func (m *MockDeployer) Cleanup(context.Context, io.Writer) error {
 	return m.cleanupErr
 }
 
+func (m *MockDeployer) Rollback(context.Context, io.Writer) error {
+	m.rolledBack = true
+	return m.rollbackErr
+}
+
 func (m *MockDeployer) WithLabel(labels map[string]string) *MockDeployer {
 	m.labels = labels
 	return m
@@ -70,6 +77,11 @@ func (m *MockDeployer) WithCleanupErr(err error) *MockDeployer {
 	return m
 }
 
+func (m *MockDeployer) WithRollbackErr(err error) *MockDeployer {
+	m.rollbackErr = err
+	return m
+}
+
 func (m *MockDeployer) WithRenderErr(err error) *MockDeployer {
 	m.renderErr = err
 	return m
@@ -204,6 +216,44 @@ func TestDeployerMux_Dependencies(t *testing.T) {
 	}
 }
 
+func TestDeployerMux_Rollback(t *testing.T) {
+	tests := []struct {
+		name               string
+		err1               error
+		err2               error
+		expectedRolledBack []bool
+		shouldErr          bool
+	}{
+		{
+			name:               "all deployers are rolled back",
+			expectedRolledBack: []bool{true, true},
+		},
+		{
+			name:               "when first call fails",
+			err1:               fmt.Errorf("failed in first"),
+			expectedRolledBack: []bool{true, false},
+			shouldErr:          true,
+		},
+		{
+			name:               "when second call fails",
+			err2:               fmt.Errorf("failed in second"),
+			expectedRolledBack: []bool{true, true},
+			shouldErr:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployer1 := NewMockDeployer().WithRollbackErr(test.err1)
+			deployer2 := NewMockDeployer().WithRollbackErr(test.err2)
+			deployerMux := DeployerMux([]Deployer{deployer1, deployer2})
+
+			err := deployerMux.Rollback(context.Background(), ioutil.Discard)
+			testutil.CheckErrorAndDeepEqual(t, test.shouldErr, err, test.expectedRolledBack, []bool{deployer1.rolledBack, deployer2.rolledBack})
+		})
+	}
+}
+
 func TestDeployerMux_Render(t *testing.T) {
 	tests := []struct {
 		name           string
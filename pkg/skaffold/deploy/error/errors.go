@@ -61,6 +61,15 @@ func CleanupErr(err error) error {
 		})
 }
 
+// RollbackErr returns actionable error when rolling back a failed deployment fails.
+func RollbackErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("rolling back: %s", err),
+			ErrCode: proto.StatusCode_DEPLOY_ROLLBACK_ERR,
+		})
+}
+
 // MissingToolErr returns a concise error if error is due to deploy tool executable not found.
 func MissingToolErr(toolName string, err error) string {
 	if strings.Contains(err.Error(), executableNotFound) {
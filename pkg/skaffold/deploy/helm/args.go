@@ -111,6 +111,39 @@ func getArgs(releaseName string, namespace string) []string {
 	return append(args, releaseName)
 }
 
+// TemplateArgs calculates the correct arguments to "helm template"
+func TemplateArgs(releaseName string, r latest_v1.HelmRelease, builds []graph.Artifact, namespace string) ([]string, error) {
+	args := []string{"template", releaseName, chartSource(r)}
+
+	params, err := pairParamsToArtifacts(builds, r.ArtifactOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range params {
+		value, err := imageSetFromConfig(r.ImageStrategy.HelmImageConfig.HelmConventionConfig, k, v.Tag)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--set-string", value)
+	}
+
+	args, err = constructOverrideArgs(&r, builds, args, func(string) {})
+	if err != nil {
+		return nil, userErr("construct override args", err)
+	}
+
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+
+	if r.Repo != "" {
+		args = append(args, "--repo", r.Repo)
+	}
+
+	return args, nil
+}
+
 // installArgs calculates the correct arguments to "helm install"
 func (h *Deployer) installArgs(r latest_v1.HelmRelease, builds []graph.Artifact, valuesSet map[string]bool, o installOpts) ([]string, error) {
 	var args []string
@@ -88,6 +88,16 @@ type Deployer struct {
 	isMultiConfig bool
 	// bV is the helm binary version
 	bV semver.Version
+
+	// deployedReleases are the releases installed or upgraded by the last call to Deploy
+	deployedReleases []deployedRelease
+}
+
+// deployedRelease records how a release was deployed, so that it can be rolled back.
+type deployedRelease struct {
+	name      string
+	namespace string
+	upgraded  bool
 }
 
 type Config interface {
@@ -127,6 +137,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 	var dRes []types.Artifact
 	nsMap := map[string]struct{}{}
 	valuesSet := map[string]bool{}
+	h.deployedReleases = nil
 
 	// Deploy every release
 	for _, r := range h.Releases {
@@ -251,6 +262,27 @@ func (h *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// Rollback rolls back the releases upgraded by the last call to Deploy to their previous revision,
+// and deletes the releases it installed.
+func (h *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	for i := len(h.deployedReleases) - 1; i >= 0; i-- {
+		r := h.deployedReleases[i]
+
+		args := []string{"delete", r.name}
+		if r.upgraded {
+			args = []string{"rollback", r.name}
+		}
+		if r.namespace != "" {
+			args = append(args, "--namespace", r.namespace)
+		}
+		if err := h.exec(ctx, out, false, nil, args...); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("release %q: %w", r.name, err))
+		}
+	}
+	h.deployedReleases = nil
+	return nil
+}
+
 // Render generates the Kubernetes manifests and writes them out
 func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, offline bool, filepath string) error {
 	renderedManifests := new(bytes.Buffer)
@@ -261,44 +293,15 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 			return userErr(fmt.Sprintf("cannot expand release name %q", r.Name), err)
 		}
 
-		args := []string{"template", chartSource(r)}
-		args = append(args[:1], append([]string{releaseName}, args[1:]...)...)
-
-		params, err := pairParamsToArtifacts(builds, r.ArtifactOverrides)
+		namespace, err := h.releaseNamespace(r)
 		if err != nil {
 			return err
 		}
 
-		for k, v := range params {
-			var value string
-
-			cfg := r.ImageStrategy.HelmImageConfig.HelmConventionConfig
-
-			value, err = imageSetFromConfig(cfg, k, v.Tag)
-			if err != nil {
-				return err
-			}
-
-			args = append(args, "--set-string", value)
-		}
-
-		args, err = constructOverrideArgs(&r, builds, args, func(string) {})
-		if err != nil {
-			return userErr("construct override args", err)
-		}
-
-		namespace, err := h.releaseNamespace(r)
+		args, err := TemplateArgs(releaseName, r, builds, namespace)
 		if err != nil {
 			return err
 		}
-		if namespace != "" {
-			args = append(args, "--namespace", namespace)
-		}
-
-		if r.Repo != "" {
-			args = append(args, "--repo")
-			args = append(args, r.Repo)
-		}
 
 		outBuffer := new(bytes.Buffer)
 		if err := h.exec(ctx, outBuffer, false, nil, args...); err != nil {
@@ -416,6 +419,7 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 	if err != nil {
 		return nil, userErr("install", err)
 	}
+	h.deployedReleases = append(h.deployedReleases, deployedRelease{name: releaseName, namespace: opts.namespace, upgraded: opts.upgrade})
 
 	b, err := h.getReleaseManifest(ctx, releaseName, opts.namespace)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -35,6 +36,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
@@ -66,6 +68,10 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	globalConfig       string
+
+	// applied and previousResources are used to roll back the last call to Deploy.
+	applied           bool
+	previousResources []byte
 }
 
 // NewDeployer generates a new Deployer object contains the kptDeploy schema.
@@ -159,13 +165,20 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 		return nil, fmt.Errorf("getting applyDir: %w", err)
 	}
 
-	manifest.Write(manifests.String(), filepath.Join(applyDir, "resources.yaml"), out)
+	resourcesFile := filepath.Join(applyDir, "resources.yaml")
+	previousResources, err := ioutil.ReadFile(resourcesFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	manifest.Write(manifests.String(), resourcesFile, out)
 	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
 	cmd.Stdout = out
 	cmd.Stderr = out
 	if err := util.RunCmd(cmd); err != nil {
 		return nil, err
 	}
+	k.applied, k.previousResources = true, previousResources
 
 	return namespaces, nil
 }
@@ -225,6 +238,38 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// Rollback re-applies the inventory as it was before the last call to Deploy.
+// If nothing was deployed before, the deployed resources are destroyed.
+func (k *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	if !k.applied {
+		return nil
+	}
+
+	applyDir, err := k.getApplyDir(ctx)
+	if err != nil {
+		return deployerr.RollbackErr(fmt.Errorf("getting applyDir: %w", err))
+	}
+
+	command := []string{"live", "destroy"}
+	var flags []string
+	if k.previousResources != nil {
+		if err := ioutil.WriteFile(filepath.Join(applyDir, "resources.yaml"), k.previousResources, 0666); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+		command, flags = []string{"live", "apply"}, k.getKptLiveApplyArgs()
+	}
+
+	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, command, flags, nil)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return deployerr.RollbackErr(err)
+	}
+
+	k.applied, k.previousResources = false, nil
+	return nil
+}
+
 // Render hydrates manifests using both kustomization and kpt functions.
 func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, _ bool, filepath string) error {
 	if err := sanityCheck(k.Dir, out); err != nil {
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// LiveDeployer applies hydrated manifests with `kpt live apply`, using the
+// inventory settings from the v2 schema's `deploy` section.
+type LiveDeployer struct {
+	latest_v2.DeployConfig
+
+	// applied and previousResources are used to roll back the last call to Deploy.
+	applied           bool
+	previousResources []byte
+}
+
+// NewLiveDeployer returns a new LiveDeployer for a v2 DeployConfig.
+func NewLiveDeployer(d latest_v2.DeployConfig) *LiveDeployer {
+	return &LiveDeployer{DeployConfig: d}
+}
+
+// Deploy writes the rendered manifests into the apply directory and calls `kpt live apply`.
+// It returns the namespaces of the deployed resources.
+func (k *LiveDeployer) Deploy(ctx context.Context, out io.Writer, manifests manifest.ManifestList) ([]string, error) {
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	namespaces, err := manifests.CollectNamespaces()
+	if err != nil {
+		event.DeployInfoEvent(fmt.Errorf("could not fetch deployed resource namespace. "+
+			"This might cause port-forward and deploy health-check to fail: %w", err))
+	}
+
+	applyDir, err := k.getApplyDir(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting applyDir: %w", err)
+	}
+
+	resourcesFile := filepath.Join(applyDir, "resources.yaml")
+	previousResources, err := ioutil.ReadFile(resourcesFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := manifest.Write(manifests.String(), resourcesFile, out); err != nil {
+		return nil, err
+	}
+	if err := k.liveApply(ctx, out, applyDir); err != nil {
+		return nil, err
+	}
+	k.applied, k.previousResources = true, previousResources
+
+	return namespaces, nil
+}
+
+// Apply calls `kpt live apply` on the apply directory as is, without writing any manifests to it.
+func (k *LiveDeployer) Apply(ctx context.Context, out io.Writer) error {
+	applyDir, err := k.getApplyDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting applyDir: %w", err)
+	}
+
+	return k.liveApply(ctx, out, applyDir)
+}
+
+// Cleanup deletes what was deployed by calling `kpt live destroy`.
+func (k *LiveDeployer) Cleanup(ctx context.Context, out io.Writer) error {
+	applyDir, err := k.getApplyDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting applyDir: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "destroy"}, nil, nil)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return util.RunCmd(cmd)
+}
+
+// Rollback re-applies the inventory as it was before the last call to Deploy.
+// If nothing was deployed before, the deployed resources are destroyed.
+func (k *LiveDeployer) Rollback(ctx context.Context, out io.Writer) error {
+	if !k.applied {
+		return nil
+	}
+
+	applyDir, err := k.getApplyDir(ctx)
+	if err != nil {
+		return deployerr.RollbackErr(fmt.Errorf("getting applyDir: %w", err))
+	}
+
+	if k.previousResources == nil {
+		if err := k.Cleanup(ctx, out); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+	} else {
+		if err := ioutil.WriteFile(filepath.Join(applyDir, "resources.yaml"), k.previousResources, 0666); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+		if err := k.liveApply(ctx, out, applyDir); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+	}
+
+	k.applied, k.previousResources = false, nil
+	return nil
+}
+
+func (k *LiveDeployer) liveApply(ctx context.Context, out io.Writer, applyDir string) error {
+	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return util.RunCmd(cmd)
+}
+
+// getApplyDir returns the path to `dir` if specified by the user. Otherwise, getApplyDir
+// creates a hidden directory named .kpt-hydrated and initializes its inventory.
+func (k *LiveDeployer) getApplyDir(ctx context.Context) (string, error) {
+	if k.Dir != "" {
+		if _, err := os.Stat(k.Dir); os.IsNotExist(err) {
+			return "", err
+		}
+		return k.Dir, nil
+	}
+
+	if err := os.MkdirAll(kptHydrated, os.ModePerm); err != nil {
+		return "", fmt.Errorf("applyDir was unspecified. creating applyDir: %w", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(kptHydrated, inventoryTemplate)); os.IsNotExist(err) {
+		cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(kptHydrated, []string{"live", "init"}, k.getKptLiveInitArgs(), nil)...)
+		if _, err := util.RunCmdOut(cmd); err != nil {
+			return "", err
+		}
+	}
+
+	return kptHydrated, nil
+}
+
+// getKptLiveApplyArgs returns a list of arguments that the user specified for the `kpt live apply` command.
+func (k *LiveDeployer) getKptLiveApplyArgs() []string {
+	var flags []string
+
+	if len(k.PrunePropagationPolicy) > 0 {
+		flags = append(flags, "--prune-propagation-policy", k.PrunePropagationPolicy)
+	}
+
+	if len(k.PruneTimeout) > 0 {
+		flags = append(flags, "--prune-timeout", k.PruneTimeout)
+	}
+
+	// The status check deadline bounds how long `kpt live apply` waits for the resources to reconcile.
+	// An explicit reconcile timeout takes precedence.
+	if len(k.ReconcileTimeout) > 0 {
+		flags = append(flags, "--reconcile-timeout", k.ReconcileTimeout)
+	} else if len(k.StatusCheckDeadlineSeconds) > 0 {
+		flags = append(flags, "--reconcile-timeout", k.StatusCheckDeadlineSeconds)
+	}
+
+	return flags
+}
+
+// getKptLiveInitArgs returns a list of arguments that the user specified for the `kpt live init` command.
+func (k *LiveDeployer) getKptLiveInitArgs() []string {
+	var flags []string
+
+	if len(k.InventoryID) > 0 {
+		flags = append(flags, "--inventory-id", k.InventoryID)
+	}
+
+	if len(k.InventoryNamespace) > 0 {
+		flags = append(flags, "--namespace", k.InventoryNamespace)
+	}
+
+	return flags
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLiveDeployer_Deploy(t *testing.T) {
+	pod := `apiVersion: v1
+kind: Pod
+metadata:
+  name: leeroy-web
+  namespace: test
+spec:
+  containers:
+  - image: leeroy-web
+    name: leeroy-web`
+
+	tests := []struct {
+		description        string
+		config             latest_v2.DeployConfig
+		manifests          manifest.ManifestList
+		commands           util.Command
+		expectedNamespaces []string
+		shouldErr          bool
+	}{
+		{
+			description: "no manifests",
+		},
+		{
+			description: "invalid user specified dir",
+			config:      latest_v2.DeployConfig{Dir: "invalid_path"},
+			manifests:   manifest.ManifestList{[]byte(pod)},
+			shouldErr:   true,
+		},
+		{
+			description:        "user specified dir",
+			config:             latest_v2.DeployConfig{Dir: "valid_path"},
+			manifests:          manifest.ManifestList{[]byte(pod)},
+			commands:           testutil.CmdRun("kpt live apply valid_path"),
+			expectedNamespaces: []string{"test"},
+		},
+		{
+			description: "unspecified dir with inventory and live apply options",
+			config: latest_v2.DeployConfig{
+				InventoryID:                "1a23bcde-4f56-7891-a2bc-de34fabcde5f6",
+				InventoryNamespace:         "foo",
+				StatusCheckDeadlineSeconds: "5s",
+				PrunePropagationPolicy:     "Orphan",
+				PruneTimeout:               "2m",
+				ReconcileTimeout:           "1m",
+			},
+			manifests: manifest.ManifestList{[]byte(pod)},
+			commands: testutil.
+				CmdRunOut("kpt live init .kpt-hydrated --inventory-id 1a23bcde-4f56-7891-a2bc-de34fabcde5f6 --namespace foo", "").
+				AndRun("kpt live apply .kpt-hydrated --prune-propagation-policy Orphan --prune-timeout 2m --reconcile-timeout 1m"),
+			expectedNamespaces: []string{"test"},
+		},
+		{
+			description: "status check deadline as reconcile timeout",
+			config: latest_v2.DeployConfig{
+				Dir:                        "valid_path",
+				StatusCheckDeadlineSeconds: "5s",
+			},
+			manifests:          manifest.ManifestList{[]byte(pod)},
+			commands:           testutil.CmdRun("kpt live apply valid_path --reconcile-timeout 5s"),
+			expectedNamespaces: []string{"test"},
+		},
+		{
+			description: "kpt live apply fails",
+			config:      latest_v2.DeployConfig{Dir: "valid_path"},
+			manifests:   manifest.ManifestList{[]byte(pod)},
+			commands:    testutil.CmdRunErr("kpt live apply valid_path", errors.New("BUG")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			tmpDir := t.NewTempDir().Chdir()
+			if test.config.Dir == "valid_path" {
+				os.Mkdir(test.config.Dir, 0755)
+			}
+
+			k := NewLiveDeployer(test.config)
+			namespaces, err := k.Deploy(context.Background(), ioutil.Discard, test.manifests)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedNamespaces, namespaces)
+			if !test.shouldErr && len(test.manifests) > 0 {
+				applyDir := test.config.Dir
+				if applyDir == "" {
+					applyDir = kptHydrated
+				}
+				resources, err := ioutil.ReadFile(tmpDir.Path(filepath.Join(applyDir, "resources.yaml")))
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.manifests.String()+"\n", string(resources))
+			}
+		})
+	}
+}
+
+func TestLiveDeployer_Cleanup(t *testing.T) {
+	tests := []struct {
+		description string
+		dir         string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "invalid user specified dir",
+			dir:         "invalid_path",
+			shouldErr:   true,
+		},
+		{
+			description: "user specified dir",
+			dir:         "valid_path",
+			commands:    testutil.CmdRun("kpt live destroy valid_path"),
+		},
+		{
+			description: "unspecified dir",
+			commands: testutil.
+				CmdRunOut("kpt live init .kpt-hydrated", "").
+				AndRun("kpt live destroy .kpt-hydrated"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.NewTempDir().Chdir()
+			if test.dir == "valid_path" {
+				os.Mkdir(test.dir, 0755)
+			}
+
+			k := NewLiveDeployer(latest_v2.DeployConfig{Dir: test.dir})
+			err := k.Cleanup(context.Background(), ioutil.Discard)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestLiveDeployer_Rollback(t *testing.T) {
+	pod := `apiVersion: v1
+kind: Pod
+metadata:
+  name: leeroy-web
+spec:
+  containers:
+  - image: leeroy-web:v1
+    name: leeroy-web`
+
+	tests := []struct {
+		description       string
+		previousResources string
+		commands          util.Command
+		expectedResources string
+	}{
+		{
+			description: "first deployment is destroyed",
+			commands: testutil.
+				CmdRun("kpt live apply valid_path").
+				AndRun("kpt live destroy valid_path"),
+		},
+		{
+			description:       "previous resources are applied back",
+			previousResources: "previous",
+			commands: testutil.
+				CmdRun("kpt live apply valid_path").
+				AndRun("kpt live apply valid_path"),
+			expectedResources: "previous",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			tmpDir := t.NewTempDir().Chdir()
+			os.Mkdir("valid_path", 0755)
+			if test.previousResources != "" {
+				tmpDir.Write(filepath.Join("valid_path", "resources.yaml"), test.previousResources)
+			}
+
+			k := NewLiveDeployer(latest_v2.DeployConfig{Dir: "valid_path"})
+			_, err := k.Deploy(context.Background(), ioutil.Discard, manifest.ManifestList{[]byte(pod)})
+			t.CheckNoError(err)
+
+			err = k.Rollback(context.Background(), ioutil.Discard)
+			t.CheckNoError(err)
+			if test.expectedResources != "" {
+				resources, err := ioutil.ReadFile(tmpDir.Path(filepath.Join("valid_path", "resources.yaml")))
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expectedResources, string(resources))
+			}
+
+			// A second rollback is a no-op.
+			t.CheckNoError(k.Rollback(context.Background(), ioutil.Discard))
+		})
+	}
+}
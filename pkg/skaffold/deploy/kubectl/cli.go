@@ -25,13 +25,16 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	deploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
 
 // CLI holds parameters to run kubectl.
@@ -39,9 +42,15 @@ type CLI struct {
 	*kubectl.CLI
 	Flags latest_v1.KubectlFlags
 
-	forceDeploy      bool
-	waitForDeletions config.WaitForDeletions
-	previousApply    manifest.ManifestList
+	forceDeploy       bool
+	rollbackOnFailure bool
+	waitForDeletions  config.WaitForDeletions
+	previousApply     manifest.ManifestList
+
+	// lastApply, snapshot and existing are used to roll back the last call to Apply.
+	lastApply manifest.ManifestList
+	snapshot  manifest.ManifestList
+	existing  []resourceKey
 }
 
 type Config interface {
@@ -51,14 +60,16 @@ type Config interface {
 	WaitForDeletions() config.WaitForDeletions
 	Mode() config.RunMode
 	HydratedManifests() []string
+	RollbackOnFailure() bool
 }
 
 func NewCLI(cfg Config, flags latest_v1.KubectlFlags, defaultNameSpace string) CLI {
 	return CLI{
-		CLI:              kubectl.NewCLI(cfg, defaultNameSpace),
-		Flags:            flags,
-		forceDeploy:      cfg.ForceDeploy(),
-		waitForDeletions: cfg.WaitForDeletions(),
+		CLI:               kubectl.NewCLI(cfg, defaultNameSpace),
+		Flags:             flags,
+		forceDeploy:       cfg.ForceDeploy(),
+		rollbackOnFailure: cfg.RollbackOnFailure(),
+		waitForDeletions:  cfg.WaitForDeletions(),
 	}
 }
 
@@ -83,6 +94,14 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		return nil
 	}
 
+	if c.rollbackOnFailure {
+		snapshot, existing, err := c.Snapshot(ctx, updated)
+		if err != nil {
+			return err
+		}
+		c.lastApply, c.snapshot, c.existing = updated, snapshot, existing
+	}
+
 	args := []string{"-f", "-"}
 	if c.forceDeploy {
 		args = append(args, "--force", "--grace-period=0")
@@ -99,6 +118,39 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	return nil
 }
 
+// Rollback reverts the resources updated by the last call to Apply to their snapshot.
+// Resources that didn't exist before are deleted.
+func (c *CLI) Rollback(ctx context.Context, out io.Writer) error {
+	if len(c.lastApply) == 0 {
+		return nil
+	}
+
+	created, err := c.newResources(c.lastApply, c.existing)
+	if err != nil {
+		return deployerr.RollbackErr(err)
+	}
+	if skipped := len(c.lastApply) - len(created) - len(c.snapshot); skipped > 0 {
+		logrus.Warnf("%d updated resources weren't deployed by Skaffold and can't be rolled back", skipped)
+	}
+
+	if len(c.snapshot) > 0 {
+		if err := c.Run(ctx, c.snapshot.Reader(), out, "apply", c.args(c.Flags.Apply, "-f", "-")...); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("kubectl apply: %w", err))
+		}
+	}
+
+	if len(created) > 0 {
+		if err := c.Delete(ctx, out, created); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+	}
+
+	// Force the next call to Apply to redeploy everything.
+	c.previousApply = nil
+	c.lastApply, c.snapshot, c.existing = nil, nil, nil
+	return nil
+}
+
 // Kustomize runs `kubectl kustomize` with the provided args
 func (c *CLI) Kustomize(ctx context.Context, args []string) ([]byte, error) {
 	return c.RunOut(ctx, "kustomize", c.args(nil, args...)...)
@@ -178,6 +230,133 @@ func (c *CLI) WaitForDeletions(ctx context.Context, out io.Writer, manifests man
 	}
 }
 
+// serverSetFields are the metadata fields set by the API server. They are removed from
+// snapshots so that the resources can be applied again.
+var serverSetFields = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+// Snapshot returns the current state of the resources described by the manifests that were
+// previously deployed by Skaffold, as selected by the run-id label, so that they can be applied again.
+// It also returns the keys of all the resources that already exist in the cluster.
+func (c *CLI) Snapshot(ctx context.Context, manifests manifest.ManifestList) (manifest.ManifestList, []resourceKey, error) {
+	buf, err := c.RunOutInput(ctx, manifests.Reader(), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("snapshotting deployed resources: %w", err)
+	}
+
+	// No resource found.
+	if len(buf) == 0 {
+		return nil, nil, nil
+	}
+
+	var result struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(buf, &result); err != nil {
+		return nil, nil, fmt.Errorf("snapshotting deployed resources: %w", err)
+	}
+
+	selector, err := labels.Parse(label.RunIDLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var snapshot manifest.ManifestList
+	var existing []resourceKey
+	for _, item := range result.Items {
+		b, err := yaml.Marshal(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("snapshotting deployed resources: %w", err)
+		}
+		key, err := parseResourceKey(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		existing = append(existing, key)
+		if !selector.Matches(labels.Set(key.labels)) {
+			continue
+		}
+
+		delete(item, "status")
+		if metadata, ok := item["metadata"].(map[string]interface{}); ok {
+			for _, field := range serverSetFields {
+				delete(metadata, field)
+			}
+		}
+
+		b, err = yaml.Marshal(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("snapshotting deployed resources: %w", err)
+		}
+		snapshot = append(snapshot, b)
+	}
+
+	return snapshot, existing, nil
+}
+
+// newResources returns the manifests of the resources that didn't exist before they were applied.
+func (c *CLI) newResources(manifests manifest.ManifestList, existing []resourceKey) (manifest.ManifestList, error) {
+	var created manifest.ManifestList
+	for _, m := range manifests {
+		key, err := parseResourceKey(m)
+		if err != nil {
+			return nil, err
+		}
+		if key.namespace == "" {
+			key.namespace = c.Namespace
+		}
+
+		found := false
+		for _, e := range existing {
+			if key.matches(e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			created = append(created, m)
+		}
+	}
+	return created, nil
+}
+
+// resourceKey identifies a resource by its kind, namespace and name.
+type resourceKey struct {
+	kind      string
+	namespace string
+	name      string
+	labels    map[string]string
+}
+
+func parseResourceKey(m []byte) (resourceKey, error) {
+	var resource struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Name      string            `yaml:"name"`
+			Namespace string            `yaml:"namespace"`
+			Labels    map[string]string `yaml:"labels"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(m, &resource); err != nil {
+		return resourceKey{}, fmt.Errorf("parsing manifest: %w", err)
+	}
+	return resourceKey{
+		kind:      resource.Kind,
+		namespace: resource.Metadata.Namespace,
+		name:      resource.Metadata.Name,
+		labels:    resource.Metadata.Labels,
+	}, nil
+}
+
+// matches compares the key of a manifest with the key of a deployed resource.
+// A manifest without namespace is deployed to kubectl's default namespace, and
+// cluster-scoped resources have no namespace.
+func (k resourceKey) matches(deployed resourceKey) bool {
+	if k.kind != deployed.kind || k.name != deployed.name {
+		return false
+	}
+	return k.namespace == "" || deployed.namespace == "" || k.namespace == deployed.namespace
+}
+
 // ReadManifests reads a list of manifests in yaml format.
 func (c *CLI) ReadManifests(ctx context.Context, manifests []string) (manifest.ManifestList, error) {
 	var list []string
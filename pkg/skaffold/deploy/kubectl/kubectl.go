@@ -349,6 +349,11 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// Rollback reverts the resources applied by the last call to Deploy.
+func (k *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	return k.kubectl.Rollback(ctx, textio.NewPrefixWriter(out, " - "))
+}
+
 // Dependencies lists all the files that describe what needs to be deployed.
 func (k *Deployer) Dependencies() ([]string, error) {
 	return k.manifestFiles(k.KubectlDeploy.Manifests)
@@ -372,6 +372,71 @@ func TestKubectlDeployerRemoteCleanup(t *testing.T) {
 	}
 }
 
+func TestKubectlRollback(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    util.Command
+	}{
+		{
+			description: "previous version is applied back",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", DeploymentWebYAML).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", `{"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"labels":{"skaffold.dev/run-id":"previous"},"name":"leeroy-web","namespace":"testNamespace","resourceVersion":"42","uid":"1234"},"spec":{"containers":[{"image":"leeroy-web:v0","name":"leeroy-web"}]},"status":{"phase":"Running"}}]}`).
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f -").
+				AndRunInput("kubectl --context kubecontext --namespace testNamespace apply -f -", `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    skaffold.dev/run-id: previous
+  name: leeroy-web
+  namespace: testNamespace
+spec:
+  containers:
+  - image: leeroy-web:v0
+    name: leeroy-web`),
+		},
+		{
+			description: "new resources are deleted",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", DeploymentWebYAML).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f -").
+				AndRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
+		},
+		{
+			description: "resources not deployed by skaffold are left untouched",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", DeploymentWebYAML).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", `{"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"leeroy-web","namespace":"testNamespace"}}]}`).
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f -"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.NewTempDir().
+				Write("deployment.yaml", DeploymentWebYAML).
+				Chdir()
+
+			k, err := NewDeployer(&kubectlConfig{
+				workingDir:        ".",
+				rollbackOnFailure: true,
+				RunContext:        runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: TestNamespace}},
+			}, nil, &latest_v1.KubectlDeploy{Manifests: []string{"deployment.yaml"}})
+			t.RequireNoError(err)
+
+			_, err = k.Deploy(context.Background(), ioutil.Discard, []graph.Artifact{{ImageName: "leeroy-web", Tag: "leeroy-web:v1"}})
+			t.CheckNoError(err)
+
+			err = k.Rollback(context.Background(), ioutil.Discard)
+			t.CheckNoError(err)
+		})
+	}
+}
+
 func TestKubectlRedeploy(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
@@ -735,6 +800,7 @@ type kubectlConfig struct {
 	defaultRepo           string
 	skipRender            bool
 	force                 bool
+	rollbackOnFailure     bool
 	waitForDeletions      config.WaitForDeletions
 }
 
@@ -745,3 +811,4 @@ func (c *kubectlConfig) SkipRender() bool                          { return c.sk
 func (c *kubectlConfig) ForceDeploy() bool                         { return c.force }
 func (c *kubectlConfig) DefaultRepo() *string                      { return &c.defaultRepo }
 func (c *kubectlConfig) WaitForDeletions() config.WaitForDeletions { return c.waitForDeletions }
+func (c *kubectlConfig) RollbackOnFailure() bool                   { return c.rollbackOnFailure }
@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+const (
+	jobConditions = `jsonpath={range .status.conditions[?(@.status=="True")]}{.type}{"\t"}{.message}{"\n"}{end}`
+	podConditions = `jsonpath={.status.phase}{"\t"}{.status.conditions[?(@.type=="Ready")].status}`
+)
+
+// Kind knows how to check the readiness of one kind of Kubernetes resource.
+type Kind interface {
+	// Type returns the resource type as understood by kubectl, e.g. `deployment`.
+	Type() string
+
+	// CheckStatus returns the current status of the resource with the given name.
+	CheckStatus(ctx context.Context, cli *kubectl.CLI, name, namespace string) proto.ActionableErr
+}
+
+var (
+	// DeploymentKind waits for a deployment to roll out.
+	DeploymentKind Kind = rolloutKind("deployment")
+
+	// StatefulSetKind waits for a statefulset with a `RollingUpdate` strategy to roll out.
+	StatefulSetKind Kind = rolloutKind("statefulset")
+
+	// DaemonSetKind waits for a daemonset with a `RollingUpdate` strategy to roll out.
+	DaemonSetKind Kind = rolloutKind("daemonset")
+
+	// JobKind waits for a job to complete and fails if the job fails.
+	JobKind Kind = jobKind{}
+
+	// PodKind waits for a standalone pod to be ready, or to have run to completion.
+	PodKind Kind = podKind{}
+)
+
+// CustomKind returns a Kind that checks custom resources with the given readiness rule.
+func CustomKind(rule latest_v1.CustomResourceStatusCheck) Kind {
+	return customKind(rule)
+}
+
+// rolloutKind relies on `kubectl rollout status` to check the resource.
+type rolloutKind string
+
+func (k rolloutKind) Type() string {
+	return string(k)
+}
+
+func (k rolloutKind) CheckStatus(ctx context.Context, cli *kubectl.CLI, name, namespace string) proto.ActionableErr {
+	b, err := cli.RunOut(ctx, "rollout", "status", string(k), name, "--namespace", namespace, "--watch=false")
+	return parseKubectlRolloutError(k.cleanupStatus(name, string(b)), err)
+}
+
+// cleanupStatus removes the resource name from the `kubectl rollout status` output.
+// e.g.
+//  deployment "leeroy-app" successfully rolled out
+//  daemon set "fluentd" successfully rolled out
+func (k rolloutKind) cleanupStatus(name, msg string) string {
+	prefix := string(k)
+	if k == "daemonset" {
+		prefix = "daemon set"
+	}
+	clean := strings.ReplaceAll(msg, prefix+` "`+name+`" `, "")
+	if len(clean) > 0 {
+		clean = strings.ToLower(clean[0:1]) + clean[1:]
+	}
+	return clean
+}
+
+type jobKind struct{}
+
+func (jobKind) Type() string {
+	return "job"
+}
+
+func (jobKind) CheckStatus(ctx context.Context, cli *kubectl.CLI, name, namespace string) proto.ActionableErr {
+	b, err := cli.RunOut(ctx, "get", "job", name, "--namespace", namespace, "-o", jobConditions)
+	if err != nil {
+		return parseKubectlError(err)
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		condition := strings.SplitN(line, "\t", 2)
+		switch condition[0] {
+		case "Complete":
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "job completed",
+			}
+		case "Failed":
+			msg := "job failed"
+			if len(condition) > 1 && condition[1] != "" {
+				msg = fmt.Sprintf("job failed: %s", condition[1])
+			}
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+				Message: msg,
+			}
+		}
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		Message: "waiting for job to complete",
+	}
+}
+
+type podKind struct{}
+
+func (podKind) Type() string {
+	return "pod"
+}
+
+func (podKind) CheckStatus(ctx context.Context, cli *kubectl.CLI, name, namespace string) proto.ActionableErr {
+	b, err := cli.RunOut(ctx, "get", "pod", name, "--namespace", namespace, "-o", podConditions)
+	if err != nil {
+		return parseKubectlError(err)
+	}
+
+	status := strings.SplitN(strings.TrimSpace(string(b)), "\t", 2)
+	switch {
+	case status[0] == "Succeeded":
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "pod completed",
+		}
+	case status[0] == "Failed":
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			Message: "pod failed",
+		}
+	case len(status) > 1 && status[1] == "True":
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "pod is ready",
+		}
+	default:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_POD_PENDING,
+			Message: "waiting for pod to be ready",
+		}
+	}
+}
+
+// customKind checks custom resources by evaluating JSONPath expressions against them.
+type customKind latest_v1.CustomResourceStatusCheck
+
+func (k customKind) Type() string {
+	return strings.ToLower(k.Kind) + "." + k.Group
+}
+
+func (k customKind) CheckStatus(ctx context.Context, cli *kubectl.CLI, name, namespace string) proto.ActionableErr {
+	ready, err := k.eval(ctx, cli, name, namespace, k.ReadyJSONPath)
+	if err != nil {
+		return parseKubectlError(err)
+	}
+	if ready == k.ReadyValue {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: fmt.Sprintf("%s is %s", k.ReadyJSONPath, ready),
+		}
+	}
+
+	if k.FailedJSONPath != "" {
+		failed, err := k.eval(ctx, cli, name, namespace, k.FailedJSONPath)
+		if err != nil {
+			return parseKubectlError(err)
+		}
+		if failed == k.FailedValue {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED,
+				Message: fmt.Sprintf("%s is %s", k.FailedJSONPath, failed),
+			}
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+		Message: fmt.Sprintf("waiting for %s to be %s", k.ReadyJSONPath, k.ReadyValue),
+	}
+}
+
+func (k customKind) eval(ctx context.Context, cli *kubectl.CLI, name, namespace, jsonPath string) (string, error) {
+	b, err := cli.RunOut(ctx, "get", k.Type(), name, "--namespace", namespace, "-o", "jsonpath="+jsonPath)
+	return strings.TrimSpace(string(b)), err
+}
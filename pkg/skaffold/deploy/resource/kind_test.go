@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestKindCheckStatus(t *testing.T) {
+	certificates := latest_v1.CustomResourceStatusCheck{
+		Group:          "cert-manager.io",
+		Kind:           "Certificate",
+		ReadyJSONPath:  `{.status.conditions[?(@.type=="Ready")].status}`,
+		ReadyValue:     "True",
+		FailedJSONPath: `{.status.conditions[?(@.type=="Issuing")].reason}`,
+		FailedValue:    "Failed",
+	}
+	readyCmd := `kubectl --context kubecontext get certificate.cert-manager.io tls --namespace test -o jsonpath={.status.conditions[?(@.type=="Ready")].status}`
+	failedCmd := `kubectl --context kubecontext get certificate.cert-manager.io tls --namespace test -o jsonpath={.status.conditions[?(@.type=="Issuing")].reason}`
+
+	tests := []struct {
+		description string
+		kind        Kind
+		name        string
+		commands    util.Command
+		expectedStr string
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "statefulset rolled out",
+			kind:        StatefulSetKind,
+			name:        "db",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset db --namespace test --watch=false",
+				"statefulset rolling update complete 3 pods at revision db-7b5c4d4f9...",
+			),
+			expectedStr: "test:statefulset/db",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "statefulset rolling update complete 3 pods at revision db-7b5c4d4f9...",
+			},
+		},
+		{
+			description: "statefulset pending",
+			kind:        StatefulSetKind,
+			name:        "db",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset db --namespace test --watch=false",
+				"Waiting for 1 pods to be ready...",
+			),
+			expectedStr: "test:statefulset/db",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+				Message: "waiting for 1 pods to be ready...",
+			},
+		},
+		{
+			description: "daemonset rolled out",
+			kind:        DaemonSetKind,
+			name:        "fluentd",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset fluentd --namespace test --watch=false",
+				`daemon set "fluentd" successfully rolled out`,
+			),
+			expectedStr: "test:daemonset/fluentd",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "successfully rolled out",
+			},
+		},
+		{
+			description: "job completed",
+			kind:        JobKind,
+			name:        "migrate",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job migrate --namespace test -o "+jobConditions,
+				"Complete\t\n",
+			),
+			expectedStr: "test:job/migrate",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "job completed",
+			},
+		},
+		{
+			description: "job failed",
+			kind:        JobKind,
+			name:        "migrate",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job migrate --namespace test -o "+jobConditions,
+				"Failed\tJob has reached the specified backoff limit\n",
+			),
+			expectedStr: "test:job/migrate",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+				Message: "job failed: Job has reached the specified backoff limit",
+			},
+		},
+		{
+			description: "job running",
+			kind:        JobKind,
+			name:        "migrate",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job migrate --namespace test -o "+jobConditions,
+				"",
+			),
+			expectedStr: "test:job/migrate",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+				Message: "waiting for job to complete",
+			},
+		},
+		{
+			description: "job not found",
+			kind:        JobKind,
+			name:        "migrate",
+			commands: testutil.CmdRunOutErr(
+				"kubectl --context kubecontext get job migrate --namespace test -o "+jobConditions,
+				"",
+				errors.New(`jobs.batch "migrate" not found`),
+			),
+			expectedStr: "test:job/migrate",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: `jobs.batch "migrate" not found`,
+			},
+		},
+		{
+			description: "pod ready",
+			kind:        PodKind,
+			name:        "web",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get pod web --namespace test -o "+podConditions,
+				"Running\tTrue",
+			),
+			expectedStr: "test:pod/web",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "pod is ready",
+			},
+		},
+		{
+			description: "pod not ready",
+			kind:        PodKind,
+			name:        "web",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get pod web --namespace test -o "+podConditions,
+				"Running\tFalse",
+			),
+			expectedStr: "test:pod/web",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_POD_PENDING,
+				Message: "waiting for pod to be ready",
+			},
+		},
+		{
+			description: "pod completed",
+			kind:        PodKind,
+			name:        "web",
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get pod web --namespace test -o "+podConditions,
+				"Succeeded\tFalse",
+			),
+			expectedStr: "test:pod/web",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "pod completed",
+			},
+		},
+		{
+			description: "custom resource ready",
+			kind:        CustomKind(certificates),
+			name:        "tls",
+			commands:    testutil.CmdRunOut(readyCmd, "True"),
+			expectedStr: "test:certificate.cert-manager.io/tls",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: `{.status.conditions[?(@.type=="Ready")].status} is True`,
+			},
+		},
+		{
+			description: "custom resource failed",
+			kind:        CustomKind(certificates),
+			name:        "tls",
+			commands: testutil.
+				CmdRunOut(readyCmd, "False").
+				AndRunOut(failedCmd, "Failed"),
+			expectedStr: "test:certificate.cert-manager.io/tls",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED,
+				Message: `{.status.conditions[?(@.type=="Issuing")].reason} is Failed`,
+			},
+		},
+		{
+			description: "custom resource pending",
+			kind:        CustomKind(certificates),
+			name:        "tls",
+			commands: testutil.
+				CmdRunOut(readyCmd, "False").
+				AndRunOut(failedCmd, ""),
+			expectedStr: "test:certificate.cert-manager.io/tls",
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+				Message: `waiting for {.status.conditions[?(@.type=="Ready")].status} to be True`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			ae := test.kind.CheckStatus(context.Background(), kubectl.NewCLI(&statusConfig{}, ""), test.name, "test")
+			t.CheckDeepEqual(test.expected, ae)
+			t.CheckDeepEqual(test.expectedStr, NewResource(test.name, test.kind, "test", 0).String())
+		})
+	}
+}
@@ -178,6 +178,18 @@ func TestIsErrAndNotRetriable(t *testing.T) {
 			description: "rollout status connection error",
 			statusCode:  proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
 		},
+		{
+			description: "job pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		},
+		{
+			description: "pod pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_POD_PENDING,
+		},
+		{
+			description: "custom resource pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+		},
 		{
 			description: "rollout status kubectl command killed",
 			statusCode:  proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -35,9 +36,12 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/resource"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
@@ -68,6 +72,7 @@ type Config interface {
 
 	GetNamespaces() []string
 	StatusCheckDeadlineSeconds() int
+	CustomResourceStatusChecks() []latest_v1.CustomResourceStatusCheck
 	Muted() config.Muted
 }
 
@@ -76,7 +81,7 @@ type Checker interface {
 	Check(context.Context, io.Writer) error
 }
 
-// statusChecker runs status checks for pods and deployments
+// statusChecker runs status checks for pods, workloads and custom resources
 type statusChecker struct {
 	cfg             Config
 	labeller        *label.DefaultLabeller
@@ -84,7 +89,7 @@ type statusChecker struct {
 	muteLogs        bool
 }
 
-// NewStatusChecker returns a status checker which runs checks on deployments and pods.
+// NewStatusChecker returns a status checker which runs checks on workloads, custom resources and pods.
 func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
@@ -94,7 +99,7 @@ func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	}
 }
 
-// Run runs the status checks on deployments and pods deployed in current skaffold dev iteration.
+// Run runs the status checks on resources and pods deployed in current skaffold dev iteration.
 func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 	event.StatusCheckEventStarted()
 	errCode, err := s.statusCheck(ctx, out)
@@ -108,50 +113,75 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, 0)
+	resources := make([]*resource.Resource, 0)
 	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
-			getDeadline(s.deadlineSeconds))
+		newResources, err := getResources(ctx, client, n, s.labeller, getDeadline(s.deadlineSeconds))
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
 		}
-		deployments = append(deployments, newDeployments...)
+		resources = append(resources, newResources...)
+
+		customResources, err := getCustomResources(ctx, s.cfg, n, s.labeller, getDeadline(s.deadlineSeconds))
+		if err != nil {
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch custom resources: %w", err)
+		}
+		resources = append(resources, customResources...)
 	}
 
 	var wg sync.WaitGroup
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for _, d := range deployments {
+	for _, d := range resources {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
-			pollDeploymentStatus(ctx, s.cfg, r)
+			pollResourceStatus(ctx, s.cfg, r)
 			rcCopy := c.markProcessed(r.Status().Error())
 			s.printStatusCheckSummary(out, r, rcCopy)
-			// if one deployment fails, cancel status checks for all deployments.
+			// if one resource fails, cancel status checks for all resources.
 			if r.Status().Error() != nil && r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 				cancel()
 			}
 		}(d)
 	}
 
-	// Retrieve pending deployments statuses
+	// Retrieve pending resource statuses
 	go func() {
-		s.printDeploymentStatus(ctx, out, deployments)
+		s.printResourceStatus(ctx, out, resources)
 	}()
 
-	// Wait for all deployment statuses to be fetched
+	// Wait for all resource statuses to be fetched
 	wg.Wait()
 	cancel()
-	return getSkaffoldDeployStatus(c, deployments)
+	return getSkaffoldDeployStatus(c, resources)
+}
+
+// getResources returns the deployments, statefulsets, daemonsets, jobs and standalone pods
+// deployed in the current run.
+func getResources(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	var resources []*resource.Resource
+	for _, get := range []func(context.Context, kubernetes.Interface, string, *label.DefaultLabeller, time.Duration) ([]*resource.Resource, error){
+		getDeployments,
+		getStatefulSets,
+		getDaemonSets,
+		getJobs,
+		getStandalonePods,
+	} {
+		r, err := get(ctx, client, ns, l, deadlineDuration)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r...)
+	}
+	return resources, nil
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -159,7 +189,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -167,20 +197,127 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		pd := podValidator(client, d.Namespace, l, d.Spec.Template.Labels)
+		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+	}
+	return deployments, nil
+}
+
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+	var statefulSets []*resource.Resource
+	for _, ss := range sets.Items {
+		// `kubectl rollout status` is only available for the RollingUpdate strategy.
+		if ss.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			logrus.Debugf("skipping status check for statefulset %s with %s update strategy", ss.Name, ss.Spec.UpdateStrategy.Type)
+			continue
 		}
+		pd := podValidator(client, ss.Namespace, l, ss.Spec.Template.Labels)
+		statefulSets = append(statefulSets, resource.NewResource(ss.Name, resource.StatefulSetKind, ss.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return statefulSets, nil
+}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
 	}
-	return deployments, nil
+
+	var daemonSets []*resource.Resource
+	for _, ds := range sets.Items {
+		// `kubectl rollout status` is only available for the RollingUpdate strategy.
+		if ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			logrus.Debugf("skipping status check for daemonset %s with %s update strategy", ds.Name, ds.Spec.UpdateStrategy.Type)
+			continue
+		}
+		pd := podValidator(client, ds.Namespace, l, ds.Spec.Template.Labels)
+		daemonSets = append(daemonSets, resource.NewResource(ds.Name, resource.DaemonSetKind, ds.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return daemonSets, nil
+}
+
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+
+	jobs := make([]*resource.Resource, len(list.Items))
+	for i, j := range list.Items {
+		pd := podValidator(client, j.Namespace, l, j.Spec.Template.Labels)
+		jobs[i] = resource.NewResource(j.Name, resource.JobKind, j.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return jobs, nil
+}
+
+// getStandalonePods returns the pods deployed in the current run that aren't managed by a controller.
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch pods: %w", err)
+	}
+
+	var pods []*resource.Resource
+	for _, p := range list.Items {
+		if len(p.OwnerReferences) > 0 {
+			continue
+		}
+		pd := podValidator(client, p.Namespace, l, p.Labels)
+		pods = append(pods, resource.NewResource(p.Name, resource.PodKind, p.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return pods, nil
+}
+
+// getCustomResources returns the custom resources deployed in the current run
+// for which a readiness rule is configured.
+func getCustomResources(ctx context.Context, cfg Config, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	checks := cfg.CustomResourceStatusChecks()
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	cli := pkgkubectl.NewCLI(cfg, "")
+	var resources []*resource.Resource
+	for _, check := range checks {
+		kind := resource.CustomKind(check)
+		b, err := cli.RunOut(ctx, "get", kind.Type(), "--namespace", ns, "-l", l.RunIDSelector(), "-o", "name")
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch %s: %w", kind.Type(), err)
+		}
+		for _, name := range strings.Fields(string(b)) {
+			// `kubectl get -o name` prints `<type>/<name>`
+			name = name[strings.LastIndex(name, "/")+1:]
+			resources = append(resources, resource.NewResource(name, kind, ns, deadlineDuration))
+		}
+	}
+	return resources, nil
+}
+
+func podValidator(client kubernetes.Interface, ns string, l *label.DefaultLabeller, podLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range podLabels {
+		pd = pd.WithLabel(k, v)
+	}
+	return pd
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
+func pollResourceStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
@@ -220,9 +357,9 @@ func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resourc
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed > 0 {
-		err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+		err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 		for _, r := range rs {
 			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
 				return r.StatusCode(), err
@@ -239,14 +376,15 @@ func getDeadline(d int) time.Duration {
 	return DefaultStatusCheckDeadline
 }
 
-func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
-		// another deployment failed
+		// another resource failed
 		return
 	}
 	event.ResourceStatusCheckEventCompleted(r.String(), ae)
+	eventV2.ResourceStatusCheckEventCompleted(r.String(), sErrors.V2fromV1(ae))
 	status := fmt.Sprintf("%s %s", tabHeader, r)
 	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
@@ -263,15 +401,15 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 	fmt.Fprintln(out, status)
 }
 
-// printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+// printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
+func (s statusChecker) printResourceStatus(ctx context.Context, out io.Writer, resources []*resource.Resource) {
 	for {
 		var allDone bool
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allDone = s.printStatus(deployments, out)
+			allDone = s.printStatus(resources, out)
 		}
 		if allDone {
 			return
@@ -279,15 +417,16 @@ func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer,
 	}
 }
 
-func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s statusChecker) printStatus(resources []*resource.Resource, out io.Writer) bool {
 	allDone := true
-	for _, r := range deployments {
+	for _, r := range resources {
 		if r.IsStatusCheckCompleteOrCancelled() {
 			continue
 		}
 		allDone = false
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
 			event.ResourceStatusCheckEventUpdated(r.String(), r.Status().ActionableError())
+			eventV2.ResourceStatusCheckEventUpdated(r.String(), sErrors.V2fromV1(r.Status().ActionableError()))
 			fmt.Fprintln(out, trimNewLine(str))
 		}
 	}
@@ -296,7 +435,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 
 func getPendingMessage(pending int32, total int) string {
 	if pending > 0 {
-		return fmt.Sprintf(" [%d/%d deployment(s) still pending]", pending, total)
+		return fmt.Sprintf(" [%d/%d resource(s) still pending]", pending, total)
 	}
 	return ""
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
@@ -49,7 +51,7 @@ func TestGetDeployments(t *testing.T) {
 	tests := []struct {
 		description string
 		deps        []*appsv1.Deployment
-		expected    []*resource.Deployment
+		expected    []*resource.Resource
 		shouldErr   bool
 	}{
 		{
@@ -77,7 +79,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(20)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 10*time.Second),
 				resource.NewDeployment("dep2", "test", 20*time.Second),
 			},
@@ -97,7 +99,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(300)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 300*time.Second),
 			},
 		},
@@ -124,7 +126,7 @@ func TestGetDeployments(t *testing.T) {
 					},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 100*time.Second),
 				resource.NewDeployment("dep2", "test", 200*time.Second),
 			},
@@ -143,13 +145,13 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(600)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 200*time.Second),
 			},
 		},
 		{
 			description: "no deployments",
-			expected:    []*resource.Deployment{},
+			expected:    []*resource.Resource{},
 		},
 		{
 			description: "multiple deployments in different namespaces",
@@ -175,7 +177,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 100*time.Second),
 			},
 		},
@@ -193,7 +195,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
@@ -209,7 +211,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 	}
 
@@ -222,17 +224,81 @@ func TestGetDeployments(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
-				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
 		})
 	}
 }
 
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	runID := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	objs := []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test", Labels: runID}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runID}},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "on-delete", Namespace: "test", Labels: runID},
+			Spec:       appsv1.StatefulSetSpec{UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}},
+		},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd", Namespace: "test", Labels: runID}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "test", Labels: runID}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "other-run", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "9876-6789"}}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "test", Labels: runID}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:            "migrate-xyz",
+			Namespace:       "test",
+			Labels:          runID,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: "migrate"}},
+		}},
+	}
+	client := fakekubeclientset.NewSimpleClientset(objs...)
+
+	resources, err := getResources(context.Background(), client, "test", labeller, 200*time.Second)
+	testutil.CheckError(t, false, err)
+
+	var actual []string
+	for _, r := range resources {
+		actual = append(actual, r.String())
+	}
+	testutil.CheckDeepEqual(t, []string{
+		"test:deployment/web",
+		"test:statefulset/db",
+		"test:daemonset/fluentd",
+		"test:job/migrate",
+		"test:pod/standalone",
+	}, actual)
+}
+
+func TestGetCustomResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	testutil.Run(t, "custom resources with a readiness rule", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(
+			"kubectl --context kubecontext get certificate.cert-manager.io --namespace test -l "+labeller.RunIDSelector()+" -o name",
+			"certificate.cert-manager.io/tls\ncertificate.cert-manager.io/api-tls\n",
+		))
+		cfg := &statusConfig{checks: []latest_v1.CustomResourceStatusCheck{{
+			Group:         "cert-manager.io",
+			Kind:          "Certificate",
+			ReadyJSONPath: "{.status.conditions[0].status}",
+			ReadyValue:    "True",
+		}}}
+
+		resources, err := getCustomResources(context.Background(), cfg, "test", labeller, 200*time.Second)
+		t.CheckNoError(err)
+
+		var actual []string
+		for _, r := range resources {
+			actual = append(actual, r.String())
+		}
+		t.CheckDeepEqual([]string{"test:certificate.cert-manager.io/tls", "test:certificate.cert-manager.io/api-tls"}, actual)
+	})
+}
+
 func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
 		counter      *counter
-		deployments  []*resource.Deployment
+		deployments  []*resource.Resource
 		expected     string
 		expectedCode proto.StatusCode
 		shouldErr    bool
@@ -240,18 +306,18 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one error",
 			counter:     &counter{total: 2, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				resource.NewDeployment("foo", "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
-			expected:     "1/2 deployment(s) failed",
+			expected:     "1/2 resource(s) failed",
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
 			shouldErr:    true,
 		},
 		{
 			description: "no error",
 			counter:     &counter{total: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -265,8 +331,8 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "multiple errors",
 			counter:     &counter{total: 3, failed: 2},
-			expected:    "2/3 deployment(s) failed",
-			deployments: []*resource.Deployment{
+			expected:    "2/3 resource(s) failed",
+			deployments: []*resource.Resource{
 				resource.NewDeployment("foo", "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
@@ -280,7 +346,7 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "unable to retrieve pods for deployment",
 			counter:     &counter{total: 1, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("deployment", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
@@ -344,7 +410,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			deployment:  "dep",
 			pending:     4,
 			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
-			expected:    " - test:deployment/dep is ready. [4/10 deployment(s) still pending]\n",
+			expected:    " - test:deployment/dep is ready. [4/10 resource(s) still pending]\n",
 		},
 		{
 			description: "more than 1 deployment left and current is in error",
@@ -385,13 +451,13 @@ func TestPrintStatus(t *testing.T) {
 	labeller := label.NewLabeller(true, nil)
 	tests := []struct {
 		description string
-		rs          []*resource.Deployment
+		rs          []*resource.Resource
 		expectedOut string
 		expected    bool
 	}{
 		{
 			description: "single resource successful marked complete - skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -401,7 +467,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "single resource in error marked complete -skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "error"},
@@ -411,7 +477,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -429,7 +495,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete and retry-able error",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -446,7 +512,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "skip printing if status check is cancelled",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
@@ -469,7 +535,7 @@ func TestPrintStatus(t *testing.T) {
 	}
 }
 
-func withStatus(d *resource.Deployment, ae proto.ActionableErr) *resource.Deployment {
+func withStatus(d *resource.Resource, ae proto.ActionableErr) *resource.Resource {
 	d.UpdateStatus(ae)
 	return d
 }
@@ -538,13 +604,13 @@ func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
 	tests := []struct {
 		description string
-		dep         *resource.Deployment
+		dep         *resource.Resource
 		runs        [][]validator.Resource
 		command     util.Command
 		expected    proto.StatusCode
 	}{
 		{
-			description: "pollDeploymentStatus errors out immediately when container error can't recover",
+			description: "pollResourceStatus errors out immediately when container error can't recover",
 			dep:         resource.NewDeployment("dep", "test", time.Second),
 			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
@@ -559,7 +625,7 @@ func TestPollDeployment(t *testing.T) {
 			expected: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 		},
 		{
-			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
+			description: "pollResourceStatus waits when a container can recover and eventually succeeds",
 			dep:         resource.NewDeployment("dep", "test", time.Second),
 			command: testutil.CmdRunOutErr(
 				// pending due to recoverable error
@@ -595,7 +661,7 @@ func TestPollDeployment(t *testing.T) {
 			mockVal := mockValidator{runs: test.runs}
 			dep := test.dep.WithValidator(mockVal)
 
-			pollDeploymentStatus(context.Background(), &statusConfig{}, dep)
+			pollResourceStatus(context.Background(), &statusConfig{}, dep)
 
 			t.CheckDeepEqual(test.expected, test.dep.Status().ActionableError().ErrCode)
 		})
@@ -625,6 +691,11 @@ func (m mockValidator) WithValidators([]validator.Validator) diag.Diagnose {
 
 type statusConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
+	checks                []latest_v1.CustomResourceStatusCheck
+}
+
+func (c *statusConfig) CustomResourceStatusChecks() []latest_v1.CustomResourceStatusCheck {
+	return c.checks
 }
 
 func (c *statusConfig) GetKubeContext() string { return kubectl.TestKubeContext }
@@ -17,11 +17,13 @@ limitations under the License.
 package docker
 
 import (
+	"encoding/base64"
 	"strings"
 	"sync"
 
 	"github.com/docker/cli/cli/config"
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/google"
 )
 
@@ -107,3 +109,32 @@ func (a *Keychain) newAuthenticator(res authn.Resource) authn.Authenticator {
 	// 4. Default to anonymous
 	return authn.Anonymous
 }
+
+// AuthorizationHeader returns the value of the `Authorization` header to send to a host,
+// based on the configured docker credentials. It returns an empty string for anonymous access.
+func AuthorizationHeader(host string) (string, error) {
+	registry, err := name.NewRegistry(host, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := primaryKeychain.Resolve(registry)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := auth.Authorization()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case cfg.RegistryToken != "":
+		return "Bearer " + cfg.RegistryToken, nil
+	case cfg.Auth != "":
+		return "Basic " + cfg.Auth, nil
+	case cfg.Username != "" || cfg.Password != "":
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(cfg.Username+":"+cfg.Password)), nil
+	default:
+		return "", nil
+	}
+}
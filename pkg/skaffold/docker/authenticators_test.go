@@ -122,3 +122,33 @@ func TestResolve(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizationHeader(t *testing.T) {
+	tests := []struct {
+		description    string
+		dockerConfig   string
+		expectedHeader string
+	}{
+		{
+			description:    "basic auth",
+			dockerConfig:   `{"auths":{"cache.example.com":{"auth":"dXNlcjpwYXNz"}}}`,
+			expectedHeader: "Basic dXNlcjpwYXNz",
+		},
+		{
+			description:  "anonymous",
+			dockerConfig: `{}`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("config.json", test.dockerConfig)
+			t.SetEnvs(map[string]string{"DOCKER_CONFIG": tmpDir.Root()})
+			t.Override(&primaryKeychain, &Keychain{configDir: tmpDir.Root()})
+
+			header, err := AuthorizationHeader("cache.example.com")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedHeader, header)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+var _ v1.Layer = (*staticLayer)(nil)
+
+// staticLayer is an uncompressed layer with an arbitrary content, typically
+// used to store artifacts, such as signatures, in a registry.
+type staticLayer struct {
+	content   []byte
+	mediaType types.MediaType
+	hash      v1.Hash
+}
+
+// NewStaticLayer returns a layer, with the given media type, holding the content as is.
+func NewStaticLayer(content []byte, mediaType types.MediaType) (v1.Layer, error) {
+	hash, _, err := v1.SHA256(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	return &staticLayer{content: content, mediaType: mediaType, hash: hash}, nil
+}
+
+func (l *staticLayer) Digest() (v1.Hash, error) { return l.hash, nil }
+func (l *staticLayer) DiffID() (v1.Hash, error) { return l.hash, nil }
+func (l *staticLayer) Size() (int64, error)     { return int64(len(l.content)), nil }
+func (l *staticLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+func (l *staticLayer) Uncompressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+func (l *staticLayer) MediaType() (types.MediaType, error) { return l.mediaType, nil }
@@ -64,6 +64,47 @@ func getRemoteDigest(identifier string, cfg Config) (string, error) {
 	return digest(img)
 }
 
+// RemoteImage retrieves the image for the given platform from a remote registry.
+// The default platform of the image is used if platform is nil.
+func RemoteImage(identifier string, platform *v1.Platform, cfg Config) (v1.Image, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []remote.Option{remote.WithAuthFromKeychain(primaryKeychain)}
+	if platform != nil {
+		opts = append(opts, remote.WithPlatform(*platform))
+	}
+	return remoteImage(ref, opts...)
+}
+
+// WriteRemoteImage pushes an image to a remote registry and returns its digest.
+func WriteRemoteImage(tag string, img v1.Image, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.Write(ref, img, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, ref, err)
+	}
+	return digest(img)
+}
+
+// WriteRemoteIndex pushes an image index to a remote registry and returns its digest.
+func WriteRemoteIndex(tag string, idx v1.ImageIndex, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.WriteIndex(ref, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, ref, err)
+	}
+	return digest(idx)
+}
+
 // RetrieveRemoteConfig retrieves the remote config file for an image
 func RetrieveRemoteConfig(identifier string, cfg Config) (*v1.ConfigFile, error) {
 	img, err := getRemoteImage(identifier, cfg)
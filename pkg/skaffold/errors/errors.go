@@ -68,6 +68,20 @@ func ActionableErrV2(cfg interface{}, phase constants.Phase, err error) *protoV2
 	}
 }
 
+// V2fromV1 converts a v1 actionable error into its v2 counterpart.
+func V2fromV1(ae proto.ActionableErr) protoV2.ActionableErr {
+	suggestionsV2 := make([]*protoV2.Suggestion, len(ae.Suggestions))
+	for i, suggestion := range ae.Suggestions {
+		converted := protoV2.Suggestion(*suggestion)
+		suggestionsV2[i] = &converted
+	}
+	return protoV2.ActionableErr{
+		ErrCode:     ae.ErrCode,
+		Message:     ae.Message,
+		Suggestions: suggestionsV2,
+	}
+}
+
 func ShowAIError(cfg interface{}, err error) error {
 	if uErr := errors.Unwrap(err); uErr != nil {
 		err = uErr
@@ -132,9 +146,9 @@ func unknownErrForPhase(phase constants.Phase) proto.StatusCode {
 		return proto.StatusCode_BUILD_UNKNOWN
 	case constants.Init:
 		return proto.StatusCode_INIT_UNKNOWN
-	case constants.Test:
+	case constants.Test, constants.Verify:
 		return proto.StatusCode_TEST_UNKNOWN
-	case constants.Deploy:
+	case constants.Deploy, constants.Rollback:
 		return proto.StatusCode_DEPLOY_UNKNOWN
 	case constants.StatusCheck:
 		return proto.StatusCode_STATUSCHECK_UNKNOWN
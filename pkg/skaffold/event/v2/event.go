@@ -45,6 +45,9 @@ const (
 	Succeeded  = "Succeeded"
 	Terminated = "Terminated"
 	Canceled   = "Canceled"
+
+	Connected    = "Connected"
+	Reconnecting = "Reconnecting"
 )
 
 var handler = newHandler()
@@ -363,6 +366,14 @@ func (ev *eventHandler) handleBuildSubtaskEvent(e *proto.BuildSubtaskEvent) {
 	})
 }
 
+func (ev *eventHandler) handleSkaffoldLogEvent(e *proto.SkaffoldLogEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_SkaffoldLogEvent{
+			SkaffoldLogEvent: e,
+		},
+	})
+}
+
 func (ev *eventHandler) handleExec(event *proto.Event) {
 	switch e := event.GetEventType().(type) {
 	case *proto.Event_BuildSubtaskEvent:
@@ -384,6 +395,10 @@ func (ev *eventHandler) handleExec(event *proto.Event) {
 		se := e.StatusCheckSubtaskEvent
 		ev.stateLock.Lock()
 		ev.state.StatusCheckState.Status = se.Status
+		if ev.state.StatusCheckState.Resources == nil {
+			ev.state.StatusCheckState.Resources = map[string]string{}
+		}
+		ev.state.StatusCheckState.Resources[se.Resource] = se.Status
 		ev.stateLock.Unlock()
 	case *proto.Event_FileSyncEvent:
 		fse := e.FileSyncEvent
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+)
+
+func TestLogger(t *testing.T) {
+	defer func() { handler = newHandler() }()
+	handler = newHandler()
+
+	w := NewLogger(constants.Build, "0", "hooks")
+	fmt.Fprint(w, "Running pre-build hook")
+
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return false
+		}
+		le := handler.eventLog[len(handler.eventLog)-1].GetSkaffoldLogEvent()
+		return le != nil && le.TaskId == "Build-0" && le.SubtaskId == "0" && le.Origin == "hooks" && le.Message == "Running pre-build hook"
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// PortForwardStatusChanged notifies that a port forwarding is connected, is reconnecting, or keeps failing.
+// The error describes why the port forwarding is reconnecting or failing.
+func PortForwardStatusChanged(id string, localPort int32, remotePort util.IntOrString, podName, containerName, namespace, portName, resourceType, resourceName, address, status string, err error) {
+	event := &proto.PortForwardEvent{
+		Id:            id,
+		TaskId:        fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration),
+		LocalPort:     localPort,
+		PodName:       podName,
+		ContainerName: containerName,
+		Namespace:     namespace,
+		PortName:      portName,
+		ResourceType:  resourceType,
+		ResourceName:  resourceName,
+		Address:       address,
+		TargetPort: &proto.IntOrString{
+			Type:   int32(remotePort.Type),
+			IntVal: int32(remotePort.IntVal),
+			StrVal: remotePort.StrVal,
+		},
+		Status: status,
+	}
+	if err != nil {
+		event.ActionableErr = sErrors.ActionableErrV2(handler.cfg, constants.PortForward, err)
+	}
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_PortEvent{
+			PortEvent: event,
+		},
+	})
+}
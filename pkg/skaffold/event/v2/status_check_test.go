@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+func TestResourceStatusCheckEvents(t *testing.T) {
+	tcs := []struct {
+		description    string
+		ae             proto.ActionableErr
+		completed      bool
+		expectedStatus string
+	}{
+		{
+			description:    "resource still pending",
+			ae:             proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: "waiting for rollout"},
+			expectedStatus: InProgress,
+		},
+		{
+			description:    "resource ready",
+			ae:             proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			completed:      true,
+			expectedStatus: Succeeded,
+		},
+		{
+			description:    "resource failed",
+			ae:             proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "could not stabilize"},
+			completed:      true,
+			expectedStatus: Failed,
+		},
+	}
+	handler.setState(emptyState(mockCfg([]latest_v1.Pipeline{{}}, "test")))
+	for _, tc := range tcs {
+		t.Run(tc.description, func(t *testing.T) {
+			if tc.completed {
+				ResourceStatusCheckEventCompleted("statefulset/db", tc.ae)
+			} else {
+				ResourceStatusCheckEventUpdated("statefulset/db", tc.ae)
+			}
+			wait(t, func() bool {
+				handler.logLock.Lock()
+				defer handler.logLock.Unlock()
+				if len(handler.eventLog) == 0 {
+					return false
+				}
+				se := handler.eventLog[len(handler.eventLog)-1].GetStatusCheckSubtaskEvent()
+				return se != nil && se.Resource == "statefulset/db" && se.Status == tc.expectedStatus && se.StatusCode == tc.ae.ErrCode
+			})
+			if actual := handler.getState().StatusCheckState.Resources["statefulset/db"]; actual != tc.expectedStatus {
+				t.Errorf("expected resource state %q, got %q", tc.expectedStatus, actual)
+			}
+			if actual := handler.getState().StatusCheckState.Status; actual != tc.expectedStatus {
+				t.Errorf("expected status check state %q, got %q", tc.expectedStatus, actual)
+			}
+		})
+	}
+}
@@ -16,22 +16,32 @@ limitations under the License.
 
 package filemon
 
+import (
+	"path/filepath"
+	"sync"
+)
+
 // Monitor monitors files changes for multiples components.
 type Monitor interface {
 	Register(deps func() ([]string, error), onChange func(Events)) error
 	Run(debounce bool) error
 	Reset()
+	Ignore(paths ...string)
 }
 
 type watchList struct {
 	changedComponents map[int]bool
 	components        []*component
+
+	ignoredLock sync.Mutex
+	ignored     map[string]bool
 }
 
 // NewMonitor creates a new Monitor.
 func NewMonitor() Monitor {
 	return &watchList{
 		changedComponents: map[int]bool{},
+		ignored:           map[string]bool{},
 	}
 }
 
@@ -61,14 +71,53 @@ func (w *watchList) Reset() {
 	w.changedComponents = map[int]bool{}
 }
 
+// Ignore makes the monitor skip the next change of each given file,
+// for files that Skaffold writes itself and that shouldn't trigger a dev loop.
+func (w *watchList) Ignore(paths ...string) {
+	w.ignoredLock.Lock()
+	defer w.ignoredLock.Unlock()
+
+	for _, path := range paths {
+		if abs, err := filepath.Abs(path); err == nil {
+			w.ignored[abs] = true
+		}
+	}
+}
+
+// skipIgnored marks the ignored files that changed as already known to the component
+// and returns the ones that did change.
+func (w *watchList) skipIgnored(component *component, state FileMap) []string {
+	var skipped []string
+	for path, modTime := range state {
+		if prev, found := component.state[path]; found && prev.Equal(modTime) {
+			continue
+		}
+
+		abs, err := filepath.Abs(path)
+		if err != nil || !w.ignored[abs] {
+			continue
+		}
+		component.state[path] = modTime
+		skipped = append(skipped, abs)
+	}
+	return skipped
+}
+
 // Run watches files until the context is cancelled or an error occurs.
 func (w *watchList) Run(debounce bool) error {
+	w.ignoredLock.Lock()
+	defer w.ignoredLock.Unlock()
+
 	changed := 0
+	var skipped []string
 	for i, component := range w.components {
 		state, err := Stat(component.deps)
 		if err != nil {
 			return err
 		}
+		if len(w.ignored) > 0 {
+			skipped = append(skipped, w.skipIgnored(component, state)...)
+		}
 		e := events(component.state, state)
 
 		if e.HasChanged() {
@@ -78,6 +127,10 @@ func (w *watchList) Run(debounce bool) error {
 			changed++
 		}
 	}
+	// The same file can be watched by multiple components
+	for _, path := range skipped {
+		delete(w.ignored, path)
+	}
 
 	// Rapid file changes that are more frequent than the poll interval would trigger
 	// multiple rebuilds.
@@ -74,6 +74,36 @@ func TestFileMonitor(t *testing.T) {
 	}
 }
 
+func TestFileMonitorIgnore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("file", "other")
+
+		monitor := NewMonitor()
+		changed := callback{}
+		err := monitor.Register(tmpDir.List, changed.call)
+		t.CheckNoError(err)
+
+		// Ignored changes don't trigger the callback
+		monitor.Ignore(tmpDir.Path("file"))
+		tmpDir.Chtimes("file", time.Now().Add(2*time.Second))
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, changed.calls())
+
+		// Other changes still do
+		tmpDir.Chtimes("other", time.Now().Add(2*time.Second))
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, changed.calls())
+
+		// Only the next change of an ignored file is skipped
+		tmpDir.Chtimes("file", time.Now().Add(4*time.Second))
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, changed.calls())
+	})
+}
+
 type callback struct {
 	events []Events
 }
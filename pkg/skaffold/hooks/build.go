@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// BuildRunner creates a new runner for pre-build and post-build lifecycle hooks.
+func BuildRunner(d latest_v1.BuildHooks, opts BuildEnvOpts) Runner {
+	return buildRunner{BuildHooks: d, opts: opts}
+}
+
+type buildRunner struct {
+	latest_v1.BuildHooks
+	opts BuildEnvOpts
+}
+
+func (r buildRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PreHooks, preBuild)
+}
+
+func (r buildRunner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PostHooks, postBuild)
+}
+
+func (r buildRunner) run(ctx context.Context, out io.Writer, hooks []latest_v1.HostHook, p phase) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Starting %s hooks for artifact %q...\n", p, r.opts.Image)
+	env := r.opts.env()
+	for _, h := range hooks {
+		hook := hostHook{cfg: h, env: env}
+		if err := hook.run(ctx, out); err != nil {
+			return hookError(p, err)
+		}
+	}
+	color.Default.Fprintf(out, "Completed %s hooks for artifact %q\n", p, r.opts.Image)
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerSelector chooses the containers a container hook is executed in.
+type containerSelector func(v1.Pod, v1.Container) (bool, error)
+
+// containerHook represents a lifecycle hook to be executed inside running containers.
+type containerHook struct {
+	cfg        latest_v1.ContainerHook
+	cli        *kubectl.CLI
+	selector   containerSelector
+	namespaces []string
+	runID      string
+}
+
+// run executes the lifecycle hook in every running container deployed by the current run and matched by the selector.
+func (h containerHook) run(ctx context.Context, out io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	for _, ns := range h.namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.RunIDLabel, h.runID),
+		})
+		if err != nil {
+			return fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+
+		for _, p := range pods.Items {
+			if p.Status.Phase != v1.PodRunning {
+				continue
+			}
+
+			for _, c := range p.Spec.Containers {
+				matched, err := h.selector(p, c)
+				if err != nil {
+					return err
+				}
+				if !matched {
+					continue
+				}
+
+				args := []string{p.Name, "--namespace", p.Namespace, "-c", c.Name, "--"}
+				cmd := h.cli.Command(ctx, "exec", append(args, h.cfg.Command...)...)
+				cmd.Stdout = out
+				cmd.Stderr = out
+				if err := util.RunCmd(cmd); err != nil {
+					return fmt.Errorf("running hook in container %q of pod %q: %w", c.Name, p.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// runningImageSelector selects the containers running the given image.
+func runningImageSelector(image string) containerSelector {
+	return func(_ v1.Pod, c v1.Container) (bool, error) {
+		return c.Image == image, nil
+	}
+}
+
+// namedContainerSelector selects the containers whose pod and container names match the hook's patterns.
+func namedContainerSelector(h latest_v1.NamedContainerHook) containerSelector {
+	return func(p v1.Pod, c v1.Container) (bool, error) {
+		matched, err := filepath.Match(h.PodName, p.Name)
+		if err != nil || !matched {
+			return false, err
+		}
+		if h.ContainerName == "" {
+			return true, nil
+		}
+		return filepath.Match(h.ContainerName, c.Name)
+	}
+}
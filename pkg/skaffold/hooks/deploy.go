@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// DeployRunner creates a new runner for pre-deploy and post-deploy lifecycle hooks.
+func DeployRunner(cli *kubectl.CLI, d latest_v1.DeployHooks, namespaces []string, opts DeployEnvOpts) Runner {
+	return deployRunner{
+		DeployHooks: d,
+		cli:         cli,
+		namespaces:  namespaces,
+		opts:        opts,
+	}
+}
+
+type deployRunner struct {
+	latest_v1.DeployHooks
+	cli        *kubectl.CLI
+	namespaces []string
+	opts       DeployEnvOpts
+}
+
+func (r deployRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PreHooks, preDeploy)
+}
+
+func (r deployRunner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PostHooks, postDeploy)
+}
+
+func (r deployRunner) run(ctx context.Context, out io.Writer, hooks []latest_v1.DeployHookItem, p phase) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Starting %s hooks...\n", p)
+	env := r.opts.env()
+	for _, h := range hooks {
+		if h.HostHook != nil {
+			hook := hostHook{cfg: *h.HostHook, env: env}
+			if err := hook.run(ctx, out); err != nil {
+				return hookError(p, err)
+			}
+		} else if h.ContainerHook != nil {
+			hook := containerHook{
+				cfg:        h.ContainerHook.ContainerHook,
+				cli:        r.cli,
+				selector:   namedContainerSelector(*h.ContainerHook),
+				namespaces: r.namespaces,
+				runID:      r.opts.RunID,
+			}
+			if err := hook.run(ctx, out); err != nil {
+				return hookError(p, err)
+			}
+		}
+	}
+	color.Default.Fprintf(out, "Completed %s hooks\n", p)
+	return nil
+}
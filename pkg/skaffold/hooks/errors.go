@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func hookError(p phase, err error) error {
+	var code proto.StatusCode
+	switch p {
+	case preBuild, postBuild:
+		code = proto.StatusCode_BUILD_LIFECYCLE_HOOK_ERR
+	case preSync, postSync:
+		code = proto.StatusCode_SYNC_LIFECYCLE_HOOK_ERR
+	default:
+		code = proto.StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR
+	}
+
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("%s hook failed: %s", p, err),
+			ErrCode: code,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_LIFECYCLE_HOOK,
+					Action:         fmt.Sprintf("Check the %s lifecycle hooks defined in your skaffold configuration", p),
+				},
+			},
+		})
+}
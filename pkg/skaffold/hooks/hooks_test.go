@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestHostHook(t *testing.T) {
+	tests := []struct {
+		description string
+		hook        latest_v1.HostHook
+		goos        string
+		cmd         *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "runs command with env",
+			hook:        latest_v1.HostHook{Command: []string{"sh", "-c", "echo foo"}},
+			goos:        "linux",
+			cmd:         testutil.CmdRunEnv("sh -c echo foo", []string{"SKAFFOLD_IMAGE=img"}),
+		},
+		{
+			description: "runs command on matching OS",
+			hook:        latest_v1.HostHook{Command: []string{"sh", "-c", "echo foo"}, OS: []string{"darwin", "linux"}},
+			goos:        "linux",
+			cmd:         testutil.CmdRun("sh -c echo foo"),
+		},
+		{
+			description: "skips command on other OS",
+			hook:        latest_v1.HostHook{Command: []string{"sh", "-c", "echo foo"}, OS: []string{"windows"}},
+			goos:        "linux",
+			cmd:         testutil.CmdRun("unexpected"),
+		},
+		{
+			description: "command failure",
+			hook:        latest_v1.HostHook{Command: []string{"sh", "-c", "exit 1"}},
+			goos:        "linux",
+			cmd:         testutil.CmdRunErr("sh -c exit 1", errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&goos, test.goos)
+			t.Override(&util.DefaultExecCommand, test.cmd)
+
+			h := hostHook{cfg: test.hook, env: []string{"SKAFFOLD_IMAGE=img"}}
+			err := h.run(context.Background(), &bytes.Buffer{})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestBuildRunner(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		hooks := latest_v1.BuildHooks{
+			PreHooks:  []latest_v1.HostHook{{Command: []string{"pre"}}},
+			PostHooks: []latest_v1.HostHook{{Command: []string{"post"}}},
+		}
+		opts, err := NewBuildEnvOpts(&latest_v1.Artifact{ImageName: "img", Workspace: "./foo"}, "gcr.io/foo/img:latest")
+		t.CheckNoError(err)
+
+		env := []string{
+			"SKAFFOLD_IMAGE=gcr.io/foo/img:latest",
+			"SKAFFOLD_IMAGE_REPO=gcr.io/foo",
+			"SKAFFOLD_IMAGE_TAG=latest",
+			"SKAFFOLD_BUILD_CONTEXT=./foo",
+		}
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv("pre", env).AndRunEnv("post", env))
+
+		runner := BuildRunner(hooks, opts)
+		var out bytes.Buffer
+		t.CheckNoError(runner.RunPreHooks(context.Background(), &out))
+		t.CheckNoError(runner.RunPostHooks(context.Background(), &out))
+		t.CheckContains(`Starting pre-build hooks for artifact "gcr.io/foo/img:latest"`, out.String())
+		t.CheckContains(`Completed post-build hooks for artifact "gcr.io/foo/img:latest"`, out.String())
+	})
+}
+
+func TestContainerHook(t *testing.T) {
+	tests := []struct {
+		description string
+		selector    containerSelector
+		pods        []runtime.Object
+		cmd         *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "runs in matching containers of the current run",
+			selector:    runningImageSelector("gcr.io/foo/img:latest"),
+			pods: []runtime.Object{
+				pod("pod1", "run-id", v1.PodRunning, "gcr.io/foo/img:latest"),
+				pod("pod2", "other-run-id", v1.PodRunning, "gcr.io/foo/img:latest"),
+				pod("pod3", "run-id", v1.PodPending, "gcr.io/foo/img:latest"),
+				pod("pod4", "run-id", v1.PodRunning, "gcr.io/foo/other:latest"),
+			},
+			cmd: testutil.CmdRun("kubectl --context kubecontext exec pod1 --namespace np1 -c container -- foo"),
+		},
+		{
+			description: "runs in named containers",
+			selector:    namedContainerSelector(latest_v1.NamedContainerHook{PodName: "app-*", ContainerName: "container"}),
+			pods: []runtime.Object{
+				pod("app-1", "run-id", v1.PodRunning, "gcr.io/foo/img:latest"),
+				pod("other", "run-id", v1.PodRunning, "gcr.io/foo/img:latest"),
+			},
+			cmd: testutil.CmdRun("kubectl --context kubecontext exec app-1 --namespace np1 -c container -- foo"),
+		},
+		{
+			description: "command failure",
+			selector:    runningImageSelector("gcr.io/foo/img:latest"),
+			pods:        []runtime.Object{pod("pod1", "run-id", v1.PodRunning, "gcr.io/foo/img:latest")},
+			cmd:         testutil.CmdRunErr("kubectl --context kubecontext exec pod1 --namespace np1 -c container -- foo", errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.cmd)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) {
+				return fakekubeclientset.NewSimpleClientset(test.pods...), nil
+			})
+
+			h := containerHook{
+				cfg:        latest_v1.ContainerHook{Command: []string{"foo"}},
+				cli:        &kubectl.CLI{KubeContext: "kubecontext"},
+				selector:   test.selector,
+				namespaces: []string{"np1"},
+				runID:      "run-id",
+			}
+			err := h.run(context.Background(), &bytes.Buffer{})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestSyncRunner(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		hooks := latest_v1.SyncHooks{
+			PreHooks:  []latest_v1.SyncHookItem{{HostHook: &latest_v1.HostHook{Command: []string{"pre"}}}},
+			PostHooks: []latest_v1.SyncHookItem{{ContainerHook: &latest_v1.ContainerHook{Command: []string{"post"}}}},
+		}
+		opts := NewSyncEnvOpts(&latest_v1.Artifact{ImageName: "img", Workspace: "./foo"}, "gcr.io/foo/img:latest", []string{"foo1", "foo2"}, []string{"foo3"}, []string{"np1"}, "kubecontext")
+
+		env := []string{
+			"SKAFFOLD_IMAGE=gcr.io/foo/img:latest",
+			"SKAFFOLD_BUILD_CONTEXT=./foo",
+			"SKAFFOLD_FILES_ADDED_OR_MODIFIED=foo1,foo2",
+			"SKAFFOLD_FILES_DELETED=foo3",
+			"SKAFFOLD_KUBE_CONTEXT=kubecontext",
+			"SKAFFOLD_NAMESPACES=np1",
+		}
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv("pre", env).
+			AndRun("kubectl --context kubecontext exec pod1 --namespace np1 -c container -- post"))
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(pod("pod1", "run-id", v1.PodRunning, "gcr.io/foo/img:latest")), nil
+		})
+
+		runner := SyncRunner(&kubectl.CLI{KubeContext: "kubecontext"}, "gcr.io/foo/img:latest", []string{"np1"}, "run-id", hooks, opts)
+		var out bytes.Buffer
+		t.CheckNoError(runner.RunPreHooks(context.Background(), &out))
+		t.CheckNoError(runner.RunPostHooks(context.Background(), &out))
+		t.CheckContains(`Starting pre-sync hooks for artifact "gcr.io/foo/img:latest"`, out.String())
+		t.CheckContains(`Completed post-sync hooks for artifact "gcr.io/foo/img:latest"`, out.String())
+	})
+}
+
+func TestDeployRunner(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		hooks := latest_v1.DeployHooks{
+			PreHooks: []latest_v1.DeployHookItem{{HostHook: &latest_v1.HostHook{Command: []string{"pre"}}}},
+			PostHooks: []latest_v1.DeployHookItem{{ContainerHook: &latest_v1.NamedContainerHook{
+				ContainerHook: latest_v1.ContainerHook{Command: []string{"post"}},
+				PodName:       "pod*",
+			}}},
+		}
+		opts := NewDeployEnvOpts("run-id", "kubecontext", []string{"np1"})
+
+		env := []string{
+			"SKAFFOLD_RUN_ID=run-id",
+			"SKAFFOLD_KUBE_CONTEXT=kubecontext",
+			"SKAFFOLD_NAMESPACES=np1",
+		}
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv("pre", env).
+			AndRun("kubectl --context kubecontext exec pod1 --namespace np1 -c container -- post"))
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) {
+			return fakekubeclientset.NewSimpleClientset(pod("pod1", "run-id", v1.PodRunning, "gcr.io/foo/img:latest")), nil
+		})
+
+		runner := DeployRunner(&kubectl.CLI{KubeContext: "kubecontext"}, hooks, []string{"np1"}, opts)
+		var out bytes.Buffer
+		t.CheckNoError(runner.RunPreHooks(context.Background(), &out))
+		t.CheckNoError(runner.RunPostHooks(context.Background(), &out))
+		t.CheckContains("Starting pre-deploy hooks...", out.String())
+		t.CheckContains("Completed post-deploy hooks", out.String())
+	})
+}
+
+func pod(name string, runID string, phase v1.PodPhase, image string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "np1",
+			Labels:    map[string]string{label.RunIDLabel: runID},
+		},
+		Status: v1.PodStatus{Phase: phase},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container", Image: image}},
+		},
+	}
+}
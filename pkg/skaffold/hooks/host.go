@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// for testing
+var goos = runtime.GOOS
+
+// hostHook represents a lifecycle hook to be executed on the host machine.
+type hostHook struct {
+	cfg latest_v1.HostHook
+	env []string
+}
+
+// run executes the lifecycle hook on the host machine.
+func (h hostHook) run(ctx context.Context, out io.Writer) error {
+	if len(h.cfg.OS) > 0 && !util.StrSliceContains(h.cfg.OS, goos) {
+		logrus.Infof("Skipping host hook %q: it only runs on %s", strings.Join(h.cfg.Command, " "), strings.Join(h.cfg.OS, ", "))
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, h.cfg.Command[0], h.cfg.Command[1:]...)
+	cmd.Env = append(os.Environ(), h.env...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	return util.RunCmd(cmd)
+}
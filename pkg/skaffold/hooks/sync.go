@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// SyncRunner creates a new runner for pre-sync and post-sync lifecycle hooks.
+func SyncRunner(cli *kubectl.CLI, image string, namespaces []string, runID string, d latest_v1.SyncHooks, opts SyncEnvOpts) Runner {
+	return syncRunner{
+		SyncHooks:  d,
+		cli:        cli,
+		image:      image,
+		namespaces: namespaces,
+		runID:      runID,
+		opts:       opts,
+	}
+}
+
+type syncRunner struct {
+	latest_v1.SyncHooks
+	cli        *kubectl.CLI
+	image      string
+	namespaces []string
+	runID      string
+	opts       SyncEnvOpts
+}
+
+func (r syncRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PreHooks, preSync)
+}
+
+func (r syncRunner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PostHooks, postSync)
+}
+
+func (r syncRunner) run(ctx context.Context, out io.Writer, hooks []latest_v1.SyncHookItem, p phase) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Starting %s hooks for artifact %q...\n", p, r.image)
+	env := r.opts.env()
+	for _, h := range hooks {
+		if h.HostHook != nil {
+			hook := hostHook{cfg: *h.HostHook, env: env}
+			if err := hook.run(ctx, out); err != nil {
+				return hookError(p, err)
+			}
+		} else if h.ContainerHook != nil {
+			hook := containerHook{
+				cfg:        *h.ContainerHook,
+				cli:        r.cli,
+				selector:   runningImageSelector(r.image),
+				namespaces: r.namespaces,
+				runID:      r.runID,
+			}
+			if err := hook.run(ctx, out); err != nil {
+				return hookError(p, err)
+			}
+		}
+	}
+	color.Default.Fprintf(out, "Completed %s hooks for artifact %q\n", p, r.image)
+	return nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+)
+
+// phase represents the skaffold phase a lifecycle hook is attached to.
+type phase string
+
+const (
+	preBuild   phase = "pre-build"
+	postBuild  phase = "post-build"
+	preSync    phase = "pre-sync"
+	postSync   phase = "post-sync"
+	preDeploy  phase = "pre-deploy"
+	postDeploy phase = "post-deploy"
+)
+
+// Runner executes the lifecycle hooks defined for a skaffold phase.
+type Runner interface {
+	// RunPreHooks executes all the hooks that need to run before the phase.
+	RunPreHooks(ctx context.Context, out io.Writer) error
+	// RunPostHooks executes all the hooks that need to run after the phase.
+	RunPostHooks(ctx context.Context, out io.Writer) error
+}
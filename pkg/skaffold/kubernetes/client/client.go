@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
 
 	// Initialize all known client auth plugins
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -30,12 +31,21 @@ import (
 
 // for tests
 var (
-	Client        = getClientset
-	DynamicClient = getDynamicClient
+	Client               = getClientset
+	DynamicClient        = getDynamicClient
+	RestConfig           = getRestConfig
+	ClientForContext     = getClientsetForContext
+	RestConfigForContext = getRestConfigForContext
 )
 
+// getRestConfig returns the REST config shared by the Kubernetes clients and by the
+// streaming connections (exec, port-forward) made directly against the Kubernetes API.
+func getRestConfig() (*restclient.Config, error) {
+	return context.GetRestClientConfig()
+}
+
 func getClientset() (kubernetes.Interface, error) {
-	config, err := context.GetRestClientConfig()
+	config, err := RestConfig()
 	if err != nil {
 		return nil, fmt.Errorf("getting client config for Kubernetes client: %w", err)
 	}
@@ -43,9 +53,28 @@ func getClientset() (kubernetes.Interface, error) {
 }
 
 func getDynamicClient() (dynamic.Interface, error) {
-	config, err := context.GetRestClientConfig()
+	config, err := RestConfig()
 	if err != nil {
 		return nil, fmt.Errorf("getting client config for dynamic client: %w", err)
 	}
 	return dynamic.NewForConfig(config)
 }
+
+// getRestConfigForContext returns the REST config for the given kube-context, or for the current one when empty.
+func getRestConfigForContext(kubeContext string) (*restclient.Config, error) {
+	if kubeContext == "" {
+		return RestConfig()
+	}
+	return context.GetRestClientConfigForContext(kubeContext)
+}
+
+func getClientsetForContext(kubeContext string) (kubernetes.Interface, error) {
+	if kubeContext == "" {
+		return Client()
+	}
+	config, err := RestConfigForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for Kubernetes client of context %q: %w", kubeContext, err)
+	}
+	return kubernetes.NewForConfig(config)
+}
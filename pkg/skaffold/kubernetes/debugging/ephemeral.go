@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+)
+
+var (
+	// ErrEphemeralContainersUnsupported is returned when the cluster doesn't support ephemeral containers.
+	ErrEphemeralContainersUnsupported = errors.New("ephemeral containers are not supported by the cluster")
+
+	// ErrNoAttachableContainers is returned when no running container could be attached to.
+	ErrNoAttachableContainers = errors.New("no running container supports attaching a debugger")
+
+	// For testing
+	ephemeralDebugContainer = debug.EphemeralDebugContainer
+)
+
+// AttachDebuggers injects ephemeral containers that attach debuggers to the running containers of the given
+// images, and records their debug configuration in the pods' annotations, so that the debug ports are
+// forwarded and the containers are reported like the ones of redeployed pods.
+// The images map the deployed image names, with the default repo applied, to the artifacts' image names.
+// Returns the container images that debuggers were attached to.
+func AttachDebuggers(ctx context.Context, out io.Writer, images map[string]string, namespaces []string, registries manifest.Registries) ([]string, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	var attached []string
+	for _, ns := range namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if pod.Status.Phase != v1.PodRunning {
+				continue
+			}
+
+			configurations, err := debugConfigurations(pod)
+			if err != nil {
+				logrus.Warnf("Skipping pod %s/%s: %s", pod.Namespace, pod.Name, err)
+				continue
+			}
+
+			for _, c := range pod.Spec.Containers {
+				artifact := matchingImage(c.Image, images)
+				if _, debugged := configurations[c.Name]; artifact == "" || debugged {
+					continue
+				}
+
+				ephemeral, configuration, err := ephemeralDebugContainer(ctx, pod, c, artifact, registries)
+				if err != nil {
+					logrus.Warnf("Unable to attach a debugger to %s/%s: %s", pod.Name, c.Name, err)
+					continue
+				}
+
+				if err := addEphemeralContainer(ctx, pod, *ephemeral); err != nil {
+					return attached, err
+				}
+				configurations[c.Name] = configuration
+				if err := annotatePod(ctx, pod, configurations); err != nil {
+					return attached, err
+				}
+
+				color.Default.Fprintf(out, "Attached a %s debugger to %s/%s\n", configuration.Runtime, pod.Name, c.Name)
+				attached = append(attached, c.Image)
+			}
+		}
+	}
+
+	if len(attached) == 0 {
+		return nil, ErrNoAttachableContainers
+	}
+	return attached, nil
+}
+
+// matchingImage returns the artifact's image name for the image that the container runs, ignoring the tag and digest,
+// or "" if there's none.
+func matchingImage(containerImage string, images map[string]string) string {
+	ref, err := docker.ParseReference(containerImage)
+	if err != nil {
+		return ""
+	}
+	return images[ref.BaseName]
+}
+
+func debugConfigurations(pod *v1.Pod) (map[string]debug.ContainerDebugConfiguration, error) {
+	configurations := map[string]debug.ContainerDebugConfiguration{}
+	if annotation, found := pod.Annotations[debug.DebugConfigAnnotation]; found {
+		if err := json.Unmarshal([]byte(annotation), &configurations); err != nil {
+			return nil, fmt.Errorf("unable to parse debug-config %q: %w", annotation, err)
+		}
+	}
+	return configurations, nil
+}
+
+func addEphemeralContainer(ctx context.Context, pod *v1.Pod, ephemeral v1.EphemeralContainer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	pods := client.CoreV1().Pods(pod.Namespace)
+
+	containers, err := pods.GetEphemeralContainers(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return ephemeralContainersErr(err)
+	}
+	containers.EphemeralContainers = append(containers.EphemeralContainers, ephemeral)
+	if _, err := pods.UpdateEphemeralContainers(ctx, pod.Name, containers, metav1.UpdateOptions{}); err != nil {
+		return ephemeralContainersErr(err)
+	}
+	return nil
+}
+
+func ephemeralContainersErr(err error) error {
+	// the subresource is missing when the EphemeralContainers feature gate is disabled
+	if apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+		return fmt.Errorf("%w: %s", ErrEphemeralContainersUnsupported, err)
+	}
+	return fmt.Errorf("adding ephemeral container: %w", err)
+}
+
+func annotatePod(ctx context.Context, pod *v1.Pod, configurations map[string]debug.ContainerDebugConfiguration) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	encoded, err := json.Marshal(configurations)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{debug.DebugConfigAnnotation: string(encoded)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("annotating pod %q: %w", pod.Name, err)
+	}
+	return nil
+}
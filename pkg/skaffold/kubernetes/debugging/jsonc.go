@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// VS Code's `launch.json` is JSON with comments and trailing commas (JSONC), which `encoding/json`
+// rejects.  Rather than re-encoding the whole file, which would lose the user's comments and key order,
+// the file is scanned for the offsets of the `configurations` array and only the generated
+// configurations are replaced.
+
+// jsoncMember is an object member or an array element, as offsets into the scanned buffer.
+type jsoncMember struct {
+	key        string // object members only
+	start      int    // start of the key, or of the value for array elements
+	valueStart int
+	end        int // end of the value, exclusive
+}
+
+type jsoncScanner struct {
+	buf []byte
+}
+
+// skip returns the offset of the first character at or after i that isn't whitespace or a comment.
+func (s jsoncScanner) skip(i int) int {
+	for i < len(s.buf) {
+		switch {
+		case s.buf[i] == ' ' || s.buf[i] == '\t' || s.buf[i] == '\n' || s.buf[i] == '\r':
+			i++
+		case bytes.HasPrefix(s.buf[i:], []byte("//")):
+			end := bytes.IndexByte(s.buf[i:], '\n')
+			if end < 0 {
+				return len(s.buf)
+			}
+			i += end + 1
+		case bytes.HasPrefix(s.buf[i:], []byte("/*")):
+			end := bytes.Index(s.buf[i+2:], []byte("*/"))
+			if end < 0 {
+				return len(s.buf)
+			}
+			i += end + 4
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// str returns the end of the string starting at i.
+func (s jsoncScanner) str(i int) (int, error) {
+	for j := i + 1; j < len(s.buf); j++ {
+		switch s.buf[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string at offset %d", i)
+}
+
+// value returns the end of the value starting at i.
+func (s jsoncScanner) value(i int) (int, error) {
+	if i >= len(s.buf) {
+		return 0, fmt.Errorf("unexpected end of input")
+	}
+	switch s.buf[i] {
+	case '{', '[':
+		_, closing, err := s.members(i)
+		return closing + 1, err
+	case '"':
+		return s.str(i)
+	}
+	j := i
+	for j < len(s.buf) && !strings.ContainsRune(",:]}[{\" \t\r\n/", rune(s.buf[j])) {
+		j++
+	}
+	if j == i {
+		return 0, fmt.Errorf("unexpected %q at offset %d", s.buf[i], i)
+	}
+	return j, nil
+}
+
+// members scans the object or array starting at i and returns its members and the offset of its closing character.
+func (s jsoncScanner) members(i int) ([]jsoncMember, int, error) {
+	object := s.buf[i] == '{'
+	closing := byte(']')
+	if object {
+		closing = '}'
+	}
+
+	var members []jsoncMember
+	for i = s.skip(i + 1); ; i = s.skip(i + 1) {
+		if i >= len(s.buf) {
+			return nil, 0, fmt.Errorf("unexpected end of input")
+		}
+		if s.buf[i] == closing {
+			return members, i, nil
+		}
+
+		m := jsoncMember{start: i, valueStart: i}
+		if object {
+			if s.buf[i] != '"' {
+				return nil, 0, fmt.Errorf("expected a key at offset %d", i)
+			}
+			end, err := s.str(i)
+			if err != nil {
+				return nil, 0, err
+			}
+			if err := json.Unmarshal(s.buf[i:end], &m.key); err != nil {
+				return nil, 0, err
+			}
+			if i = s.skip(end); i >= len(s.buf) || s.buf[i] != ':' {
+				return nil, 0, fmt.Errorf("expected ':' at offset %d", i)
+			}
+			m.valueStart = s.skip(i + 1)
+		}
+		end, err := s.value(m.valueStart)
+		if err != nil {
+			return nil, 0, err
+		}
+		m.end = end
+		members = append(members, m)
+
+		if i = s.skip(end); i < len(s.buf) && s.buf[i] == closing {
+			return members, i, nil
+		}
+		if i >= len(s.buf) || s.buf[i] != ',' {
+			return nil, 0, fmt.Errorf("expected ',' or '%c' at offset %d", closing, i)
+		}
+	}
+}
+
+// toJSON strips the comments and trailing commas of buf[start:end].
+func (s jsoncScanner) toJSON(start, end int) []byte {
+	var out []byte
+	for i := start; i < end; {
+		switch c := s.buf[i]; {
+		case c == '"':
+			j, err := s.str(i)
+			if err != nil || j > end {
+				j = end
+			}
+			out = append(out, s.buf[i:j]...)
+			i = j
+		case c == '/' && (bytes.HasPrefix(s.buf[i:], []byte("//")) || bytes.HasPrefix(s.buf[i:], []byte("/*"))):
+			j := s.skip(i)
+			if j > end {
+				j = end
+			}
+			out = append(out, ' ')
+			i = j
+		case c == ',':
+			if j := s.skip(i + 1); j < end && (s.buf[j] == ']' || s.buf[j] == '}') {
+				i++
+				continue
+			}
+			fallthrough
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+	return out
+}
+
+// jsoncToJSON strips the comments and trailing commas of a JSONC document.
+func jsoncToJSON(buf []byte) []byte {
+	return jsoncScanner{buf}.toJSON(0, len(buf))
+}
+
+// lineIndent returns the whitespace that starts the line of offset i, or def if there is anything else before i on that line.
+func lineIndent(buf []byte, i int, def string) string {
+	indent := buf[bytes.LastIndexByte(buf[:i], '\n')+1 : i]
+	if len(indent) == 0 || len(bytes.Trim(indent, " \t")) > 0 {
+		return def
+	}
+	return string(indent)
+}
+
+// spliceLaunchConfigurations replaces the generated configurations of the `launch.json` document buf with
+// the given configurations, leaving the rest of the document as it is.  It reports whether buf was changed.
+func spliceLaunchConfigurations(buf []byte, configurations []interface{}) ([]byte, bool, error) {
+	s := jsoncScanner{buf}
+	start := s.skip(0)
+	if start >= len(buf) || buf[start] != '{' {
+		return nil, false, fmt.Errorf("expected an object")
+	}
+	members, _, err := s.members(start)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var found *jsoncMember
+	for i := range members {
+		if members[i].key == "configurations" {
+			found = &members[i]
+		}
+	}
+
+	// No `configurations` yet: add one after the last member.
+	if found == nil {
+		if len(configurations) == 0 {
+			return buf, false, nil
+		}
+		array, err := json.MarshalIndent(configurations, "\t", "\t")
+		if err != nil {
+			return nil, false, err
+		}
+		member := "\n\t\"configurations\": " + string(array)
+
+		var updated []byte
+		switch {
+		case len(members) == 0:
+			updated = splice(buf, start+1, start+1, member+"\n")
+		case buf[s.skip(members[len(members)-1].end)] == ',':
+			comma := s.skip(members[len(members)-1].end)
+			updated = splice(buf, comma+1, comma+1, member)
+		default:
+			end := members[len(members)-1].end
+			updated = splice(buf, end, end, ","+member)
+		}
+		return updated, true, nil
+	}
+
+	if buf[found.valueStart] != '[' {
+		return nil, false, fmt.Errorf("expected \"configurations\" to be an array")
+	}
+	elements, arrayEnd, err := s.members(found.valueStart)
+	if err != nil {
+		return nil, false, err
+	}
+
+	outerIndent := lineIndent(buf, found.start, "\t")
+	indent := outerIndent + "\t"
+	if len(elements) > 0 {
+		indent = lineIndent(buf, elements[0].start, indent)
+	}
+	unit := "\t"
+	if strings.HasPrefix(indent, outerIndent) && len(indent) > len(outerIndent) {
+		unit = indent[len(outerIndent):]
+	}
+
+	// Keep the user's elements verbatim, with the text that precedes them.
+	var items []string
+	removed := 0
+	prev := found.valueStart + 1
+	for _, e := range elements {
+		end := e.end
+		if comma := s.skip(e.end); buf[comma] == ',' {
+			end = comma
+		}
+
+		var c struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(s.toJSON(e.valueStart, e.end), &c) == nil && strings.HasPrefix(c.Name, launchConfigPrefix) {
+			removed++
+		} else {
+			items = append(items, string(buf[prev:end]))
+		}
+		prev = end
+		if buf[end] == ',' {
+			prev++
+		}
+	}
+	if removed == 0 && len(configurations) == 0 {
+		return buf, false, nil
+	}
+
+	for _, c := range configurations {
+		b, err := json.MarshalIndent(c, indent, unit)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, "\n"+indent+string(b))
+	}
+
+	tail := string(buf[prev:arrayEnd])
+	if len(configurations) > 0 && !strings.Contains(tail, "\n") {
+		tail = strings.TrimRight(tail, " \t") + "\n" + outerIndent
+	}
+	updated := splice(buf, found.valueStart, arrayEnd+1, "["+strings.Join(items, ",")+tail+"]")
+	return updated, !bytes.Equal(updated, buf), nil
+}
+
+// splice returns a copy of buf with buf[start:end] replaced by text.
+func splice(buf []byte, start, end int, text string) []byte {
+	updated := make([]byte, 0, len(buf)-(end-start)+len(text))
+	updated = append(updated, buf[:start]...)
+	updated = append(updated, text...)
+	return append(updated, buf[end:]...)
+}
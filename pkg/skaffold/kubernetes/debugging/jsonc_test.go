@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSpliceLaunchConfigurations(t *testing.T) {
+	generated := map[string]interface{}{"name": "Skaffold: pod/app", "port": 5005}
+
+	tests := []struct {
+		description    string
+		launchJSON     string
+		configurations []interface{}
+		expected       string
+		expectChanged  bool
+		shouldErr      bool
+	}{
+		{
+			description: "nothing to do",
+			launchJSON: `{
+	// comment
+	"configurations": [
+		{"name": "Mine"}, // trailing comma
+	],
+}`,
+			expected: `{
+	// comment
+	"configurations": [
+		{"name": "Mine"}, // trailing comma
+	],
+}`,
+		},
+		{
+			description: "append after the user's configurations",
+			launchJSON: `{
+	"version": "0.2.0", // comment
+	"configurations": [
+		// mine
+		{"name": "Mine"},
+	],
+	"compounds": []
+}`,
+			configurations: []interface{}{generated},
+			expected: `{
+	"version": "0.2.0", // comment
+	"configurations": [
+		// mine
+		{"name": "Mine"},
+		{
+			"name": "Skaffold: pod/app",
+			"port": 5005
+		}
+	],
+	"compounds": []
+}`,
+			expectChanged: true,
+		},
+		{
+			description: "replace generated configurations",
+			launchJSON: `{
+    "configurations": [
+        {
+            "name": "Skaffold: old/app" /* stale */
+        },
+        {"name": "Mine"}
+    ]
+}`,
+			configurations: []interface{}{generated},
+			expected: `{
+    "configurations": [
+        {"name": "Mine"},
+        {
+            "name": "Skaffold: pod/app",
+            "port": 5005
+        }
+    ]
+}`,
+			expectChanged: true,
+		},
+		{
+			description: "remove generated configurations",
+			launchJSON: `{
+	"configurations": [
+		{"name": "Skaffold: pod/app", "port": 5005}
+	]
+}`,
+			expected: `{
+	"configurations": [
+	]
+}`,
+			expectChanged: true,
+		},
+		{
+			description:    "add missing configurations",
+			launchJSON:     `{"version": "0.2.0",}`,
+			configurations: []interface{}{generated},
+			expected: `{"version": "0.2.0",
+	"configurations": [
+		{
+			"name": "Skaffold: pod/app",
+			"port": 5005
+		}
+	]}`,
+			expectChanged: true,
+		},
+		{
+			description:    "add configurations to empty object",
+			launchJSON:     `{}`,
+			configurations: []interface{}{generated},
+			expected: `{
+	"configurations": [
+		{
+			"name": "Skaffold: pod/app",
+			"port": 5005
+		}
+	]
+}`,
+			expectChanged: true,
+		},
+		{
+			description: "configurations isn't an array",
+			launchJSON:  `{"configurations": {}}`,
+			shouldErr:   true,
+		},
+		{
+			description: "unterminated object",
+			launchJSON:  `{"configurations": [`,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			updated, changed, err := spliceLaunchConfigurations([]byte(test.launchJSON), test.configurations)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, string(updated))
+				t.CheckDeepEqual(test.expectChanged, changed)
+			}
+		})
+	}
+}
+
+func TestJSONCToJSON(t *testing.T) {
+	jsonc := `{
+	// line comment
+	"a": "// not a comment, ]", /* block */
+	"b": [1, 2,],
+}`
+
+	var parsed map[string]interface{}
+	err := json.Unmarshal(jsoncToJSON([]byte(jsonc)), &parsed)
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, map[string]interface{}{
+		"a": "// not a comment, ]",
+		"b": []interface{}{float64(1), float64(2)},
+	}, parsed)
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+const (
+	// launchConfigPrefix prefixes the names of the generated launch configurations, so that they
+	// can be told apart from the user's own configurations.
+	launchConfigPrefix = "Skaffold: "
+
+	vscodeLaunchFile    = ".vscode/launch.json"
+	jetbrainsConfigsDir = ".idea/runConfigurations"
+	jetbrainsFilePrefix = "Skaffold_"
+)
+
+var (
+	errLaunchConfigWriterStopped = errors.New("launch configuration writer stopped")
+	unsafeFileNameChars          = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+	// For testing
+	forEachEvent = event.ForEachEvent
+)
+
+// LaunchConfigWriter writes the IDE launch configurations that attach to the forwarded debug ports
+// of the debuggable containers: configurations in `.vscode/launch.json` and JetBrains run configurations
+// in `.idea/runConfigurations`.  The configurations are kept up-to-date from the debugging container
+// and port-forward events, and are removed when the writer is stopped.
+type LaunchConfigWriter struct {
+	workingDir string
+	workspaces map[string]string // artifact image name -> absolute workspace
+
+	lock       sync.Mutex
+	stopped    bool
+	containers map[string]*proto.DebuggingContainerEvent // key: namespace/pod/container
+	forwarded  map[string]*proto.PortEvent               // key: namespace/pod/container/remote port
+	written    map[string]bool                           // JetBrains configuration files
+	created    []string                                  // files and directories created by the writer
+}
+
+// NewLaunchConfigWriter returns a writer for the launch configurations of a project rooted at `workingDir`.
+func NewLaunchConfigWriter(workingDir string, artifacts []*latest_v1.Artifact) *LaunchConfigWriter {
+	workspaces := map[string]string{}
+	for _, a := range artifacts {
+		workspace := a.Workspace
+		if !filepath.IsAbs(workspace) {
+			workspace = filepath.Join(workingDir, workspace)
+		}
+		workspaces[a.ImageName] = workspace
+	}
+
+	return &LaunchConfigWriter{
+		workingDir: workingDir,
+		workspaces: workspaces,
+		containers: map[string]*proto.DebuggingContainerEvent{},
+		forwarded:  map[string]*proto.PortEvent{},
+		written:    map[string]bool{},
+	}
+}
+
+// Start listens to the debugging container and port-forward events until the context is cancelled.
+func (w *LaunchConfigWriter) Start(ctx context.Context) {
+	if w == nil {
+		// launch configurations not requested
+		return
+	}
+
+	go func() {
+		err := forEachEvent(func(entry *proto.LogEntry) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return w.handle(entry.GetEvent())
+		})
+		if err != nil && !errors.Is(err, errLaunchConfigWriterStopped) && !errors.Is(err, context.Canceled) {
+			logrus.Warnln("Unable to update launch configurations:", err)
+		}
+	}()
+}
+
+// Stop removes the launch configurations that were written.
+func (w *LaunchConfigWriter) Stop() {
+	// if nil then launch configurations were not requested
+	if w == nil {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.stopped = true
+	w.containers = map[string]*proto.DebuggingContainerEvent{}
+	if err := w.write(); err != nil {
+		logrus.Warnln("Unable to remove launch configurations:", err)
+	}
+	// remove the created files and directories, most nested first, if they are now empty
+	for i := len(w.created) - 1; i >= 0; i-- {
+		if isEmpty(w.created[i]) {
+			os.Remove(w.created[i])
+		}
+	}
+}
+
+func (w *LaunchConfigWriter) handle(ev *proto.Event) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.stopped {
+		return errLaunchConfigWriterStopped
+	}
+
+	switch {
+	case ev.GetDebuggingContainerEvent() != nil:
+		de := ev.GetDebuggingContainerEvent()
+		key := de.Namespace + "/" + de.PodName + "/" + de.ContainerName
+		switch de.Status {
+		case event.Started:
+			w.containers[key] = de
+		case event.Terminated:
+			delete(w.containers, key)
+		}
+
+	case ev.GetPortEvent() != nil:
+		pe := ev.GetPortEvent()
+		if pe.PodName == "" || pe.ContainerName == "" {
+			return nil
+		}
+		key := fmt.Sprintf("%s/%s/%s/%d", pe.Namespace, pe.PodName, pe.ContainerName, pe.RemotePort)
+		w.forwarded[key] = pe
+
+	default:
+		return nil
+	}
+
+	if err := w.write(); err != nil {
+		logrus.Warnln("Unable to update launch configurations:", err)
+	}
+	return nil
+}
+
+// debugTarget is a forwarded debug port of a debuggable container.
+type debugTarget struct {
+	name       string
+	runtime    string
+	portName   string
+	host       string
+	port       int32
+	localRoot  string // relative to the working directory when possible
+	remoteRoot string
+}
+
+// targets returns the forwarded debug ports of the debuggable containers, sorted by name.
+func (w *LaunchConfigWriter) targets() []debugTarget {
+	var targets []debugTarget
+	for key, c := range w.containers {
+		for portName, port := range c.DebugPorts {
+			pe, found := w.forwarded[fmt.Sprintf("%s/%d", key, port)]
+			if !found {
+				continue
+			}
+			host := pe.Address
+			if host == "" {
+				host = "localhost"
+			}
+
+			name := fmt.Sprintf("%s%s/%s", launchConfigPrefix, c.PodName, c.ContainerName)
+			if len(c.DebugPorts) > 1 {
+				name += " (" + portName + ")"
+			}
+			localRoot := w.workspaces[c.Artifact]
+			if rel, err := filepath.Rel(w.workingDir, localRoot); err == nil && localRoot != "" && !strings.HasPrefix(rel, "..") {
+				localRoot = filepath.ToSlash(rel)
+			}
+
+			targets = append(targets, debugTarget{
+				name:       name,
+				runtime:    c.Runtime,
+				portName:   portName,
+				host:       host,
+				port:       pe.LocalPort,
+				localRoot:  localRoot,
+				remoteRoot: c.WorkingDir,
+			})
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].name < targets[j].name })
+	return targets
+}
+
+func (w *LaunchConfigWriter) write() error {
+	targets := w.targets()
+	if err := w.writeVSCode(targets); err != nil {
+		return err
+	}
+	return w.writeJetBrains(targets)
+}
+
+// writeVSCode replaces the generated configurations of `.vscode/launch.json`, keeping the rest of the file as it is.
+func (w *LaunchConfigWriter) writeVSCode(targets []debugTarget) error {
+	file := filepath.Join(w.workingDir, vscodeLaunchFile)
+
+	var configurations []interface{}
+	for _, t := range targets {
+		if c := vscodeConfiguration(t); c != nil {
+			configurations = append(configurations, c)
+		}
+	}
+
+	buf, err := ioutil.ReadFile(file)
+	switch {
+	case os.IsNotExist(err):
+		if len(configurations) == 0 {
+			return nil
+		}
+		launch := struct {
+			Version        string        `json:"version"`
+			Configurations []interface{} `json:"configurations"`
+		}{"0.2.0", configurations}
+		updated, err := json.MarshalIndent(launch, "", "\t")
+		if err != nil {
+			return err
+		}
+		return w.writeFile(file, append(updated, '\n'))
+	case err != nil:
+		return err
+	}
+
+	updated, changed, err := spliceLaunchConfigurations(buf, configurations)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", file, err)
+	}
+	if !changed {
+		return nil
+	}
+	return w.writeFile(file, updated)
+}
+
+// vscodeConfiguration returns the attach configuration for the runtime's usual VS Code extension.
+func vscodeConfiguration(t debugTarget) map[string]interface{} {
+	localRoot := t.localRoot
+	if !filepath.IsAbs(localRoot) {
+		localRoot = strings.TrimSuffix("${workspaceFolder}/"+localRoot, "/.")
+	}
+
+	c := map[string]interface{}{
+		"name":    t.name,
+		"request": "attach",
+	}
+	switch {
+	case t.runtime == "go" && t.portName == "dlv":
+		c["type"] = "go"
+		c["mode"] = "remote"
+		c["host"] = t.host
+		c["port"] = t.port
+		if t.remoteRoot != "" {
+			c["substitutePath"] = []map[string]string{{"from": localRoot, "to": t.remoteRoot}}
+		}
+	case t.runtime == "nodejs" && t.portName == "devtools":
+		c["type"] = "node"
+		c["address"] = t.host
+		c["port"] = t.port
+		if t.remoteRoot != "" {
+			c["localRoot"] = localRoot
+			c["remoteRoot"] = t.remoteRoot
+		}
+	case t.runtime == "python" && t.portName == "dap":
+		c["type"] = "python"
+		c["connect"] = map[string]interface{}{"host": t.host, "port": t.port}
+		if t.remoteRoot != "" {
+			c["pathMappings"] = []map[string]string{{"localRoot": localRoot, "remoteRoot": t.remoteRoot}}
+		}
+	case t.runtime == "jvm" && t.portName == "jdwp":
+		c["type"] = "java"
+		c["hostName"] = t.host
+		c["port"] = t.port
+	case t.runtime == "ruby" && t.portName == "dap":
+		c["type"] = "rdbg"
+		c["debugPort"] = fmt.Sprintf("%s:%d", t.host, t.port)
+		if t.remoteRoot != "" {
+			c["localfsMap"] = t.remoteRoot + ":" + localRoot
+		}
+	default:
+		return nil
+	}
+	return c
+}
+
+type jetbrainsComponent struct {
+	XMLName       xml.Name               `xml:"component"`
+	Name          string                 `xml:"name,attr"`
+	Configuration jetbrainsConfiguration `xml:"configuration"`
+}
+
+type jetbrainsConfiguration struct {
+	Default     bool               `xml:"default,attr"`
+	Name        string             `xml:"name,attr"`
+	Type        string             `xml:"type,attr"`
+	FactoryName string             `xml:"factoryName,attr"`
+	Host        string             `xml:"host,attr,omitempty"`
+	Port        string             `xml:"port,attr,omitempty"`
+	Options     []jetbrainsOption  `xml:"option"`
+	Mappings    []jetbrainsMapping `xml:"mappings>mapping"`
+	Method      jetbrainsMethod    `xml:"method"`
+}
+
+type jetbrainsOption struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type jetbrainsMapping struct {
+	URL       string `xml:"url,attr"`
+	LocalFile string `xml:"local-file,attr"`
+}
+
+type jetbrainsMethod struct {
+	V string `xml:"v,attr"`
+}
+
+// writeJetBrains writes one run configuration file per target, and removes the stale ones.
+func (w *LaunchConfigWriter) writeJetBrains(targets []debugTarget) error {
+	dir := filepath.Join(w.workingDir, jetbrainsConfigsDir)
+
+	current := map[string]bool{}
+	for _, t := range targets {
+		c := jetbrainsRunConfiguration(t)
+		if c == nil {
+			continue
+		}
+		buf, err := xml.MarshalIndent(jetbrainsComponent{Name: "ProjectRunConfigurationManager", Configuration: *c}, "", "  ")
+		if err != nil {
+			return err
+		}
+		file := filepath.Join(dir, jetbrainsFilePrefix+unsafeFileNameChars.ReplaceAllString(strings.TrimPrefix(t.name, launchConfigPrefix), "_")+".xml")
+		if err := w.writeFile(file, append(buf, '\n')); err != nil {
+			return err
+		}
+		current[file] = true
+	}
+
+	for file := range w.written {
+		if !current[file] {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	w.written = current
+	return nil
+}
+
+// jetbrainsRunConfiguration returns the remote debug run configuration for the runtime's JetBrains IDE.
+func jetbrainsRunConfiguration(t debugTarget) *jetbrainsConfiguration {
+	c := &jetbrainsConfiguration{
+		Name:   t.name,
+		Method: jetbrainsMethod{V: "2"},
+	}
+	switch {
+	case t.runtime == "go" && t.portName == "dlv":
+		c.Type = "GoRemoteDebugConfigurationType"
+		c.FactoryName = "Go Remote"
+		c.Options = []jetbrainsOption{{"host", t.host}, {"port", fmt.Sprint(t.port)}}
+	case t.runtime == "jvm" && t.portName == "jdwp":
+		c.Type = "Remote"
+		c.FactoryName = "Remote"
+		c.Options = []jetbrainsOption{
+			{"USE_SOCKET_TRANSPORT", "true"},
+			{"SERVER_MODE", "false"},
+			{"HOST", t.host},
+			{"PORT", fmt.Sprint(t.port)},
+		}
+	case t.runtime == "nodejs" && t.portName == "devtools":
+		c.Type = "ChromiumRemoteDebugType"
+		c.FactoryName = "Chromium Remote"
+		c.Host = t.host
+		c.Port = fmt.Sprint(t.port)
+		if t.remoteRoot != "" {
+			localRoot := t.localRoot
+			if !filepath.IsAbs(localRoot) {
+				localRoot = strings.TrimSuffix("$PROJECT_DIR$/"+localRoot, "/.")
+			}
+			c.Mappings = []jetbrainsMapping{{URL: "file://" + t.remoteRoot, LocalFile: localRoot}}
+		}
+	default:
+		return nil
+	}
+	return c
+}
+
+// writeFile writes a file, and records the file and the directories that it creates.
+func (w *LaunchConfigWriter) writeFile(file string, content []byte) error {
+	var missing []string
+	for dir := filepath.Dir(file); !exists(dir); dir = filepath.Dir(dir) {
+		missing = append([]string{dir}, missing...)
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	w.created = append(w.created, missing...)
+
+	if !exists(file) {
+		w.created = append(w.created, file)
+	}
+	return ioutil.WriteFile(file, content, 0644)
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// isEmpty returns true for empty directories, and for launch files that only hold generated configurations.
+func isEmpty(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if info.IsDir() {
+		entries, err := ioutil.ReadDir(path)
+		return err == nil && len(entries) == 0
+	}
+	if filepath.Base(path) != filepath.Base(vscodeLaunchFile) {
+		return true
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	var launch struct {
+		Configurations []interface{} `json:"configurations"`
+	}
+	return json.Unmarshal(jsoncToJSON(buf), &launch) == nil && len(launch.Configurations) == 0
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLaunchConfigWriter(t *testing.T) {
+	started := &proto.Event{EventType: &proto.Event_DebuggingContainerEvent{DebuggingContainerEvent: &proto.DebuggingContainerEvent{
+		Status:        "Started",
+		PodName:       "pod",
+		ContainerName: "app",
+		Namespace:     "default",
+		Artifact:      "gcr.io/k8s-skaffold/app",
+		Runtime:       "go",
+		WorkingDir:    "/go/src/app",
+		DebugPorts:    map[string]uint32{"dlv": 56268},
+	}}}
+	forwarded := &proto.Event{EventType: &proto.Event_PortEvent{PortEvent: &proto.PortEvent{
+		LocalPort:     56270,
+		RemotePort:    56268,
+		PodName:       "pod",
+		ContainerName: "app",
+		Namespace:     "default",
+		Address:       "127.0.0.1",
+	}}}
+	goAttach := map[string]interface{}{
+		"name":           "Skaffold: pod/app",
+		"type":           "go",
+		"request":        "attach",
+		"mode":           "remote",
+		"host":           "127.0.0.1",
+		"port":           float64(56270),
+		"substitutePath": []interface{}{map[string]interface{}{"from": "${workspaceFolder}/app", "to": "/go/src/app"}},
+	}
+	userLaunch := map[string]interface{}{"name": "Mine", "type": "go", "request": "launch"}
+
+	tests := []struct {
+		description    string
+		launchJSON     string
+		expected       []interface{}
+		expectedAfter  []interface{}
+		expectRemovals bool
+	}{
+		{
+			description:    "new launch.json",
+			expected:       []interface{}{goAttach},
+			expectRemovals: true,
+		},
+		{
+			description:   "keep the user's configurations",
+			launchJSON:    `{"version": "0.2.0", "configurations": [{"name": "Mine", "type": "go", "request": "launch"}]}`,
+			expected:      []interface{}{userLaunch, goAttach},
+			expectedAfter: []interface{}{userLaunch},
+		},
+		{
+			description:   "replace stale generated configurations",
+			launchJSON:    `{"version": "0.2.0", "configurations": [{"name": "Skaffold: old/app", "type": "go", "request": "attach"}]}`,
+			expected:      []interface{}{goAttach},
+			expectedAfter: []interface{}{},
+		},
+		{
+			description: "launch.json with comments and trailing commas",
+			launchJSON: `{
+	// Use IntelliSense to learn about possible attributes.
+	"version": "0.2.0",
+	"configurations": [
+		/* mine */
+		{"name": "Mine", "type": "go", "request": "launch",},
+	],
+}`,
+			expected:      []interface{}{userLaunch, goAttach},
+			expectedAfter: []interface{}{userLaunch},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			if test.launchJSON != "" {
+				tmpDir.Write(".vscode/launch.json", test.launchJSON)
+			}
+
+			w := NewLaunchConfigWriter(tmpDir.Root(), []*latest_v1.Artifact{{ImageName: "gcr.io/k8s-skaffold/app", Workspace: "app"}})
+			t.CheckNoError(w.handle(started))
+			t.CheckNoError(w.handle(forwarded))
+
+			t.CheckDeepEqual(test.expected, launchConfigurations(t, tmpDir.Path(".vscode/launch.json")))
+			runConfig, err := ioutil.ReadFile(tmpDir.Path(".idea/runConfigurations/Skaffold_pod_app.xml"))
+			t.CheckNoError(err)
+			t.CheckTrue(strings.Contains(string(runConfig), `type="GoRemoteDebugConfigurationType"`))
+			t.CheckTrue(strings.Contains(string(runConfig), `<option name="port" value="56270">`))
+
+			w.Stop()
+			t.CheckErrorContains("stopped", w.handle(started))
+
+			_, err = os.Stat(tmpDir.Path(".idea"))
+			t.CheckTrue(os.IsNotExist(err))
+			if test.expectRemovals {
+				_, err = os.Stat(tmpDir.Path(".vscode"))
+				t.CheckTrue(os.IsNotExist(err))
+			} else {
+				t.CheckDeepEqual(test.expectedAfter, launchConfigurations(t, tmpDir.Path(".vscode/launch.json")))
+			}
+		})
+	}
+}
+
+func TestLaunchConfigWriterTerminated(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		w := NewLaunchConfigWriter(tmpDir.Root(), nil)
+
+		debugPorts := map[string]uint32{"jdwp": 5005}
+		t.CheckNoError(w.handle(&proto.Event{EventType: &proto.Event_PortEvent{PortEvent: &proto.PortEvent{
+			LocalPort: 5005, RemotePort: 5005, PodName: "pod", ContainerName: "app", Namespace: "ns",
+		}}}))
+		t.CheckNoError(w.handle(&proto.Event{EventType: &proto.Event_DebuggingContainerEvent{DebuggingContainerEvent: &proto.DebuggingContainerEvent{
+			Status: "Started", PodName: "pod", ContainerName: "app", Namespace: "ns", Runtime: "jvm", DebugPorts: debugPorts,
+		}}}))
+		t.CheckDeepEqual([]interface{}{map[string]interface{}{
+			"name":     "Skaffold: pod/app",
+			"type":     "java",
+			"request":  "attach",
+			"hostName": "localhost",
+			"port":     float64(5005),
+		}}, launchConfigurations(t, tmpDir.Path(".vscode/launch.json")))
+
+		t.CheckNoError(w.handle(&proto.Event{EventType: &proto.Event_DebuggingContainerEvent{DebuggingContainerEvent: &proto.DebuggingContainerEvent{
+			Status: "Terminated", PodName: "pod", ContainerName: "app", Namespace: "ns", Runtime: "jvm", DebugPorts: debugPorts,
+		}}}))
+		t.CheckDeepEqual([]interface{}{}, launchConfigurations(t, tmpDir.Path(".vscode/launch.json")))
+		_, err := ioutil.ReadFile(tmpDir.Path(".idea/runConfigurations/Skaffold_pod_app.xml"))
+		t.CheckError(true, err)
+	})
+}
+
+func launchConfigurations(t *testutil.T, file string) []interface{} {
+	buf, err := ioutil.ReadFile(file)
+	t.CheckNoError(err)
+
+	var launch struct {
+		Configurations []interface{} `json:"configurations"`
+	}
+	t.CheckNoError(json.Unmarshal(jsoncToJSON(buf), &launch))
+	return launch.Configurations
+}
@@ -29,8 +29,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
 )
@@ -48,22 +50,25 @@ type LogAggregator struct {
 	events            chan kubernetes.PodEvent
 	trackedContainers trackedContainers
 	outputLock        sync.Mutex
+	useClient         bool
 }
 
 type Config interface {
 	PipelineForImage(imageName string) (latest_v1.Pipeline, bool)
 	DefaultPipeline() latest_v1.Pipeline
+	GlobalConfig() string
 }
 
 // NewLogAggregator creates a new LogAggregator for a given output.
-func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector kubernetes.PodSelector, config Config) *LogAggregator {
+func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector kubernetes.PodSelector, cfg Config) *LogAggregator {
 	return &LogAggregator{
 		output:      out,
 		kubectlcli:  cli,
-		config:      config,
+		config:      cfg,
 		podWatcher:  kubernetes.NewPodWatcher(podSelector),
 		colorPicker: kubernetes.NewColorPicker(imageNames),
 		events:      make(chan kubernetes.PodEvent),
+		useClient:   config.UseNativeKubeClient(cfg.GlobalConfig()),
 	}
 }
 
@@ -149,11 +154,11 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 	// In theory, it's more precise to use --since-time='' but there can be a time
 	// difference between the user's machine and the server.
 	// So we use --since=Xs and round up to the nearest second to not lose any log.
-	sinceSeconds := fmt.Sprintf("--since=%ds", sinceSeconds(time.Since(a.sinceTime)))
+	since := sinceSeconds(time.Since(a.sinceTime))
 
 	tr, tw := io.Pipe()
 	go func() {
-		if err := a.kubectlcli.Run(ctx, nil, tw, "logs", sinceSeconds, "-f", pod.Name, "-c", container.Name, "--namespace", pod.Namespace); err != nil {
+		if err := a.tailLogs(ctx, tw, pod, container.Name, since); err != nil {
 			// Don't print errors if the user interrupted the logs
 			// or if the logs were interrupted because of a configuration change
 			if ctx.Err() != context.Canceled {
@@ -170,6 +175,31 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 	}
 }
 
+// tailLogs follows the logs of a container, starting `since` seconds ago.
+func (a *LogAggregator) tailLogs(ctx context.Context, out io.Writer, pod *v1.Pod, container string, since int64) error {
+	if !a.useClient {
+		return a.kubectlcli.Run(ctx, nil, out, "logs", fmt.Sprintf("--since=%ds", since), "-f", pod.Name, "-c", container, "--namespace", pod.Namespace)
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+		Container:    container,
+		Follow:       true,
+		SinceSeconds: &since,
+	}).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("streaming logs of pod %q, container %q: %w", pod.Name, container, err)
+	}
+	defer logs.Close()
+
+	_, err = io.Copy(out, logs)
+	return err
+}
+
 func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text string) {
 	if !a.IsMuted() {
 		a.outputLock.Lock()
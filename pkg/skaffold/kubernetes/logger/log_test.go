@@ -26,9 +26,12 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -199,6 +202,21 @@ func TestPrefix(t *testing.T) {
 	}
 }
 
+func TestTailLogsWithClient(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		pod := podWithName("hello")
+		t.Override(&client.Client, func() (k8s.Interface, error) {
+			return fake.NewSimpleClientset(&pod), nil
+		})
+		logger := &LogAggregator{useClient: true}
+
+		var out bytes.Buffer
+		err := logger.tailLogs(context.Background(), &out, &pod, "hello", 1)
+
+		t.CheckErrorAndDeepEqual(false, err, "fake logs", out.String())
+	})
+}
+
 func podWithName(n string) v1.Pod {
 	return v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -223,6 +241,8 @@ func (c *mockConfig) PipelineForImage(string) (latest_v1.Pipeline, bool) {
 	return pipeline, true
 }
 
+func (c *mockConfig) GlobalConfig() string { return "" }
+
 func (c *mockConfig) DefaultPipeline() latest_v1.Pipeline {
 	var pipeline latest_v1.Pipeline
 	pipeline.Deploy.Logs = c.log
@@ -28,23 +28,38 @@ type Registries struct {
 type Transform func(l ManifestList, builds []graph.Artifact, registries Registries) (ManifestList, error)
 
 // Transforms are applied to manifests
-var transforms []Transform
+var transforms []*Transform
 
 // AddTransform adds a transform to be applied when deploying.
-func AddTransform(newTransform Transform) {
-	transforms = append(transforms, newTransform)
+// Returns a function that removes the transform.
+func AddTransform(newTransform Transform) func() {
+	added := &newTransform
+	transforms = append(transforms, added)
+
+	return func() {
+		for i, t := range transforms {
+			if t == added {
+				transforms = append(transforms[:i:i], transforms[i+1:]...)
+				return
+			}
+		}
+	}
 }
 
 // GetTransforms returns all manifest transforms.
 func GetTransforms() []Transform {
-	return transforms
+	var all []Transform
+	for _, t := range transforms {
+		all = append(all, *t)
+	}
+	return all
 }
 
 // ApplyTransforms applies all manifests transforms to the provided manifests.
 func ApplyTransforms(manifests ManifestList, builds []graph.Artifact, insecureRegistries map[string]bool, debugHelpersRegistry string) (ManifestList, error) {
 	var err error
 	for _, transform := range transforms {
-		manifests, err = transform(manifests, builds, Registries{insecureRegistries, debugHelpersRegistry})
+		manifests, err = (*transform)(manifests, builds, Registries{insecureRegistries, debugHelpersRegistry})
 		if err != nil {
 			return nil, transformManifestErr(err)
 		}
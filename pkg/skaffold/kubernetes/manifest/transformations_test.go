@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAddTransform(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&transforms, nil)
+		appendTransform := func(suffix string) Transform {
+			return func(l ManifestList, _ []graph.Artifact, _ Registries) (ManifestList, error) {
+				return append(l, []byte(suffix)), nil
+			}
+		}
+
+		removeFirst := AddTransform(appendTransform("first"))
+		AddTransform(appendTransform("second"))
+		result, err := ApplyTransforms(nil, nil, nil, "")
+		t.CheckErrorAndDeepEqual(false, err, "first\n---\nsecond", result.String())
+
+		removeFirst()
+		removeFirst()
+		result, err = ApplyTransforms(nil, nil, nil, "")
+		t.CheckErrorAndDeepEqual(false, err, "second", result.String())
+	})
+}
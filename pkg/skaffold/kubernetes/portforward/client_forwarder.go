@@ -0,0 +1,310 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ClientForwarder port-forwards through the Kubernetes API, without running `kubectl`.
+type ClientForwarder struct {
+	out io.Writer
+}
+
+// NewClientForwarder returns a new ClientForwarder
+func NewClientForwarder(out io.Writer) *ClientForwarder {
+	return &ClientForwarder{
+		out: out,
+	}
+}
+
+// For testing
+var forwardPorts = forwardPortsWithClient
+
+// Forward port-forwards a pod in the background.
+// It restarts the port forwarding if it was not cancelled by skaffold.
+// It retries in case the port is taken.
+func (f *ClientForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	errChan := make(chan error, 1)
+	go f.forward(parentCtx, pfe, errChan)
+	return <-errChan
+}
+
+func (f *ClientForwarder) forward(parentCtx context.Context, pfe *portForwardEntry, errChan chan error) {
+	var notifiedUser bool
+	defer deferFunc()
+
+	for {
+		pfe.terminationLock.Lock()
+		if pfe.terminated {
+			logrus.Debugf("port forwarding %v was cancelled...", pfe)
+			pfe.terminationLock.Unlock()
+			errChan <- nil
+			return
+		}
+		pfe.terminationLock.Unlock()
+
+		if !isPortFree(util.Loopback, pfe.localPort) {
+			color.Red.Fprintf(f.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, pfe.localPort)
+			notifiedUser = true
+			pfe.setStatus(eventV2.Reconnecting, fmt.Errorf("port %d is taken", pfe.localPort))
+			time.Sleep(waitPortNotFree)
+			continue
+		}
+
+		if notifiedUser {
+			color.Green.Fprintf(f.out, "port forwarding %v recovered on port %d\n", pfe, pfe.localPort)
+			notifiedUser = false
+		}
+
+		ctx, cancel := context.WithCancel(parentCtx)
+		pfe.cancel = cancel
+
+		ready := make(chan struct{})
+		go func() {
+			select {
+			case <-ready:
+				select {
+				case errChan <- nil:
+				default:
+				}
+				pfe.setStatus(eventV2.Connected, nil)
+			case <-ctx.Done():
+			}
+		}()
+
+		// forwardPorts returns when the connection to the pod is lost, e.g. when the pod is deleted
+		err := forwardPorts(ctx, pfe, ready)
+		if ctx.Err() == context.Canceled {
+			logrus.Debugf("terminated %v due to context cancellation", pfe)
+			return
+		}
+		cancel()
+		if err == nil {
+			err = errors.New("lost connection to pod")
+		}
+
+		logrus.Debugf("port forwarding %v got terminated: %v", pfe, err)
+		if err != nil {
+			err = fmt.Errorf("port forwarding %v got terminated: %w", pfe, err)
+			select {
+			case errChan <- err:
+			default:
+			}
+		}
+		pfe.waitBeforeReconnect(parentCtx, err)
+	}
+}
+
+// Terminate terminates an existing port forwarding.
+func (*ClientForwarder) Terminate(p *portForwardEntry) {
+	logrus.Debugf("Terminating port-forward %v", p)
+
+	p.terminationLock.Lock()
+	defer p.terminationLock.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.terminated = true
+}
+
+// forwardPortsWithClient forwards the local port to a pod of the resource until the context is cancelled
+// or the connection is lost. It closes `ready` once the local port is listening.
+func forwardPortsWithClient(ctx context.Context, pfe *portForwardEntry, ready chan struct{}) error {
+	config, err := kubernetesclient.RestConfigForContext(pfe.resource.KubeContext)
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+	client, err := kubernetesclient.ClientForContext(pfe.resource.KubeContext)
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	podName, remotePort, err := findPodAndPort(ctx, client, pfe.resource)
+	if err != nil {
+		return err
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pfe.resource.Namespace).
+		Name(podName).
+		SubResource("portforward")
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return fmt.Errorf("creating round tripper: %w", err)
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+
+	stop := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stop)
+	}()
+
+	var errOut bytes.Buffer
+	fw, err := portforward.NewOnAddresses(dialer, []string{address}, []string{fmt.Sprintf("%d:%d", pfe.localPort, remotePort)}, stop, ready, ioutil.Discard, &errOut)
+	if err != nil {
+		return fmt.Errorf("creating port forwarder: %w", err)
+	}
+
+	if err := fw.ForwardPorts(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(errOut.String()))
+	}
+	return nil
+}
+
+// findPodAndPort finds the pod to forward to, for a given resource, and the container port
+// to forward to, the same way `kubectl port-forward` does.
+func findPodAndPort(ctx context.Context, client kubernetes.Interface, resource latest_v1.PortForwardResource) (string, int, error) {
+	ns, name := resource.Namespace, resource.Name
+
+	switch strings.ToLower(string(resource.Type)) {
+	case "pod":
+		pod, err := client.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting pod %s/%s: %w", ns, name, err)
+		}
+		port, err := findContainerPort(*pod, resource.Port)
+		return pod.Name, port, err
+
+	case "service":
+		return findNewestPodForSvc(ctx, resource.KubeContext, ns, name, resource.Port)
+
+	default:
+		selector, err := selectorForResource(ctx, client, resource)
+		if err != nil {
+			return "", -1, err
+		}
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return "", -1, fmt.Errorf("listing pods: %w", err)
+		}
+
+		var candidates []corev1.Pod
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == corev1.PodRunning {
+				candidates = append(candidates, pod)
+			}
+		}
+		if len(candidates) == 0 {
+			return "", -1, fmt.Errorf("no running pod for %s/%s", resource.Type, name)
+		}
+		sort.Slice(candidates, newestPodsFirst(candidates))
+
+		port, err := findContainerPort(candidates[0], resource.Port)
+		return candidates[0].Name, port, err
+	}
+}
+
+// selectorForResource returns the label selector of a workload resource.
+func selectorForResource(ctx context.Context, client kubernetes.Interface, resource latest_v1.PortForwardResource) (labels.Selector, error) {
+	ns, name := resource.Namespace, resource.Name
+
+	var selector *metav1.LabelSelector
+	var err error
+	switch strings.ToLower(string(resource.Type)) {
+	case "deployment":
+		var d *appsv1.Deployment
+		if d, err = client.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = d.Spec.Selector
+		}
+	case "replicaset":
+		var rs *appsv1.ReplicaSet
+		if rs, err = client.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = rs.Spec.Selector
+		}
+	case "statefulset":
+		var ss *appsv1.StatefulSet
+		if ss, err = client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = ss.Spec.Selector
+		}
+	case "daemonset":
+		var ds *appsv1.DaemonSet
+		if ds, err = client.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = ds.Spec.Selector
+		}
+	case "job":
+		var job *batchv1.Job
+		if job, err = client.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = job.Spec.Selector
+		}
+	case "replicationcontroller":
+		var rc *corev1.ReplicationController
+		if rc, err = client.CoreV1().ReplicationControllers(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = metav1.SetAsLabelSelector(rc.Spec.Selector)
+		}
+	default:
+		return nil, fmt.Errorf("port forwarding %s resources isn't supported", resource.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s %s/%s: %w", resource.Type, ns, name, err)
+	}
+	if selector == nil {
+		return nil, fmt.Errorf("%s %s/%s has no selector", resource.Type, ns, name)
+	}
+
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// findContainerPort resolves a port, possibly given by name, to a port number of the pod's containers.
+func findContainerPort(pod corev1.Pod, port schemautil.IntOrString) (int, error) {
+	if port.Type == schemautil.Int {
+		return port.IntVal, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return int(p.ContainerPort), nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("pod %q does not have a port named %q", pod.Name, port.StrVal)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestFindPodAndPort(t *testing.T) {
+	now := time.Now()
+	appPod := func(name string, phase corev1.PodPhase, created time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: map[string]string{"app": "web"}, CreationTimestamp: metav1.NewTime(created)},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:  "web",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+	}
+
+	tests := []struct {
+		description  string
+		resource     latest_v1.PortForwardResource
+		objects      []runtime.Object
+		expectedPod  string
+		expectedPort int
+		shouldErr    bool
+	}{
+		{
+			description:  "pod with numbered port",
+			resource:     latest_v1.PortForwardResource{Type: "pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromInt(9000)},
+			objects:      []runtime.Object{appPod("web-1", corev1.PodRunning, now)},
+			expectedPod:  "web-1",
+			expectedPort: 9000,
+		},
+		{
+			description:  "pod with named port",
+			resource:     latest_v1.PortForwardResource{Type: "Pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects:      []runtime.Object{appPod("web-1", corev1.PodRunning, now)},
+			expectedPod:  "web-1",
+			expectedPort: 8080,
+		},
+		{
+			description: "pod with unknown named port",
+			resource:    latest_v1.PortForwardResource{Type: "pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromString("grpc")},
+			objects:     []runtime.Object{appPod("web-1", corev1.PodRunning, now)},
+			shouldErr:   true,
+		},
+		{
+			description:  "deployment picks the newest running pod",
+			resource:     latest_v1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects:      []runtime.Object{deployment, appPod("web-old", corev1.PodRunning, now.Add(-time.Hour)), appPod("web-new", corev1.PodRunning, now), appPod("web-pending", corev1.PodPending, now.Add(time.Hour))},
+			expectedPod:  "web-new",
+			expectedPort: 8080,
+		},
+		{
+			description: "deployment without running pods",
+			resource:    latest_v1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			objects:     []runtime.Object{deployment, appPod("web-pending", corev1.PodPending, now)},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported resource type",
+			resource:    latest_v1.PortForwardResource{Type: "cronjob", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fake.NewSimpleClientset(test.objects...)
+
+			pod, port, err := findPodAndPort(context.Background(), client, test.resource)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedPod, pod)
+				t.CheckDeepEqual(test.expectedPort, port)
+			}
+		})
+	}
+}
+
+func TestClientForwarderForward(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&forwardPorts, func(ctx context.Context, _ *portForwardEntry, ready chan struct{}) error {
+			close(ready)
+			<-ctx.Done()
+			return nil
+		})
+
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{Type: "pod", Name: "web"}, "", "", "", "", 9000, false)
+		forwarder := NewClientForwarder(nil)
+
+		err := forwarder.Forward(context.Background(), pfe)
+		forwarder.Terminate(pfe)
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestClientForwarderForwardError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&forwardPorts, func(context.Context, *portForwardEntry, chan struct{}) error {
+			return errors.New("no pod")
+		})
+
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{Type: "pod", Name: "web"}, "", "", "", "", 9000, false)
+		forwarder := NewClientForwarder(nil)
+
+		err := forwarder.Forward(context.Background(), pfe)
+		forwarder.Terminate(pfe)
+
+		t.CheckErrorContains("no pod", err)
+	})
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// For testing
+var resolveContainerAddress = containerAddressWithClient
+
+// DockerForwarder forwards local ports to the ports of local Docker containers, by proxying
+// the connections made to the local port.
+type DockerForwarder struct {
+	cfg docker.Config
+}
+
+// NewDockerForwarder returns a new DockerForwarder
+func NewDockerForwarder(cfg docker.Config) *DockerForwarder {
+	return &DockerForwarder{
+		cfg: cfg,
+	}
+}
+
+// Forward listens on the local port and proxies each connection to the container.
+// The container's address is resolved for each connection, so that restarted containers are reached.
+func (f *DockerForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	pfe.terminationLock.Lock()
+	defer pfe.terminationLock.Unlock()
+	if pfe.terminated {
+		return nil
+	}
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(pfe.localPort)))
+	if err != nil {
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	pfe.cancel = cancel
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	go f.accept(ctx, listener, pfe)
+	pfe.setStatus(eventV2.Connected, nil)
+	return nil
+}
+
+func (f *DockerForwarder) accept(ctx context.Context, listener net.Listener, pfe *portForwardEntry) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Debugf("port forwarding %v stopped accepting connections: %v", pfe, err)
+				pfe.setStatus(eventV2.Failed, err)
+			}
+			return
+		}
+		go f.proxy(ctx, conn, pfe)
+	}
+}
+
+func (f *DockerForwarder) proxy(ctx context.Context, conn net.Conn, pfe *portForwardEntry) {
+	defer conn.Close()
+
+	target, err := resolveContainerAddress(ctx, f.cfg, pfe.resource.Name, pfe.resource.Port.IntVal)
+	if err != nil {
+		logrus.Warnf("port forwarding %v: %v", pfe, err)
+		pfe.setStatus(eventV2.Reconnecting, err)
+		return
+	}
+
+	var dialer net.Dialer
+	upstream, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		logrus.Warnf("port forwarding %v: %v", pfe, err)
+		pfe.setStatus(eventV2.Reconnecting, err)
+		return
+	}
+	defer upstream.Close()
+	pfe.setStatus(eventV2.Connected, nil)
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+		upstream.Close()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(upstream, conn)
+		closeWrite(upstream)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(conn, upstream)
+		closeWrite(conn)
+	}()
+	wg.Wait()
+}
+
+func closeWrite(conn net.Conn) {
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.CloseWrite()
+	}
+}
+
+// Terminate stops listening on the local port.
+func (*DockerForwarder) Terminate(p *portForwardEntry) {
+	logrus.Debugf("Terminating port-forward %v", p)
+
+	p.terminationLock.Lock()
+	defer p.terminationLock.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.terminated = true
+}
+
+func containerAddressWithClient(ctx context.Context, cfg docker.Config, name string, port int) (string, error) {
+	client, err := docker.NewAPIClient(cfg)
+	if err != nil {
+		return "", fmt.Errorf("getting Docker client: %w", err)
+	}
+	info, err := client.RawClient().ContainerInspect(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("inspecting container %q: %w", name, err)
+	}
+	return containerAddress(info, port)
+}
+
+// containerAddress returns the address to reach a container's port: the published host port when
+// the port is published, or else the container's own address, which is only reachable when
+// the Docker daemon runs on the local host.
+func containerAddress(info types.ContainerJSON, port int) (string, error) {
+	if info.State == nil || !info.State.Running {
+		return "", fmt.Errorf("container %q is not running", strings.TrimPrefix(info.Name, "/"))
+	}
+	if info.NetworkSettings == nil {
+		return "", fmt.Errorf("container %q has no network settings", strings.TrimPrefix(info.Name, "/"))
+	}
+
+	for p, bindings := range info.NetworkSettings.Ports {
+		if string(p) != fmt.Sprintf("%d/tcp", port) {
+			continue
+		}
+		for _, b := range bindings {
+			if b.HostPort == "" {
+				continue
+			}
+			host := b.HostIP
+			if host == "" || host == "0.0.0.0" || host == "::" {
+				host = util.Loopback
+			}
+			return net.JoinHostPort(host, b.HostPort), nil
+		}
+	}
+
+	ip := info.NetworkSettings.IPAddress
+	if ip == "" {
+		var names []string
+		for name := range info.NetworkSettings.Networks {
+			names = append(names, name)
+		}
+		if len(names) > 0 {
+			// pick a network consistently
+			first := names[0]
+			for _, name := range names {
+				if name < first {
+					first = name
+				}
+			}
+			if network := info.NetworkSettings.Networks[first]; network != nil {
+				ip = network.IPAddress
+			}
+		}
+	}
+	if ip == "" {
+		return "", fmt.Errorf("container %q doesn't publish port %d and has no IP address", strings.TrimPrefix(info.Name, "/"), port)
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(port)), nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestContainerAddress(t *testing.T) {
+	tests := []struct {
+		description     string
+		running         bool
+		ports           nat.PortMap
+		ipAddress       string
+		networks        map[string]*network.EndpointSettings
+		expectedAddress string
+		shouldErr       bool
+	}{
+		{
+			description:     "published port",
+			running:         true,
+			ports:           nat.PortMap{"8080/tcp": {{HostIP: "0.0.0.0", HostPort: "32768"}}},
+			ipAddress:       "172.17.0.2",
+			expectedAddress: "127.0.0.1:32768",
+		},
+		{
+			description:     "published port on a given interface",
+			running:         true,
+			ports:           nat.PortMap{"8080/tcp": {{HostIP: "192.168.1.10", HostPort: "8081"}}},
+			expectedAddress: "192.168.1.10:8081",
+		},
+		{
+			description:     "unpublished port",
+			running:         true,
+			ports:           nat.PortMap{"9000/tcp": {{HostPort: "9000"}}},
+			ipAddress:       "172.17.0.2",
+			expectedAddress: "172.17.0.2:8080",
+		},
+		{
+			description: "user defined networks",
+			running:     true,
+			networks: map[string]*network.EndpointSettings{
+				"zeta":  {IPAddress: "10.0.1.2"},
+				"alpha": {IPAddress: "10.0.0.2"},
+			},
+			expectedAddress: "10.0.0.2:8080",
+		},
+		{
+			description: "no address",
+			running:     true,
+			shouldErr:   true,
+		},
+		{
+			description: "stopped container",
+			ports:       nat.PortMap{"8080/tcp": {{HostPort: "32768"}}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			info := types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					Name:  "/db",
+					State: &types.ContainerState{Running: test.running},
+				},
+				NetworkSettings: &types.NetworkSettings{
+					NetworkSettingsBase:    types.NetworkSettingsBase{Ports: test.ports},
+					DefaultNetworkSettings: types.DefaultNetworkSettings{IPAddress: test.ipAddress},
+					Networks:               test.networks,
+				},
+			}
+
+			address, err := containerAddress(info, 8080)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedAddress, address)
+		})
+	}
+}
+
+func TestDockerForwarder(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		container, err := net.Listen("tcp", "127.0.0.1:0")
+		t.CheckNoError(err)
+		defer container.Close()
+		go func() {
+			for {
+				conn, err := container.Accept()
+				if err != nil {
+					return
+				}
+				conn.Write([]byte("hello"))
+				conn.Close()
+			}
+		}()
+
+		var (
+			statuses []string
+			lock     sync.Mutex
+		)
+		t.Override(&portForwardStatusEvent, func(_ *portForwardEntry, status string, _ error) {
+			lock.Lock()
+			statuses = append(statuses, status)
+			lock.Unlock()
+		})
+		t.Override(&resolveContainerAddress, func(_ context.Context, _ docker.Config, name string, port int) (string, error) {
+			if name != "db" || port != 5432 {
+				return "", errors.New("unknown container")
+			}
+			return container.Addr().String(), nil
+		})
+
+		localPort := util.GetAvailablePort(util.Loopback, 0, &util.PortSet{})
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{
+			Type: constants.DockerContainer,
+			Name: "db",
+			Port: schemautil.FromInt(5432),
+		}, "", "", "", "", localPort, false)
+
+		f := NewDockerForwarder(nil)
+		t.CheckNoError(f.Forward(context.Background(), pfe))
+		defer f.Terminate(pfe)
+
+		conn, err := net.Dial("tcp", net.JoinHostPort(util.Loopback, fmt.Sprint(localPort)))
+		t.CheckNoError(err)
+		defer conn.Close()
+		received, err := ioutil.ReadAll(conn)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("hello", string(received))
+		lock.Lock()
+		defer lock.Unlock()
+		t.CheckDeepEqual([]string{eventV2.Connected}, statuses)
+	})
+}
@@ -20,10 +20,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -41,6 +45,22 @@ var (
 			entry.resource.Name,
 			entry.resource.Address)
 	}
+
+	portForwardStatusEvent = func(entry *portForwardEntry, status string, err error) {
+		eventV2.PortForwardStatusChanged(
+			entry.key(),
+			int32(entry.localPort),
+			entry.resource.Port,
+			entry.podName,
+			entry.containerName,
+			entry.resource.Namespace,
+			entry.portName,
+			string(entry.resource.Type),
+			entry.resource.Name,
+			entry.resource.Address,
+			status,
+			err)
+	}
 )
 
 type forwardedResources struct {
@@ -86,6 +106,8 @@ func (f *forwardedResources) Length() int {
 type EntryManager struct {
 	output         io.Writer
 	entryForwarder EntryForwarder
+	// dockerForwarder forwards the entries of Docker containers, if set.
+	dockerForwarder EntryForwarder
 
 	// forwardedPorts serves as a synchronized set of ports we've forwarded.
 	forwardedPorts util.PortSet
@@ -109,14 +131,22 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portF
 		return
 	}
 	b.forwardedResources.Store(entry.key(), entry)
-
-	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
+	// keep the local port reserved when the entry replaces a terminated one, e.g. for a restarted pod
+	b.forwardedPorts.Set(entry.localPort)
+
+	if err := b.forwarderFor(entry).Forward(ctx, entry); err == nil {
+		location := fmt.Sprintf("in namespace %s", entry.resource.Namespace)
+		if isDockerContainer(entry.resource) {
+			location = "in Docker"
+		} else if entry.resource.KubeContext != "" {
+			location = fmt.Sprintf("in namespace %s of context %s", entry.resource.Namespace, entry.resource.KubeContext)
+		}
 		color.Green.Fprintln(
 			b.output,
-			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> %s:%d",
+			fmt.Sprintf("Port forwarding %s/%s %s, remote port %s -> %s:%d",
 				entry.resource.Type,
 				entry.resource.Name,
-				entry.resource.Namespace,
+				location,
 				entry.resource.Port.String(),
 				entry.resource.Address,
 				entry.localPort))
@@ -137,5 +167,17 @@ func (b *EntryManager) Stop() {
 func (b *EntryManager) Terminate(p *portForwardEntry) {
 	b.forwardedResources.Delete(p.key())
 	b.forwardedPorts.Delete(p.localPort)
-	b.entryForwarder.Terminate(p)
+	b.forwarderFor(p).Terminate(p)
+}
+
+// forwarderFor returns the forwarder responsible for an entry.
+func (b *EntryManager) forwarderFor(entry *portForwardEntry) EntryForwarder {
+	if b.dockerForwarder != nil && isDockerContainer(entry.resource) {
+		return b.dockerForwarder
+	}
+	return b.entryForwarder
+}
+
+func isDockerContainer(resource latest_v1.PortForwardResource) bool {
+	return strings.EqualFold(string(resource.Type), string(constants.DockerContainer))
 }
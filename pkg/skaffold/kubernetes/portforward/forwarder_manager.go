@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -27,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
@@ -41,16 +44,34 @@ type Forwarder interface {
 
 // ForwarderManager manages all forwarders
 type ForwarderManager struct {
-	forwarders []Forwarder
+	forwarders   []Forwarder
+	entryManager *EntryManager
 }
 
-// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
-func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes.PodSelector, label string, runMode config.RunMode, options config.PortForwardOptions, userDefined []*latest_v1.PortForwardResource) *ForwarderManager {
+// ForwardedPort describes a remote port forwarded to a local address.
+type ForwardedPort struct {
+	ResourceType string
+	ResourceName string
+	Namespace    string
+	RemotePort   string
+	Address      string
+	LocalPort    int
+}
+
+// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding.
+// When useClient is true, ports are forwarded through the Kubernetes API instead of `kubectl port-forward`.
+// Ports of Docker containers are forwarded with the Docker daemon configured by dockerCfg.
+func NewForwarderManager(out io.Writer, cli *kubectl.CLI, dockerCfg docker.Config, useClient bool, podSelector kubernetes.PodSelector, label string, runMode config.RunMode, options config.PortForwardOptions, userDefined []*latest_v1.PortForwardResource) *ForwarderManager {
 	if !options.Enabled() {
 		return nil
 	}
 
-	entryManager := NewEntryManager(out, NewKubectlForwarder(out, cli))
+	var entryForwarder EntryForwarder = NewKubectlForwarder(out, cli)
+	if useClient {
+		entryForwarder = NewClientForwarder(out)
+	}
+	entryManager := NewEntryManager(out, entryForwarder)
+	entryManager.dockerForwarder = NewDockerForwarder(dockerCfg)
 
 	var forwarders []Forwarder
 	if options.ForwardUser(runMode) {
@@ -66,7 +87,8 @@ func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes
 	}
 
 	return &ForwarderManager{
-		forwarders: forwarders,
+		forwarders:   forwarders,
+		entryManager: entryManager,
 	}
 }
 
@@ -99,9 +121,31 @@ func debugPorts(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
 			}
 		}
 	}
+	// debuggers attached with ephemeral containers listen on ports that the container doesn't declare
+	var names []string
+	for name := range dc.Ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !declaresPort(c, dc.Ports[name]) {
+			port := v1.ContainerPort{Name: name, ContainerPort: int32(dc.Ports[name])}
+			logrus.Debugf("selecting undeclared debug port for pod/%s/%s: %v", pod.Name, c.Name, port)
+			ports = append(ports, port)
+		}
+	}
 	return ports
 }
 
+func declaresPort(c v1.Container, port uint32) bool {
+	for _, p := range c.Ports {
+		if uint32(p.ContainerPort) == port {
+			return true
+		}
+	}
+	return false
+}
+
 // Start begins all forwarders managed by the ForwarderManager
 func (p *ForwarderManager) Start(ctx context.Context, namespaces []string) error {
 	// Port forwarding is not enabled.
@@ -121,6 +165,39 @@ func (p *ForwarderManager) Start(ctx context.Context, namespaces []string) error
 	return nil
 }
 
+// ForwardedPorts returns the ports currently forwarded, sorted by resource.
+func (p *ForwarderManager) ForwardedPorts() []ForwardedPort {
+	// Port forwarding is not enabled.
+	if p == nil || p.entryManager == nil {
+		return nil
+	}
+
+	var ports []ForwardedPort
+	p.entryManager.forwardedResources.lock.Lock()
+	for _, entry := range p.entryManager.forwardedResources.resources {
+		ports = append(ports, ForwardedPort{
+			ResourceType: strings.ToLower(string(entry.resource.Type)),
+			ResourceName: entry.resource.Name,
+			Namespace:    entry.resource.Namespace,
+			RemotePort:   entry.resource.Port.String(),
+			Address:      entry.resource.Address,
+			LocalPort:    entry.localPort,
+		})
+	}
+	p.entryManager.forwardedResources.lock.Unlock()
+
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].ResourceType != ports[j].ResourceType {
+			return ports[i].ResourceType < ports[j].ResourceType
+		}
+		if ports[i].ResourceName != ports[j].ResourceName {
+			return ports[i].ResourceName < ports[j].ResourceName
+		}
+		return ports[i].RemotePort < ports[j].RemotePort
+	})
+	return ports
+}
+
 // Stop cleans up and terminates all forwarders managed by the ForwarderManager
 func (p *ForwarderManager) Stop() {
 	// Port forwarding is not enabled.
@@ -25,8 +25,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -59,6 +62,8 @@ func TestNewForwarderManager(t *testing.T) {
 			options.Set(test.fmOptions)
 			fm := NewForwarderManager(ioutil.Discard,
 				&kubectl.CLI{},
+				nil,
+				false,
 				&kubernetes.ImageList{},
 				"",
 				"",
@@ -72,12 +77,40 @@ func TestNewForwarderManager(t *testing.T) {
 	}
 }
 
+func TestNewForwarderManagerWithClient(t *testing.T) {
+	options := config.PortForwardOptions{}
+	options.Set("user")
+
+	fm := NewForwarderManager(ioutil.Discard, &kubectl.CLI{}, nil, true, &kubernetes.ImageList{}, "", "", options, nil)
+
+	forwarder := fm.forwarders[0].(*ResourceForwarder)
+	_, isClientForwarder := forwarder.entryManager.entryForwarder.(*ClientForwarder)
+	testutil.CheckDeepEqual(t, true, isClientForwarder)
+}
+
 func TestForwarderManagerZeroValue(t *testing.T) {
 	var m *ForwarderManager
 
 	// Should not raise a nil dereference
 	m.Start(context.Background(), nil)
 	m.Stop()
+	testutil.CheckDeepEqual(t, 0, len(m.ForwardedPorts()))
+}
+
+func TestForwardedPorts(t *testing.T) {
+	entryManager := NewEntryManager(ioutil.Discard, newTestForwarder())
+	for _, entry := range []*portForwardEntry{
+		newPortForwardEntry(0, latest_v1.PortForwardResource{Type: constants.Service, Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080), Address: "127.0.0.1"}, "", "", "", "", 9000, false),
+		newPortForwardEntry(0, latest_v1.PortForwardResource{Type: "Deployment", Name: "api", Namespace: "ns", Port: schemautil.FromInt(80), Address: "127.0.0.1"}, "", "", "", "", 4503, false),
+	} {
+		entryManager.forwardedResources.Store(entry.key(), entry)
+	}
+	m := &ForwarderManager{entryManager: entryManager}
+
+	testutil.CheckDeepEqual(t, []ForwardedPort{
+		{ResourceType: "deployment", ResourceName: "api", Namespace: "ns", RemotePort: "80", Address: "127.0.0.1", LocalPort: 4503},
+		{ResourceType: "service", ResourceName: "web", Namespace: "ns", RemotePort: "8080", Address: "127.0.0.1", LocalPort: 9000},
+	}, m.ForwardedPorts())
 }
 
 func TestAllPorts(t *testing.T) {
@@ -101,3 +134,11 @@ func TestDebugPorts(t *testing.T) {
 		Spec:       v1.PodSpec{Containers: []v1.Container{container}}}
 	testutil.CheckDeepEqual(t, []v1.ContainerPort{{Name: "dlv", ContainerPort: 56268}}, debugPorts(&pod, container))
 }
+
+func TestDebugPortsNotDeclared(t *testing.T) {
+	container := v1.Container{Name: "test", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}}
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"go","ports":{"dlv":56268}}}`}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{container}}}
+	testutil.CheckDeepEqual(t, []v1.ContainerPort{{Name: "dlv", ContainerPort: 56268}}, debugPorts(&pod, container))
+}
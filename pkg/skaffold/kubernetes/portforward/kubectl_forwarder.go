@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -96,6 +97,7 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 			// since the dev loop kicked off. We are notifying the user in the hope that they can fix it
 			color.Red.Fprintf(k.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, pfe.localPort)
 			notifiedUser = true
+			pfe.setStatus(eventV2.Reconnecting, fmt.Errorf("port %d is taken", pfe.localPort))
 			time.Sleep(waitPortNotFree)
 			continue
 		}
@@ -110,7 +112,7 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 
 		args := portForwardArgs(ctx, pfe)
 		var buf bytes.Buffer
-		cmd := k.kubectl.CommandWithStrictCancellation(ctx, "port-forward", args...)
+		cmd := k.cli(pfe).CommandWithStrictCancellation(ctx, "port-forward", args...)
 		cmd.Stdout = &buf
 		cmd.Stderr = &buf
 
@@ -122,7 +124,7 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 			}
 			// Retry on exit at Start()
 			logrus.Debugf("error starting port forwarding %v: %s, output: %s", pfe, err, buf.String())
-			time.Sleep(500 * time.Millisecond)
+			pfe.waitBeforeReconnect(parentCtx, err)
 			continue
 		}
 
@@ -138,17 +140,29 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 
 			s := buf.String()
 			logrus.Debugf("port forwarding %v got terminated: %s, output: %s", pfe, err, s)
+			terminatedErr := fmt.Errorf("port forwarding %v got terminated: output: %s", pfe, s)
 			if !strings.Contains(s, "address already in use") {
 				select {
-				case errChan <- fmt.Errorf("port forwarding %v got terminated: output: %s", pfe, s):
+				case errChan <- terminatedErr:
 				default:
 				}
 			}
-			time.Sleep(500 * time.Millisecond)
+			pfe.waitBeforeReconnect(parentCtx, terminatedErr)
 		}
 	}
 }
 
+// cli returns the kubectl CLI for the kube-context of the entry's resource.
+func (k *KubectlForwarder) cli(pfe *portForwardEntry) *kubectl.CLI {
+	if pfe.resource.KubeContext == "" || pfe.resource.KubeContext == k.kubectl.KubeContext {
+		return k.kubectl
+	}
+	return &kubectl.CLI{
+		KubeContext: pfe.resource.KubeContext,
+		KubeConfig:  k.kubectl.KubeConfig,
+	}
+}
+
 func portForwardArgs(ctx context.Context, pfe *portForwardEntry) []string {
 	args := []string{"--pod-running-timeout", "1s", "--namespace", pfe.resource.Namespace}
 
@@ -156,7 +170,7 @@ func portForwardArgs(ctx context.Context, pfe *portForwardEntry) []string {
 	switch {
 	case pfe.resource.Type == "service" && !disableServiceForwarding:
 		// Services need special handling: https://github.com/GoogleContainerTools/skaffold/issues/4522
-		podName, remotePort, err := findNewestPodForSvc(ctx, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
+		podName, remotePort, err := findNewestPodForSvc(ctx, pfe.resource.KubeContext, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
 		if err == nil {
 			args = append(args, fmt.Sprintf("pod/%s", podName), fmt.Sprintf("%d:%d", pfe.localPort, remotePort))
 			break
@@ -190,6 +204,8 @@ func (*KubectlForwarder) Terminate(p *portForwardEntry) {
 // Monitor monitors the logs for a kubectl port forward command
 // If it sees an error, it calls back to the EntryManager to
 // retry the entire port forward operation.
+// It also kills the command when kubectl loses the connection to the pod, e.g. when the pod is deleted,
+// since some versions of kubectl keep running without forwarding anything.
 func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *kubectl.Cmd, p *portForwardEntry, err chan error) {
 	ticker := time.NewTicker(waitErrorLogs)
 	defer ticker.Stop()
@@ -209,7 +225,8 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 
 			if strings.Contains(s, "error forwarding port") ||
 				strings.Contains(s, "unable to forward") ||
-				strings.Contains(s, "error upgrading connection") {
+				strings.Contains(s, "error upgrading connection") ||
+				strings.Contains(s, "lost connection to pod") {
 				// kubectl is having an error. retry the command
 				logrus.Tracef("killing port forwarding %v", p)
 				if err := cmd.Terminate(); err != nil {
@@ -225,6 +242,8 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 				case err <- nil:
 				default:
 				}
+
+				p.setStatus(eventV2.Connected, nil)
 			}
 		}
 	}
@@ -233,8 +252,8 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 // findNewestPodForService queries the cluster to find a pod that fulfills the given service, giving
 // preference to pods that were most recently created.  This is in contrast to the selection algorithm
 // used by kubectl (see https://github.com/GoogleContainerTools/skaffold/issues/4522 for details).
-func findNewestPodForService(ctx context.Context, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
-	client, err := kubernetesclient.Client()
+func findNewestPodForService(ctx context.Context, kubeContext, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return "", -1, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -131,6 +131,11 @@ func TestMonitorErrorLogs(t *testing.T) {
 			input:       "error upgrading connection 8080",
 			shouldError: true,
 		},
+		{
+			description: "match on 'lost connection to pod'",
+			input:       "E0616 portforward.go:233] lost connection to pod",
+			shouldError: true,
+		},
 		{
 			description: "match on successful port forwarding message",
 			input:       "Forwarding from 127.0.0.1:8080 -> 8080",
@@ -233,7 +238,7 @@ func TestPortForwardArgs(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 			defer cancel()
 
-			t.Override(&findNewestPodForSvc, func(ctx context.Context, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
+			t.Override(&findNewestPodForSvc, func(ctx context.Context, kubeContext, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
 				return test.servicePod, test.servicePort, test.serviceErr
 			})
 
@@ -418,7 +423,7 @@ func TestFindNewestPodForService(t *testing.T) {
 				return fake.NewSimpleClientset(test.clientResources...), test.clientErr
 			})
 
-			pod, port, err := findNewestPodForService(ctx, "", test.serviceName, schemautil.FromInt(test.servicePort))
+			pod, port, err := findNewestPodForService(ctx, "", "", test.serviceName, schemautil.FromInt(test.servicePort))
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPod, pod)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPort, port)
 		})
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
@@ -37,6 +38,11 @@ type portForwardEntry struct {
 	terminated             bool
 	terminationLock        sync.Mutex
 	cancel                 context.CancelFunc
+
+	// status of the forwarding and delay before the next reconnection attempt
+	status         string
+	reconnectDelay time.Duration
+	statusLock     sync.Mutex
 }
 
 // newPortForwardEntry returns a port forward entry.
@@ -60,10 +66,13 @@ func (p *portForwardEntry) key() string {
 	if p.automaticPodForwarding {
 		return fmt.Sprintf("%s-%s-%s-%s-%s", p.ownerReference, p.containerName, p.resource.Namespace, p.portName, p.resource.Port.String())
 	}
-	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	return p.String()
 }
 
 // String is a utility function that returns the port forward entry as a user-readable string
 func (p *portForwardEntry) String() string {
+	if p.resource.KubeContext != "" {
+		return fmt.Sprintf("%s-%s-%s-%s-%s", p.resource.KubeContext, strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
+	}
 	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
 }
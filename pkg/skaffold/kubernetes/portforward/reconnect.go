@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+)
+
+// For testing
+var (
+	initialReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay     = 30 * time.Second
+)
+
+// setStatus records the status of the forwarding and notifies its changes.
+// Connecting resets the reconnection delay.
+func (p *portForwardEntry) setStatus(status string, err error) {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	if status == eventV2.Connected {
+		p.reconnectDelay = 0
+	}
+	if status == p.status {
+		return
+	}
+	p.status = status
+	portForwardStatusEvent(p, status, err)
+}
+
+// waitBeforeReconnect waits before the next attempt to reconnect, doubling the delay on every
+// attempt up to `maxReconnectDelay`. The forwarding is reported as failing once the delay has
+// reached its maximum, but the reconnection attempts go on.
+func (p *portForwardEntry) waitBeforeReconnect(ctx context.Context, err error) {
+	p.statusLock.Lock()
+	switch {
+	case p.reconnectDelay == 0:
+		p.reconnectDelay = initialReconnectDelay
+	case p.reconnectDelay < maxReconnectDelay:
+		p.reconnectDelay *= 2
+		if p.reconnectDelay > maxReconnectDelay {
+			p.reconnectDelay = maxReconnectDelay
+		}
+	}
+	delay := p.reconnectDelay
+	p.statusLock.Unlock()
+
+	if delay == maxReconnectDelay {
+		p.setStatus(eventV2.Failed, err)
+	} else {
+		p.setStatus(eventV2.Reconnecting, err)
+	}
+
+	logrus.Debugf("reconnecting port forwarding %v in %v", p, delay)
+	select {
+	case <-ctx.Done():
+	case <-time.After(delay):
+	}
+}
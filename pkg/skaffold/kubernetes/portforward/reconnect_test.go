@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestReconnectStatus(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&initialReconnectDelay, time.Millisecond)
+		t.Override(&maxReconnectDelay, 4*time.Millisecond)
+		var statuses []string
+		t.Override(&portForwardStatusEvent, func(_ *portForwardEntry, status string, _ error) {
+			statuses = append(statuses, status)
+		})
+
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{}, "", "", "", "", 8080, false)
+		pfe.setStatus("Connected", nil)
+		for i := 0; i < 4; i++ {
+			pfe.waitBeforeReconnect(context.Background(), errors.New("lost connection"))
+		}
+		t.CheckDeepEqual(4*time.Millisecond, pfe.reconnectDelay)
+		pfe.setStatus("Connected", nil)
+		t.CheckDeepEqual(time.Duration(0), pfe.reconnectDelay)
+		pfe.waitBeforeReconnect(context.Background(), errors.New("lost connection"))
+
+		t.CheckDeepEqual([]string{"Connected", "Reconnecting", "Failed", "Connected", "Reconnecting"}, statuses)
+	})
+}
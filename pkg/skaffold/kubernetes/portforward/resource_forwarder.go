@@ -65,23 +65,23 @@ func NewUserDefinedForwarder(entryManager *EntryManager, userDefinedResources []
 // Start gets a list of services deployed by skaffold as []latest_v1.PortForwardResource and
 // forwards them.
 func (p *ResourceForwarder) Start(ctx context.Context, namespaces []string) error {
-	if len(namespaces) == 1 {
-		for _, pf := range p.userDefinedResources {
-			if pf.Namespace == "" {
-				pf.Namespace = namespaces[0]
-			}
-		}
-	} else {
-		var validResources []*latest_v1.PortForwardResource
-		for _, pf := range p.userDefinedResources {
-			if pf.Namespace != "" {
-				validResources = append(validResources, pf)
-			} else {
-				logrus.Warnf("Skipping the port forwarding resource %s/%s because namespace is not specified", pf.Type, pf.Name)
-			}
+	var validResources []*latest_v1.PortForwardResource
+	for _, pf := range p.userDefinedResources {
+		switch {
+		case pf.Namespace != "" || isDockerContainer(*pf):
+			// Docker containers have no namespace
+		case pf.KubeContext != "":
+			// the deployed namespaces belong to the current context
+			pf.Namespace = "default"
+		case len(namespaces) == 1:
+			pf.Namespace = namespaces[0]
+		default:
+			logrus.Warnf("Skipping the port forwarding resource %s/%s because namespace is not specified", pf.Type, pf.Name)
+			continue
 		}
-		p.userDefinedResources = validResources
+		validResources = append(validResources, pf)
 	}
+	p.userDefinedResources = validResources
 
 	var serviceResources []*latest_v1.PortForwardResource
 	if p.services {
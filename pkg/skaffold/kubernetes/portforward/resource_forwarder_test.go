@@ -262,6 +262,18 @@ func TestUserDefinedResources(t *testing.T) {
 				"pod-pod-some-9001",
 			},
 		},
+		{
+			description: "docker containers and resources of other contexts don't need the deployed namespaces",
+			userResources: []*latest_v1.PortForwardResource{
+				{Type: constants.DockerContainer, Name: "db", Port: schemautil.FromInt(5432)},
+				{Type: constants.Service, Name: "backend", KubeContext: "staging", Port: schemautil.FromInt(8080)},
+			},
+			namespaces: []string{"test", "some"},
+			expectedResources: []string{
+				"docker-db--5432",
+				"staging-service-backend-default-8080",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,251 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+)
+
+const (
+	gcsPrefix = "gs://"
+	gitSuffix = ".git"
+)
+
+// Generator generates the dry manifests from raw manifests, kustomize directories,
+// helm charts and remote resources.
+type Generator struct {
+	workingDir   string
+	repoCacheDir string
+	config       latest_v2.Generate
+}
+
+// NewGenerator creates a new Generator for the manifests paths relative to workingDir.
+// Remote git resources are cloned into repoCacheDir.
+func NewGenerator(workingDir, repoCacheDir string, config latest_v2.Generate) *Generator {
+	return &Generator{
+		workingDir:   workingDir,
+		repoCacheDir: repoCacheDir,
+		config:       config,
+	}
+}
+
+// Generate reads the raw manifests and runs `kustomize build` on the kustomize directories,
+// then renders the helm charts with the built images and fetches the remote resources.
+// Local manifests are returned in the order in which their paths are listed.
+func (g *Generator) Generate(ctx context.Context, builds []graph.Artifact) (manifest.ManifestList, error) {
+	var manifests manifest.ManifestList
+	for _, p := range g.config.Manifests {
+		path := p
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(g.workingDir, path)
+		}
+
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			buf, err := g.generateFromDir(ctx, path)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, buf...)
+			continue
+		}
+
+		paths, err := util.ExpandPathsGlob(g.workingDir, []string{p})
+		if err != nil {
+			return nil, fmt.Errorf("expanding manifest paths %q: %w", p, err)
+		}
+		for _, path := range paths {
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading manifest %q: %w", path, err)
+			}
+			manifests.Append(buf)
+		}
+	}
+
+	helmManifests, err := g.generateFromHelm(ctx, builds)
+	if err != nil {
+		return nil, err
+	}
+	manifests = append(manifests, helmManifests...)
+
+	remoteManifests, err := g.generateFromRemote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifests = append(manifests, remoteManifests...)
+
+	return manifests, nil
+}
+
+// generateFromDir runs `kustomize build` if dir is a kustomization, or reads all
+// the yaml files under dir otherwise.
+func (g *Generator) generateFromDir(ctx context.Context, dir string) (manifest.ManifestList, error) {
+	var manifests manifest.ManifestList
+	if _, err := kustomize.FindKustomizationConfig(dir); err == nil {
+		cmd := exec.CommandContext(ctx, "kustomize", "build", dir)
+		buf, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("kustomize build %q: %w", dir, err)
+		}
+		manifests.Append(buf)
+		return manifests, nil
+	}
+
+	if err := walk.From(dir).WhenIsFile().Do(func(path string, _ walk.Dirent) error {
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading manifest %q: %w", path, err)
+		}
+		manifests.Append(buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+func (g *Generator) generateFromHelm(ctx context.Context, builds []graph.Artifact) (manifest.ManifestList, error) {
+	var manifests manifest.ManifestList
+	for _, r := range g.config.HelmCharts {
+		releaseName, err := util.ExpandEnvTemplateOrFail(r.Name, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot expand release name %q: %w", r.Name, err)
+		}
+		namespace, err := util.ExpandEnvTemplateOrFail(r.Namespace, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the release namespace template: %w", err)
+		}
+
+		args, err := helm.TemplateArgs(releaseName, r, builds, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := exec.CommandContext(ctx, "helm", args...)
+		cmd.Dir = g.workingDir
+		buf, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("helm template %q: %w", releaseName, err)
+		}
+		manifests.Append(buf)
+	}
+	return manifests, nil
+}
+
+func (g *Generator) generateFromRemote(ctx context.Context) (manifest.ManifestList, error) {
+	var manifests manifest.ManifestList
+	var gcsURLs []string
+	for _, url := range g.config.RemoteResources {
+		switch gitInfo, isGit := parseGitURL(url); {
+		case strings.HasPrefix(url, gcsPrefix):
+			// GCS resources are downloaded together to the same temp dir.
+			gcsURLs = append(gcsURLs, url)
+		case isGit:
+			repoDir, err := git.SyncRepo(gitInfo, config.SkaffoldOptions{RepoCacheDir: g.repoCacheDir})
+			if err != nil {
+				return nil, err
+			}
+			path := filepath.Join(repoDir, gitInfo.Path)
+			if info, err := os.Stat(path); err == nil && info.IsDir() {
+				buf, err := g.generateFromDir(ctx, path)
+				if err != nil {
+					return nil, err
+				}
+				manifests = append(manifests, buf...)
+				continue
+			}
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading manifest %q: %w", url, err)
+			}
+			manifests.Append(buf)
+		default:
+			buf, err := util.Download(url)
+			if err != nil {
+				return nil, fmt.Errorf("downloading manifest %q: %w", url, err)
+			}
+			manifests.Append(buf)
+		}
+	}
+
+	if len(gcsURLs) > 0 {
+		tmpDir, err := manifest.DownloadFromGCS(gcsURLs)
+		if err != nil {
+			return nil, fmt.Errorf("downloading from GCS: %w", err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		paths, err := util.ExpandPathsGlob(tmpDir, []string{"*"})
+		if err != nil {
+			return nil, fmt.Errorf("expanding manifest paths: %w", err)
+		}
+		for _, path := range paths {
+			if !kubernetes.HasKubernetesFileExtension(path) {
+				continue
+			}
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading manifest %q: %w", path, err)
+			}
+			manifests.Append(buf)
+		}
+	}
+	return manifests, nil
+}
+
+// parseGitURL parses remote resources of the form `<repo>.git//<path>?ref=<ref>`,
+// where both the path and the ref are optional.
+func parseGitURL(url string) (latest_v1.GitInfo, bool) {
+	idx := strings.Index(url, gitSuffix)
+	if idx == -1 {
+		return latest_v1.GitInfo{}, false
+	}
+
+	rest := url[idx+len(gitSuffix):]
+	if rest != "" && !strings.HasPrefix(rest, "//") && !strings.HasPrefix(rest, "?") {
+		return latest_v1.GitInfo{}, false
+	}
+
+	gitInfo := latest_v1.GitInfo{Repo: url[:idx+len(gitSuffix)]}
+	if i := strings.Index(rest, "?ref="); i != -1 {
+		gitInfo.Ref = rest[i+len("?ref="):]
+		rest = rest[:i]
+	}
+	gitInfo.Path = strings.TrimPrefix(rest, "//")
+	return gitInfo, true
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGenerateHelmCharts(t *testing.T) {
+	tests := []struct {
+		description string
+		charts      []latest_v1.HelmRelease
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "local chart with artifact overrides and values",
+			charts: []latest_v1.HelmRelease{{
+				Name:              "skaffold-helm",
+				ChartPath:         "charts",
+				ArtifactOverrides: map[string]string{"image": "leeroy-web"},
+				ValuesFiles:       []string{"values.yaml"},
+				SetValues:         map[string]string{"some.key": "somevalue"},
+				Namespace:         "test",
+			}},
+			commands: testutil.CmdRunOut("helm template skaffold-helm charts --set-string image=leeroy-web:v1 --set some.key=somevalue -f values.yaml --namespace test", podYaml),
+		},
+		{
+			description: "remote chart",
+			charts: []latest_v1.HelmRelease{{
+				Name:        "skaffold-helm",
+				RemoteChart: "stable/chartmuseum",
+				Repo:        "https://charts.helm.sh/stable",
+			}},
+			commands: testutil.CmdRunOut("helm template skaffold-helm stable/chartmuseum --repo https://charts.helm.sh/stable", podYaml),
+		},
+		{
+			description: "unknown artifact override",
+			charts: []latest_v1.HelmRelease{{
+				Name:              "skaffold-helm",
+				ChartPath:         "charts",
+				ArtifactOverrides: map[string]string{"image": "unknown"},
+			}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			g := NewGenerator(".", "", latest_v2.Generate{HelmCharts: test.charts})
+			manifests, err := g.Generate(context.Background(), []graph.Artifact{{ImageName: "leeroy-web", Tag: "leeroy-web:v1"}})
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(podYaml, string(manifests[0]))
+			}
+		})
+	}
+}
+
+func TestGenerateRemoteResources(t *testing.T) {
+	testutil.Run(t, "http and git", func(t *testutil.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, podYaml)
+		}))
+		defer server.Close()
+
+		repoDir := t.NewTempDir().Write("k8s/pod.yaml", podYaml)
+		var synced latest_v1.GitInfo
+		t.Override(&git.SyncRepo, func(g latest_v1.GitInfo, _ config.SkaffoldOptions) (string, error) {
+			synced = g
+			return repoDir.Root(), nil
+		})
+
+		g := NewGenerator(".", "", latest_v2.Generate{RemoteResources: []string{
+			server.URL + "/pod.yaml",
+			"https://github.com/org/repo.git//k8s/pod.yaml?ref=main",
+		}})
+		manifests, err := g.Generate(context.Background(), nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(manifests))
+		t.CheckDeepEqual(latest_v1.GitInfo{Repo: "https://github.com/org/repo.git", Path: "k8s/pod.yaml", Ref: "main"}, synced)
+	})
+}
+
+func TestParseGitURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected latest_v1.GitInfo
+		isGit    bool
+	}{
+		{
+			url:      "https://github.com/org/repo.git",
+			expected: latest_v1.GitInfo{Repo: "https://github.com/org/repo.git"},
+			isGit:    true,
+		},
+		{
+			url:      "git@github.com:org/repo.git//k8s?ref=v1",
+			expected: latest_v1.GitInfo{Repo: "git@github.com:org/repo.git", Path: "k8s", Ref: "v1"},
+			isGit:    true,
+		},
+		{
+			url: "https://org.github.io/manifests/pod.yaml",
+		},
+		{
+			url: "https://example.com/pod.yaml",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.url, func(t *testutil.T) {
+			gitInfo, isGit := parseGitURL(test.url)
+
+			t.CheckDeepEqual(test.isGit, isGit)
+			t.CheckDeepEqual(test.expected, gitInfo)
+		})
+	}
+}
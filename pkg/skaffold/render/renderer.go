@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+)
+
+// Renderer hydrates the manifests of a v2 pipeline: it generates the dry manifests,
+// then sets the built images and the skaffold labels.
+type Renderer struct {
+	generator *Generator
+	labels    map[string]string
+}
+
+// NewRenderer creates a Renderer for the `manifests` section of a v2 pipeline.
+// The v2 pipeline is built from a v1 config, which can't express transformers,
+// validators or an output directory, so these aren't supported yet.
+func NewRenderer(workingDir, repoCacheDir string, config latest_v2.RenderConfig, labels map[string]string) (*Renderer, error) {
+	if config.Transform != nil || config.Validate != nil || config.Output != "" {
+		return nil, errors.New("manifests transform, validate and output aren't supported yet")
+	}
+
+	r := &Renderer{labels: labels}
+	if config.Generate != nil {
+		r.generator = NewGenerator(workingDir, repoCacheDir, *config.Generate)
+	}
+	return r, nil
+}
+
+// Render returns the hydrated manifests.
+func (r *Renderer) Render(ctx context.Context, _ io.Writer, builds []graph.Artifact) (manifest.ManifestList, error) {
+	if r.generator == nil {
+		return nil, nil
+	}
+
+	manifests, err := r.generator.Generate(ctx, builds)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	manifests, err = manifests.ReplaceImages(builds)
+	if err != nil {
+		return nil, fmt.Errorf("replacing images in manifests: %w", err)
+	}
+
+	return manifests.SetLabels(r.labels)
+}
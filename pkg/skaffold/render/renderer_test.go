@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	podYaml = `apiVersion: v1
+kind: Pod
+metadata:
+  name: leeroy-web
+spec:
+  containers:
+  - image: leeroy-web
+    name: leeroy-web
+`
+	renderedPodYaml = `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    run-id: abc
+  name: leeroy-web
+spec:
+  containers:
+  - image: leeroy-web:v1
+    name: leeroy-web`
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest_v2.RenderConfig
+		kustomize   bool
+		commands    util.Command
+		expected    string
+	}{
+		{
+			description: "no generate",
+		},
+		{
+			description: "raw manifests",
+			config: latest_v2.RenderConfig{
+				Generate: &latest_v2.Generate{Manifests: []string{"pod.yaml"}},
+			},
+			expected: renderedPodYaml,
+		},
+		{
+			description: "manifests directory",
+			config: latest_v2.RenderConfig{
+				Generate: &latest_v2.Generate{Manifests: []string{"."}},
+			},
+			expected: renderedPodYaml,
+		},
+		{
+			description: "kustomize directory",
+			config: latest_v2.RenderConfig{
+				Generate: &latest_v2.Generate{Manifests: []string{"."}},
+			},
+			kustomize: true,
+			commands:  testutil.CmdRunOut("kustomize build .", podYaml),
+			expected:  renderedPodYaml,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			tmpDir := t.NewTempDir().Write("pod.yaml", podYaml)
+			if test.kustomize {
+				tmpDir.Write("kustomization.yaml", "resources:\n- pod.yaml\n")
+			}
+			tmpDir.Chdir()
+
+			r, err := NewRenderer(".", "", test.config, map[string]string{"run-id": "abc"})
+			t.CheckNoError(err)
+
+			manifests, err := r.Render(context.Background(), ioutil.Discard, []graph.Artifact{{ImageName: "leeroy-web", Tag: "leeroy-web:v1"}})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, manifests.String())
+		})
+	}
+}
+
+func TestNewRenderer_Unsupported(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest_v2.RenderConfig
+	}{
+		{
+			description: "transformer",
+			config:      latest_v2.RenderConfig{Transform: &[]latest_v2.Transformer{{Name: "set-labels"}}},
+		},
+		{
+			description: "validator",
+			config:      latest_v2.RenderConfig{Validate: &[]latest_v2.Validator{{Name: "kubeval"}}},
+		},
+		{
+			description: "output",
+			config:      latest_v2.RenderConfig{Output: "hydrated"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewRenderer(".", "", test.config, nil)
+
+			t.CheckErrorContains("aren't supported yet", err)
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -93,11 +94,36 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 
 		r.hasBuilt = true
 
+		var runners []hooks.Runner
+		for _, a := range artifacts {
+			if len(a.LifecycleHooks.PreHooks) == 0 && len(a.LifecycleHooks.PostHooks) == 0 {
+				continue
+			}
+			opts, err := hooks.NewBuildEnvOpts(a, tags[a.ImageName])
+			if err != nil {
+				return nil, err
+			}
+			runners = append(runners, hooks.BuildRunner(a.LifecycleHooks, opts))
+		}
+
+		hooksOut := io.MultiWriter(out, eventV2.NewLogger(constants.Build, "", "hooks"))
+		for _, h := range runners {
+			if err := h.RunPreHooks(ctx, hooksOut); err != nil {
+				return nil, err
+			}
+		}
+
 		bRes, err := r.builder.Build(ctx, out, tags, artifacts)
 		if err != nil {
 			return nil, err
 		}
 
+		for _, h := range runners {
+			if err := h.RunPostHooks(ctx, hooksOut); err != nil {
+				return nil, err
+			}
+		}
+
 		return bRes, nil
 	})
 	if err != nil {
@@ -115,6 +141,11 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 	return bRes, nil
 }
 
+// ExplainCache describes why the given artifacts would be rebuilt, or found in the artifact cache.
+func (r *Builder) ExplainCache(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
+	return r.cache.Explain(ctx, out, artifacts)
+}
+
 // HasBuilt returns true if this runner has built something.
 func (r *Builder) HasBuilt() bool {
 	return r.hasBuilt
@@ -28,6 +28,7 @@ type ChangeSet struct {
 	resyncTracker  map[string]*sync.Item
 	needsRetest    map[string]bool // keyed on artifact image name
 	needsRedeploy  bool
+	needsReverify  bool
 	needsReload    bool
 }
 
@@ -76,6 +77,10 @@ func (c *ChangeSet) resetDeploy() {
 	c.needsRedeploy = false
 }
 
+func (c *ChangeSet) resetVerify() {
+	c.needsReverify = false
+}
+
 func (c *ChangeSet) resetTest() {
 	c.needsRetest = make(map[string]bool)
 }
@@ -17,10 +17,22 @@ limitations under the License.
 package runner
 
 import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/debugging"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
+// For testing
+var attachDebuggers = debugging.AttachDebuggers
+
 func (r *SkaffoldRunner) createContainerManager() *debugging.ContainerManager {
 	if r.runCtx.Mode() != config.RunModes.Debug {
 		return nil
@@ -28,3 +40,61 @@ func (r *SkaffoldRunner) createContainerManager() *debugging.ContainerManager {
 
 	return debugging.NewContainerManager(r.podSelector)
 }
+
+func (r *SkaffoldRunner) createLaunchConfigWriter(artifacts []*latest_v1.Artifact) *debugging.LaunchConfigWriter {
+	if r.runCtx.Mode() != config.RunModes.Debug || !r.runCtx.IDELaunchConfigs() {
+		return nil
+	}
+
+	return debugging.NewLaunchConfigWriter(r.runCtx.GetWorkingDir(), artifacts)
+}
+
+// AttachDebuggers attaches debuggers to the running containers of the artifacts with ephemeral containers,
+// without redeploying them, then forwards the debug ports and reports the debugged containers until interrupted.
+func (r *SkaffoldRunner) AttachDebuggers(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
+	// the custom debug transformers and the launch configurations are keyed by the artifacts' image names
+	images := map[string]string{}
+	for _, a := range artifacts {
+		image, err := r.ApplyDefaultRepo(a.ImageName)
+		if err != nil {
+			return err
+		}
+		images[image] = a.ImageName
+	}
+
+	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(r.runCtx.GlobalConfig())
+	if err != nil {
+		return fmt.Errorf("retrieving debug helpers registry: %w", err)
+	}
+
+	attached, err := attachDebuggers(ctx, out, images, r.runCtx.GetNamespaces(), manifest.Registries{
+		DebugHelpersRegistry: debugHelpersRegistry,
+		InsecureRegistries:   r.runCtx.GetInsecureRegistries(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, image := range attached {
+		r.podSelector.Add(image)
+	}
+
+	forwarderManager := r.createForwarder(out)
+	defer forwarderManager.Stop()
+	if err := forwarderManager.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
+		logrus.Warnln("Error starting port forwarding:", err)
+	}
+
+	launchConfigWriter := r.createLaunchConfigWriter(artifacts)
+	defer launchConfigWriter.Stop()
+	launchConfigWriter.Start(ctx)
+
+	debugContainerManager := r.createContainerManager()
+	defer debugContainerManager.Stop()
+	if err := debugContainerManager.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
+		logrus.Warnln("Error starting debug container notification:", err)
+	}
+
+	color.Yellow.Fprintln(out, "Press Ctrl+C to exit")
+	<-ctx.Done()
+	return nil
+}
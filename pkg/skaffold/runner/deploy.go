@@ -18,6 +18,7 @@ package runner
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"io"
 	"time"
@@ -32,8 +33,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -62,6 +65,11 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 		logrus.Warnln("Error starting port forwarding:", err)
 	}
 
+	if err := r.Verify(ctx, out, artifacts, forwarderManager); err != nil {
+		eventV2.TaskFailed(constants.Deploy, err)
+		return err
+	}
+
 	// Start printing the logs after deploy is finished
 	if err := logger.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
 		eventV2.TaskFailed(constants.Deploy, err)
@@ -89,6 +97,10 @@ func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []
 		fmt.Fprintln(out, artifact.Tag)
 	}
 
+	if err := r.verifySignatures(out, artifacts); err != nil {
+		return err
+	}
+
 	var localImages []graph.Artifact
 	for _, a := range artifacts {
 		if isLocal, err := r.isLocalImage(a.ImageName); err != nil {
@@ -125,6 +137,15 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 
 	event.DeployInProgress()
 	eventV2.TaskInProgress(constants.Deploy)
+	hooksOut := io.MultiWriter(out, eventV2.NewLogger(constants.Deploy, "", "hooks"))
+	for _, h := range r.deployHooksRunners() {
+		if err := h.RunPreHooks(ctx, hooksOut); err != nil {
+			postDeployFn()
+			event.DeployFailed(err)
+			eventV2.TaskFailed(constants.Deploy, err)
+			return err
+		}
+	}
 	namespaces, err := r.deployer.Deploy(ctx, deployOut, artifacts)
 	postDeployFn()
 	if err != nil {
@@ -134,6 +155,14 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
 	r.hasDeployed = true
+	r.runCtx.UpdateNamespaces(namespaces)
+	for _, h := range r.deployHooksRunners() {
+		if err := h.RunPostHooks(ctx, hooksOut); err != nil {
+			event.DeployFailed(err)
+			eventV2.TaskFailed(constants.Deploy, err)
+			return err
+		}
+	}
 
 	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
 	defer postStatusCheckFn()
@@ -142,11 +171,41 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 	event.DeployComplete()
 	eventV2.TaskSucceeded(constants.Deploy)
-	r.runCtx.UpdateNamespaces(namespaces)
 	sErr := r.performStatusCheck(ctx, statusCheckOut)
+	if sErr != nil && r.runCtx.RollbackOnFailure() {
+		r.rollback(ctx, out)
+	}
 	return sErr
 }
 
+// rollback reverts the last deployment to its previous state after a failed status check.
+// A failed rollback is reported, but the status check error remains the one returned to the user.
+func (r *SkaffoldRunner) rollback(ctx context.Context, out io.Writer) {
+	eventV2.TaskInProgress(constants.Rollback)
+	color.Yellow.Fprintln(out, "Status check failed. Rolling back to the previous deployment...")
+
+	if err := r.deployer.Rollback(ctx, out); err != nil {
+		color.Red.Fprintln(out, "Rollback failed:", err)
+		eventV2.TaskFailed(constants.Rollback, err)
+		return
+	}
+
+	color.Default.Fprintln(out, "Rollback complete.")
+	eventV2.TaskSucceeded(constants.Rollback)
+}
+
+// deployHooksRunners returns the lifecycle hooks runners for each deploy configuration.
+func (r *SkaffoldRunner) deployHooksRunners() []hooks.Runner {
+	namespaces := r.runCtx.GetNamespaces()
+	opts := hooks.NewDeployEnvOpts(r.labeller.GetRunID(), r.runCtx.GetKubeContext(), namespaces)
+
+	var runners []hooks.Runner
+	for _, d := range r.runCtx.DeployConfigs() {
+		runners = append(runners, hooks.DeployRunner(r.kubectlCLI, d.LifecycleHooks, namespaces, opts))
+	}
+	return runners
+}
+
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
 	currentContext, err := r.getCurrentContext()
 	if err != nil {
@@ -174,6 +233,33 @@ func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Write
 	return nil
 }
 
+// verifySignatures refuses to deploy images that aren't signed by the key configured
+// in the `deploy.signatureVerification` section of their pipeline.
+func (r *SkaffoldRunner) verifySignatures(out io.Writer, artifacts []graph.Artifact) error {
+	keys := make(map[string]*ecdsa.PublicKey)
+	for _, a := range artifacts {
+		p, found := r.runCtx.PipelineForImage(a.ImageName)
+		if !found || p.Deploy.SignatureVerification == nil {
+			continue
+		}
+
+		path := p.Deploy.SignatureVerification.PublicKey
+		if _, loaded := keys[path]; !loaded {
+			key, err := sign.LoadPublicKey(path)
+			if err != nil {
+				return fmt.Errorf("loading public key for %s: %w", a.ImageName, err)
+			}
+			keys[path] = key
+		}
+
+		color.Default.Fprintf(out, "Verifying signature of %s\n", a.ImageName)
+		if err := verifySignature(a, []*ecdsa.PublicKey{keys[path]}, r.runCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *SkaffoldRunner) getCurrentContext() (*api.Context, error) {
 	currentCfg, err := kubectx.CurrentConfig()
 	if err != nil {
@@ -19,6 +19,7 @@ package runner
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -30,6 +31,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/status"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
@@ -207,8 +209,124 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 	})
 }
 
-type dummyStatusChecker struct{}
+func TestDeployRollbackOnFailure(t *testing.T) {
+	tests := []struct {
+		description        string
+		rollbackOnFailure  bool
+		statusCheckErr     error
+		rollbackErr        error
+		expectedRolledBack bool
+		expectedOutput     string
+	}{
+		{
+			description:       "no rollback when status check succeeds",
+			rollbackOnFailure: true,
+		},
+		{
+			description:    "no rollback when disabled",
+			statusCheckErr: errors.New("deployment failed"),
+		},
+		{
+			description:        "rollback when status check fails",
+			rollbackOnFailure:  true,
+			statusCheckErr:     errors.New("deployment failed"),
+			expectedRolledBack: true,
+			expectedOutput:     "Rollback complete.",
+		},
+		{
+			description:        "failed rollback",
+			rollbackOnFailure:  true,
+			statusCheckErr:     errors.New("deployment failed"),
+			rollbackErr:        errors.New("rollback error"),
+			expectedRolledBack: true,
+			expectedOutput:     "Rollback failed: rollback error",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&client.Client, mockK8sClient)
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+				return dummyStatusChecker{err: test.statusCheckErr}
+			})
+
+			testBench := &TestBench{rollbackErr: test.rollbackErr}
+			runner := createRunner(t, testBench, nil, []*latest_v1.Artifact{{ImageName: "img1"}}, nil)
+			runner.runCtx.Pipelines.All()[0].Deploy.RollbackOnFailure = test.rollbackOnFailure
+			out := new(bytes.Buffer)
+
+			err := runner.Deploy(context.Background(), out, []graph.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckErrorAndDeepEqual(test.statusCheckErr != nil, err, test.expectedRolledBack, testBench.rolledBack)
+			t.CheckContains(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestDeployVerifySignatures(t *testing.T) {
+	tests := []struct {
+		description    string
+		verify         bool
+		verifyErr      error
+		shouldErr      bool
+		expectedOutput string
+	}{
+		{
+			description: "no verification when not configured",
+		},
+		{
+			description:    "signed image",
+			verify:         true,
+			expectedOutput: "Verifying signature of img1",
+		},
+		{
+			description: "unsigned image",
+			verify:      true,
+			verifyErr:   errors.New("no signature found"),
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&client.Client, mockK8sClient)
+			tmpDir := t.NewTempDir().Write("cosign.pub", testPublicKey)
+			verified := false
+			t.Override(&verifySignature, func(a graph.Artifact, keys []*ecdsa.PublicKey, _ docker.Config) error {
+				verified = true
+				t.CheckDeepEqual(1, len(keys))
+				return test.verifyErr
+			})
+
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil, []*latest_v1.Artifact{{ImageName: "img1"}}, nil)
+			pipelines := runner.runCtx.Pipelines.All()
+			if test.verify {
+				pipelines[0].Deploy.SignatureVerification = &latest_v1.SignatureVerificationConfig{PublicKey: tmpDir.Path("cosign.pub")}
+			}
+			runner.runCtx.Pipelines = runcontext.NewPipelines(pipelines)
+			out := new(bytes.Buffer)
+
+			err := runner.Deploy(context.Background(), out, []graph.Artifact{{ImageName: "img1", Tag: "img1:tag1@sha256:abc"}})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.verify, verified)
+			t.CheckContains(test.expectedOutput, out.String())
+		})
+	}
+}
+
+const testPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEIFg2YOEenxcO2RMoWZvfmqAl1OAx
+VN7FrqRlt8xDvO61MGkWmuDLD9L82ahNcMfN28iJWUBRbibei9dWqCJkHA==
+-----END PUBLIC KEY-----
+`
+
+type dummyStatusChecker struct {
+	err error
+}
 
 func (d dummyStatusChecker) Check(_ context.Context, _ io.Writer) error {
-	return nil
+	return d.err
 }
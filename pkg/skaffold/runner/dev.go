@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -31,8 +32,10 @@ import (
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/logger"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
@@ -50,7 +53,7 @@ var (
 	fileSyncSucceeded  = event.FileSyncSucceeded
 )
 
-func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logger.LogAggregator, forwarderManager portforward.Forwarder) error {
+func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logger.LogAggregator, forwarderManager *portforward.ForwarderManager, downloader *sync.Downloader) error {
 	// never queue intents from user, even if they're not used
 	defer r.intents.reset()
 
@@ -89,6 +92,16 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logge
 			color.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
 			fileSyncInProgress(fileCount, s.Image)
 
+			hooksRunner := r.syncHooksRunner(s)
+			hooksOut := io.MultiWriter(out, eventV2.NewLogger(constants.Sync, s.Image, "hooks"))
+			if err := hooksRunner.RunPreHooks(ctx, hooksOut); err != nil {
+				logrus.Warnln("Skipping sync due to pre-sync hook error:", err)
+				fileSyncFailed(fileCount, s.Image, err)
+				event.DevLoopFailedInPhase(r.devIteration, constants.Sync, err)
+				eventV2.TaskFailed(constants.DevLoop, err)
+				return nil
+			}
+
 			if err := r.syncer.Sync(ctx, s); err != nil {
 				logrus.Warnln("Skipping deploy due to sync error:", err)
 				fileSyncFailed(fileCount, s.Image, err)
@@ -97,7 +110,16 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logge
 				return nil
 			}
 
+			if err := hooksRunner.RunPostHooks(ctx, hooksOut); err != nil {
+				logrus.Warnln("Skipping deploy due to post-sync hook error:", err)
+				fileSyncFailed(fileCount, s.Image, err)
+				event.DevLoopFailedInPhase(r.devIteration, constants.Sync, err)
+				eventV2.TaskFailed(constants.DevLoop, err)
+				return nil
+			}
+
 			fileSyncSucceeded(fileCount, s.Image)
+			r.changeSet.needsReverify = true
 		}
 	}
 
@@ -157,6 +179,7 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logge
 		}()
 
 		forwarderManager.Stop()
+		downloader.Stop()
 		if !meterUpdated {
 			instrumentation.AddDevIteration("deploy")
 		}
@@ -169,6 +192,18 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *logge
 		if err := forwarderManager.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
 			logrus.Warnln("Port forwarding failed:", err)
 		}
+		downloader.Start(ctx, r.builds)
+		r.changeSet.needsReverify = true
+	}
+
+	if r.changeSet.needsReverify {
+		r.changeSet.resetVerify()
+		if err := r.Verify(ctx, out, r.builds, forwarderManager); err != nil {
+			logrus.Warnln("Verification failed:", err)
+			event.DevLoopFailedInPhase(r.devIteration, constants.Verify, err)
+			eventV2.TaskFailed(constants.DevLoop, err)
+			return nil
+		}
 	}
 	event.DevLoopComplete(r.devIteration)
 	eventV2.TaskSucceeded(constants.DevLoop)
@@ -263,6 +298,8 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		eventV2.TaskFailed(constants.DevLoop, err)
 		return fmt.Errorf("exiting dev mode because initializing sync state failed: %w", err)
 	}
+	// Inject the sync helper in the pods of the artifacts synced with the helper transport
+	defer manifest.AddTransform(sync.HelperInjector(artifacts))()
 
 	// First build
 	bRes, err := r.Build(ctx, out, artifacts)
@@ -286,6 +323,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	debugContainerManager := r.createContainerManager()
 	defer debugContainerManager.Stop()
 
+	launchConfigWriter := r.createLaunchConfigWriter(artifacts)
+	defer launchConfigWriter.Stop()
+	launchConfigWriter.Start(ctx)
+
 	// Logs should be retrieved up to just before the deploy
 	logger.SetSince(time.Now())
 
@@ -302,6 +343,14 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	if err := forwarderManager.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
 		logrus.Warnln("Error starting port forwarding:", err)
 	}
+	downloader := sync.NewDownloader(r.syncer, r.labeller.GetRunID(), artifacts, r.monitor.Ignore)
+	defer downloader.Stop()
+	downloader.Start(ctx, r.builds)
+
+	if err := r.Verify(ctx, out, r.builds, forwarderManager); err != nil {
+		logrus.Warnln("Verification failed:", err)
+	}
+
 	if err := debugContainerManager.Start(ctx, r.runCtx.GetNamespaces()); err != nil {
 		logrus.Warnln("Error starting debug container notification:", err)
 	}
@@ -316,10 +365,49 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	eventV2.TaskSucceeded(constants.DevLoop)
 	r.devIteration++
 	return r.listener.WatchForChanges(ctx, out, func() error {
-		return r.doDev(ctx, out, logger, forwarderManager)
+		return r.doDev(ctx, out, logger, forwarderManager, downloader)
 	})
 }
 
+// syncHooksRunner returns the lifecycle hooks runner for the artifact targeted by a sync item.
+func (r *SkaffoldRunner) syncHooksRunner(s *sync.Item) hooks.Runner {
+	var copied, deleted []string
+	for src := range s.Copy {
+		copied = append(copied, src)
+	}
+	for src := range s.Delete {
+		deleted = append(deleted, src)
+	}
+	sort.Strings(copied)
+	sort.Strings(deleted)
+
+	namespaces := r.runCtx.GetNamespaces()
+	for _, b := range r.builds {
+		if b.Tag != s.Image {
+			continue
+		}
+		for _, a := range r.runCtx.Artifacts() {
+			if a.ImageName != b.ImageName || a.Sync == nil {
+				continue
+			}
+			opts := hooks.NewSyncEnvOpts(a, s.Image, copied, deleted, namespaces, r.runCtx.GetKubeContext())
+			return hooks.SyncRunner(r.kubectlCLI, s.Image, namespaces, r.labeller.GetRunID(), withAfterSyncActions(a.Sync.LifecycleHooks, s.AfterSync), opts)
+		}
+	}
+
+	return hooks.SyncRunner(r.kubectlCLI, s.Image, namespaces, r.labeller.GetRunID(), latest_v1.SyncHooks{}, hooks.SyncEnvOpts{})
+}
+
+// withAfterSyncActions returns the sync hooks with the after-sync actions of a sync item run as the first post-sync container hooks.
+func withAfterSyncActions(h latest_v1.SyncHooks, actions []latest_v1.ContainerHook) latest_v1.SyncHooks {
+	var postHooks []latest_v1.SyncHookItem
+	for i := range actions {
+		postHooks = append(postHooks, latest_v1.SyncHookItem{ContainerHook: &actions[i]})
+	}
+	h.PostHooks = append(postHooks, h.PostHooks...)
+	return h
+}
+
 // graph represents the artifact graph
 type devGraph map[string][]*latest_v1.Artifact
 
@@ -47,6 +47,8 @@ func (t *NoopMonitor) Run(bool) error {
 
 func (t *NoopMonitor) Reset() {}
 
+func (t *NoopMonitor) Ignore(...string) {}
+
 type FailMonitor struct{}
 
 func (t *FailMonitor) Register(func() ([]string, error), func(filemon.Events)) error {
@@ -59,6 +61,8 @@ func (t *FailMonitor) Run(bool) error {
 
 func (t *FailMonitor) Reset() {}
 
+func (t *FailMonitor) Ignore(...string) {}
+
 type TestMonitor struct {
 	events    []filemon.Events
 	callbacks []func(filemon.Events)
@@ -94,6 +98,8 @@ func (t *TestMonitor) Run(bool) error {
 
 func (t *TestMonitor) Reset() {}
 
+func (t *TestMonitor) Ignore(...string) {}
+
 func mockK8sClient() (k8s.Interface, error) {
 	return fakekubeclientset.NewSimpleClientset(), nil
 }
@@ -548,3 +554,35 @@ func TestDevSync(t *testing.T) {
 		})
 	}
 }
+
+func TestWithAfterSyncActions(t *testing.T) {
+	reload := latest_v1.ContainerHook{Command: []string{"nginx", "-s", "reload"}}
+	notify := latest_v1.SyncHookItem{HostHook: &latest_v1.HostHook{Command: []string{"notify"}}}
+
+	tests := []struct {
+		description string
+		hooks       latest_v1.SyncHooks
+		actions     []latest_v1.ContainerHook
+		expected    latest_v1.SyncHooks
+	}{
+		{
+			description: "no actions",
+			hooks:       latest_v1.SyncHooks{PostHooks: []latest_v1.SyncHookItem{notify}},
+			expected:    latest_v1.SyncHooks{PostHooks: []latest_v1.SyncHookItem{notify}},
+		},
+		{
+			description: "actions run before the post-sync hooks",
+			hooks:       latest_v1.SyncHooks{PreHooks: []latest_v1.SyncHookItem{notify}, PostHooks: []latest_v1.SyncHookItem{notify}},
+			actions:     []latest_v1.ContainerHook{reload},
+			expected: latest_v1.SyncHooks{
+				PreHooks:  []latest_v1.SyncHookItem{notify},
+				PostHooks: []latest_v1.SyncHookItem{{ContainerHook: &reload}, notify},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, withAfterSyncActions(test.hooks, test.actions))
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
@@ -47,6 +49,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 // NewForConfig returns a new SkaffoldRunner for a SkaffoldConfig
@@ -81,6 +84,12 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		return nil, fmt.Errorf("creating tester: %w", err)
 	}
 	syncer := getSyncer(runCtx)
+	if runCtx.Mode() == config.RunModes.Debug {
+		// register the custom debug transformers for both redeployed and attached containers
+		if err := debug.Init(runCtx.Artifacts()); err != nil {
+			return nil, fmt.Errorf("initializing debug transforms: %w", err)
+		}
+	}
 	var deployer deploy.Deployer
 	deployer, err = getDeployer(runCtx, labeller.Labels())
 	if err != nil {
@@ -136,6 +145,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		deployer: deployer,
 		syncer:   syncer,
 		monitor:  monitor,
+		verifier: verify.NewVerifier(runCtx, kubectlCLI, labeller.GetRunID()),
 		listener: &SkaffoldListener{
 			Monitor:                 monitor,
 			Trigger:                 trigger,
@@ -19,6 +19,7 @@ package runner
 import (
 	"io"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 )
 
@@ -29,6 +30,8 @@ func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderMa
 
 	return portforward.NewForwarderManager(out,
 		r.kubectlCLI,
+		r.runCtx,
+		config.UseNativeKubeClient(r.runCtx.GlobalConfig()),
 		r.podSelector,
 		r.labeller.RunIDSelector(),
 		r.runCtx.Mode(),
@@ -110,6 +110,14 @@ func (ps Pipelines) TestCases() []*latest_v1.TestCase {
 	return tests
 }
 
+func (ps Pipelines) VerifyTestCases() []*latest_v1.VerifyTestCase {
+	var tests []*latest_v1.VerifyTestCase
+	for _, p := range ps.pipelines {
+		tests = append(tests, p.Verify...)
+	}
+	return tests
+}
+
 func (ps Pipelines) StatusCheck() (*bool, error) {
 	var enabled, disabled bool
 	for _, p := range ps.pipelines {
@@ -142,6 +150,24 @@ func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	}
 	return c
 }
+
+func (ps Pipelines) CustomResourceStatusChecks() []latest_v1.CustomResourceStatusCheck {
+	var checks []latest_v1.CustomResourceStatusCheck
+	for _, p := range ps.pipelines {
+		checks = append(checks, p.Deploy.CustomResourceStatusChecks...)
+	}
+	return checks
+}
+
+func (ps Pipelines) RollbackOnFailure() bool {
+	for _, p := range ps.pipelines {
+		if p.Deploy.RollbackOnFailure {
+			return true
+		}
+	}
+	return false
+}
+
 func NewPipelines(pipelines []latest_v1.Pipeline) Pipelines {
 	m := make(map[string]latest_v1.Pipeline)
 	for _, p := range pipelines {
@@ -168,6 +194,10 @@ func (rc *RunContext) Deployers() []latest_v1.DeployType { return rc.Pipelines.D
 
 func (rc *RunContext) TestCases() []*latest_v1.TestCase { return rc.Pipelines.TestCases() }
 
+func (rc *RunContext) VerifyTestCases() []*latest_v1.VerifyTestCase {
+	return rc.Pipelines.VerifyTestCases()
+}
+
 func (rc *RunContext) StatusCheck() (*bool, error) {
 	scOpts := rc.Opts.StatusCheck.Value()
 	scConfig, err := rc.Pipelines.StatusCheck()
@@ -188,6 +218,14 @@ func (rc *RunContext) StatusCheckDeadlineSeconds() int {
 	return rc.Pipelines.StatusCheckDeadlineSeconds()
 }
 
+func (rc *RunContext) CustomResourceStatusChecks() []latest_v1.CustomResourceStatusCheck {
+	return rc.Pipelines.CustomResourceStatusChecks()
+}
+
+func (rc *RunContext) RollbackOnFailure() bool {
+	return rc.Pipelines.RollbackOnFailure()
+}
+
 func (rc *RunContext) DefaultPipeline() latest_v1.Pipeline       { return rc.Pipelines.Head() }
 func (rc *RunContext) GetKubeContext() string                    { return rc.KubeContext }
 func (rc *RunContext) GetNamespaces() []string                   { return rc.Namespaces }
@@ -213,6 +251,7 @@ func (rc *RunContext) GetKubeConfig() string                     { return rc.Opt
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
 func (rc *RunContext) GlobalConfig() string                      { return rc.Opts.GlobalConfig }
 func (rc *RunContext) HydratedManifests() []string               { return rc.Opts.HydratedManifests }
+func (rc *RunContext) IDELaunchConfigs() bool                    { return rc.Opts.IDELaunchConfigs }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
@@ -224,10 +263,13 @@ func (rc *RunContext) RenderOutput() string                      { return rc.Opt
 func (rc *RunContext) SkipRender() bool                          { return rc.Opts.SkipRender }
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) Tail() bool                                { return rc.Opts.Tail }
+func (rc *RunContext) TestReport() string                        { return rc.Opts.TestReport }
 func (rc *RunContext) Trigger() string                           { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 func (rc *RunContext) BuildConcurrency() int                     { return rc.Opts.BuildConcurrency }
+func (rc *RunContext) TestConcurrency() int                      { return rc.Opts.TestConcurrency }
+func (rc *RunContext) TestFilter() []string                      { return rc.Opts.TestFilter }
 func (rc *RunContext) IsMultiConfig() bool                       { return rc.Pipelines.IsMultiPipeline() }
 
 func GetRunContext(opts config.SkaffoldOptions, configs []*latest_v1.SkaffoldConfig) (*RunContext, error) {
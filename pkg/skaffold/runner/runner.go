@@ -31,7 +31,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 const (
@@ -44,11 +46,13 @@ const (
 type Runner interface {
 	Apply(context.Context, io.Writer) error
 	ApplyDefaultRepo(tag string) (string, error)
+	AttachDebuggers(context.Context, io.Writer, []*latest_v1.Artifact) error
 	Build(context.Context, io.Writer, []*latest_v1.Artifact) ([]graph.Artifact, error)
 	Cleanup(context.Context, io.Writer) error
 	Dev(context.Context, io.Writer, []*latest_v1.Artifact) error
 	Deploy(context.Context, io.Writer, []graph.Artifact) error
 	DeployAndLog(context.Context, io.Writer, []graph.Artifact) error
+	ExplainCache(context.Context, io.Writer, []*latest_v1.Artifact) error
 	GeneratePipeline(context.Context, io.Writer, []*latest_v1.SkaffoldConfig, []string, string) error
 	HasBuilt() bool
 	HasDeployed() bool
@@ -67,6 +71,7 @@ type SkaffoldRunner struct {
 	syncer   sync.Syncer
 	monitor  filemon.Monitor
 	listener Listener
+	verifier verify.Verifier
 
 	kubectlCLI         *kubectl.CLI
 	cache              cache.Cache
@@ -86,7 +91,8 @@ type SkaffoldRunner struct {
 
 // for testing
 var (
-	newStatusCheck = status.NewStatusChecker
+	newStatusCheck  = status.NewStatusChecker
+	verifySignature = sign.Verify
 )
 
 // HasDeployed returns true if this runner has deployed something.
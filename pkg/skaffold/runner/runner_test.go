@@ -61,6 +61,8 @@ type TestBench struct {
 	userIntents   []func(*Intents)
 	intents       *Intents
 	intentTrigger bool
+	rolledBack    bool
+	rollbackErr   error
 
 	devLoop        func(context.Context, io.Writer, func() error) error
 	firstMonitor   func(bool) error
@@ -105,6 +107,11 @@ func (t *TestBench) Dependencies() ([]string, error)                        { re
 func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error       { return nil }
 func (t *TestBench) Prune(ctx context.Context, out io.Writer) error         { return nil }
 
+func (t *TestBench) Rollback(ctx context.Context, out io.Writer) error {
+	t.rolledBack = true
+	return t.rollbackErr
+}
+
 func (t *TestBench) enterNewCycle() {
 	t.actions = append(t.actions, t.currentActions)
 	t.currentActions = Actions{}
@@ -283,6 +290,7 @@ func TestNewForConfig(t *testing.T) {
 	tests := []struct {
 		description      string
 		pipeline         latest_v1.Pipeline
+		command          string
 		shouldErr        bool
 		cacheArtifacts   bool
 		expectedBuilder  build.BuilderMux
@@ -410,6 +418,28 @@ func TestNewForConfig(t *testing.T) {
 				&kustomize.Deployer{},
 			}),
 		},
+		{
+			description: "invalid debug transformer",
+			pipeline: latest_v1.Pipeline{
+				Build: latest_v1.BuildConfig{
+					Artifacts: []*latest_v1.Artifact{{
+						ImageName: "img",
+						Debug:     &latest_v1.DebugTransformer{Runtime: "custom", CommandMatch: "("},
+					}},
+					TagPolicy: latest_v1.TagPolicy{ShaTagger: &latest_v1.ShaTagger{}},
+					BuildType: latest_v1.BuildType{
+						LocalBuild: &latest_v1.LocalBuild{},
+					},
+				},
+				Deploy: latest_v1.DeployConfig{
+					DeployType: latest_v1.DeployType{
+						KubectlDeploy: &latest_v1.KubectlDeploy{},
+					},
+				},
+			},
+			command:   "debug",
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -422,6 +452,7 @@ func TestNewForConfig(t *testing.T) {
 			runCtx := &runcontext.RunContext{
 				Pipelines: runcontext.NewPipelines([]latest_v1.Pipeline{test.pipeline}),
 				Opts: config.SkaffoldOptions{
+					Command: test.command,
 					Trigger: "polling",
 				},
 			}
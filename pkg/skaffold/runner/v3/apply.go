@@ -17,10 +17,20 @@ package v3
 
 import (
 	"context"
-	"fmt"
 	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 )
 
+// Apply deploys the already hydrated manifests without rendering them again.
 func (r *SkaffoldRunner) Apply(ctx context.Context, out io.Writer) error {
-	return fmt.Errorf("not implemented error: SkaffoldRunner(v3).Apply")
+	eventV2.TaskInProgress(constants.Deploy)
+	if err := r.deployer.Apply(ctx, out); err != nil {
+		eventV2.TaskFailed(constants.Deploy, err)
+		return err
+	}
+	r.hasDeployed = true
+	eventV2.TaskSucceeded(constants.Deploy)
+	return nil
 }
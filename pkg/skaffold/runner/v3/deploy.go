@@ -17,12 +17,30 @@ package v3
 
 import (
 	"context"
-	"fmt"
 	"io"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 )
 
 func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
-	return fmt.Errorf("not implemented error: SkaffoldRunner(v3).Deploy")
+	manifests, err := r.render(ctx, out, artifacts)
+	if err != nil {
+		return err
+	}
+
+	eventV2.TaskInProgress(constants.Deploy)
+	if _, err := r.deployer.Deploy(ctx, out, manifests); err != nil {
+		eventV2.TaskFailed(constants.Deploy, err)
+		return err
+	}
+	r.hasDeployed = true
+	eventV2.TaskSucceeded(constants.Deploy)
+	return nil
+}
+
+// DeployAndLog deploys the hydrated manifests. The v3 runner doesn't stream the logs of the deployed containers yet.
+func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
+	return r.Deploy(ctx, out, artifacts)
 }
@@ -26,3 +26,7 @@ import (
 func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
 	return fmt.Errorf("not implemented error: SkaffoldRunner(v3).Dev")
 }
+
+func (r *SkaffoldRunner) AttachDebuggers(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
+	return fmt.Errorf("not implemented error: SkaffoldRunner(v3).AttachDebuggers")
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+)
+
+// NewForConfig returns a new v3 SkaffoldRunner for a SkaffoldConfig. Artifacts are built and tested
+// like with the v1 runner, while manifests are rendered and deployed with the v2 manifests pipeline.
+func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
+	pipelines := runCtx.GetPipelines()
+	if len(pipelines) != 1 {
+		return nil, fmt.Errorf("the v3 runner supports a single skaffold config, found %d", len(pipelines))
+	}
+
+	v1Runner, err := runner.NewForConfig(runCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	labeller := label.NewLabeller(runCtx.AddSkaffoldLabels(), runCtx.CustomLabels())
+	return NewSkaffoldRunner(v1Runner.Builder, v1Runner.Pruner, v1Runner.Tester, runCtx.GetWorkingDir(), runCtx.Opts.RepoCacheDir, labeller.Labels(), v2Pipeline(pipelines[0]))
+}
+
+// v2Pipeline maps the deploy section of a v1 pipeline onto the `manifests` and `deploy` sections of a v2 pipeline.
+// Kubectl manifests, kustomize paths, helm releases and the kpt package are all rendered by the v2 generator,
+// then applied with `kpt live`.
+func v2Pipeline(p latest_v1.Pipeline) latest_v2.Pipeline {
+	var generate latest_v2.Generate
+	deploy := latest_v2.DeployConfig{
+		KubeContext: p.Deploy.KubeContext,
+		Logs:        latest_v2.LogsConfig{Prefix: p.Deploy.Logs.Prefix},
+	}
+	if p.Deploy.StatusCheckDeadlineSeconds > 0 {
+		deploy.StatusCheckDeadlineSeconds = fmt.Sprintf("%ds", p.Deploy.StatusCheckDeadlineSeconds)
+	}
+
+	if d := p.Deploy.KubectlDeploy; d != nil {
+		generate.Manifests = append(generate.Manifests, d.Manifests...)
+	}
+	if d := p.Deploy.KustomizeDeploy; d != nil {
+		generate.Manifests = append(generate.Manifests, d.KustomizePaths...)
+	}
+	if d := p.Deploy.HelmDeploy; d != nil {
+		generate.HelmCharts = append(generate.HelmCharts, d.Releases...)
+	}
+	if d := p.Deploy.KptDeploy; d != nil {
+		generate.Manifests = append(generate.Manifests, d.Dir)
+		deploy.Dir = d.Live.Apply.Dir
+		deploy.InventoryID = d.Live.Apply.InventoryID
+		deploy.InventoryNamespace = d.Live.Apply.InventoryNamespace
+		deploy.PrunePropagationPolicy = d.Live.Options.PrunePropagationPolicy
+		deploy.PruneTimeout = d.Live.Options.PruneTimeout
+		deploy.ReconcileTimeout = d.Live.Options.ReconcileTimeout
+	}
+
+	return latest_v2.Pipeline{
+		Build:       p.Build,
+		Test:        p.Test,
+		Render:      latest_v2.RenderConfig{Generate: &generate},
+		Deploy:      deploy,
+		PortForward: p.PortForward,
+	}
+}
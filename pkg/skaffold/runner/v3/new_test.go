@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestV2Pipeline(t *testing.T) {
+	tests := []struct {
+		description string
+		deploy      latest_v1.DeployConfig
+		expected    latest_v2.Pipeline
+	}{
+		{
+			description: "kubectl and kustomize",
+			deploy: latest_v1.DeployConfig{
+				DeployType: latest_v1.DeployType{
+					KubectlDeploy:   &latest_v1.KubectlDeploy{Manifests: []string{"k8s/*.yaml"}},
+					KustomizeDeploy: &latest_v1.KustomizeDeploy{KustomizePaths: []string{"overlays/dev"}},
+				},
+				StatusCheckDeadlineSeconds: 120,
+				KubeContext:                "kubecontext",
+			},
+			expected: latest_v2.Pipeline{
+				Render: latest_v2.RenderConfig{Generate: &latest_v2.Generate{
+					Manifests: []string{"k8s/*.yaml", "overlays/dev"},
+				}},
+				Deploy: latest_v2.DeployConfig{
+					StatusCheckDeadlineSeconds: "120s",
+					KubeContext:                "kubecontext",
+				},
+			},
+		},
+		{
+			description: "helm",
+			deploy: latest_v1.DeployConfig{
+				DeployType: latest_v1.DeployType{
+					HelmDeploy: &latest_v1.HelmDeploy{Releases: []latest_v1.HelmRelease{{Name: "app", ChartPath: "charts/app"}}},
+				},
+			},
+			expected: latest_v2.Pipeline{
+				Render: latest_v2.RenderConfig{Generate: &latest_v2.Generate{
+					HelmCharts: []latest_v1.HelmRelease{{Name: "app", ChartPath: "charts/app"}},
+				}},
+			},
+		},
+		{
+			description: "kpt",
+			deploy: latest_v1.DeployConfig{
+				DeployType: latest_v1.DeployType{
+					KptDeploy: &latest_v1.KptDeploy{
+						Dir: "kpt",
+						Live: latest_v1.KptLive{
+							Apply:   latest_v1.KptApplyInventory{Dir: "hydrated", InventoryID: "id", InventoryNamespace: "ns"},
+							Options: latest_v1.KptApplyOptions{PruneTimeout: "2m", ReconcileTimeout: "1m"},
+						},
+					},
+				},
+			},
+			expected: latest_v2.Pipeline{
+				Render: latest_v2.RenderConfig{Generate: &latest_v2.Generate{
+					Manifests: []string{"kpt"},
+				}},
+				Deploy: latest_v2.DeployConfig{
+					Dir:                "hydrated",
+					InventoryID:        "id",
+					InventoryNamespace: "ns",
+					PruneTimeout:       "2m",
+					ReconcileTimeout:   "1m",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actual := v2Pipeline(latest_v1.Pipeline{Deploy: test.deploy})
+
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
@@ -17,12 +17,30 @@ package v3
 
 import (
 	"context"
-	"fmt"
 	"io"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 )
 
 func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, offline bool, filepath string) error {
-	return fmt.Errorf("not implemented error: SkaffoldRunner(v3).Dev")
+	manifests, err := r.render(ctx, out, builds)
+	if err != nil {
+		return err
+	}
+	return manifest.Write(manifests.String(), filepath, out)
+}
+
+// render hydrates the manifests of the pipeline and emits the render task events.
+func (r *SkaffoldRunner) render(ctx context.Context, out io.Writer, builds []graph.Artifact) (manifest.ManifestList, error) {
+	eventV2.TaskInProgress(constants.Render)
+	manifests, err := r.renderer.Render(ctx, out, builds)
+	if err != nil {
+		eventV2.TaskFailed(constants.Render, err)
+		return nil, err
+	}
+	eventV2.TaskSucceeded(constants.Render)
+	return manifests, nil
 }
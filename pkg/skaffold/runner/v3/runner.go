@@ -16,13 +16,36 @@ limitations under the License.
 package v3
 
 import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/render"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	latest_v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v2"
 )
 
 type SkaffoldRunner struct {
 	runner.Builder
 	runner.Pruner
 	runner.Tester
+
+	renderer    *render.Renderer
+	deployer    *kpt.LiveDeployer
+	hasDeployed bool
+}
+
+// NewSkaffoldRunner returns a SkaffoldRunner that renders and deploys the manifests of a v2 pipeline.
+func NewSkaffoldRunner(builder runner.Builder, pruner runner.Pruner, tester runner.Tester, workingDir, repoCacheDir string, labels map[string]string, p latest_v2.Pipeline) (*SkaffoldRunner, error) {
+	renderer, err := render.NewRenderer(workingDir, repoCacheDir, p.Render, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SkaffoldRunner{
+		Builder:  builder,
+		Pruner:   pruner,
+		Tester:   tester,
+		renderer: renderer,
+		deployer: kpt.NewLiveDeployer(p.Deploy),
+	}, nil
 }
 
-func (r *SkaffoldRunner) HasDeployed() bool { return true }
+func (r *SkaffoldRunner) HasDeployed() bool { return r.hasDeployed }
@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+)
+
+// Verify runs the verification tests against the deployed application.
+// Tests running locally reach the application through the ports forwarded by forwarderManager.
+func (r *SkaffoldRunner) Verify(ctx context.Context, out io.Writer, artifacts []graph.Artifact, forwarderManager *portforward.ForwarderManager) error {
+	if r.runCtx.SkipTests() || len(r.runCtx.VerifyTestCases()) == 0 {
+		return nil
+	}
+
+	eventV2.TaskInProgress(constants.Verify)
+	if err := r.verifier.Verify(ctx, out, artifacts, forwarderManager.ForwardedPorts); err != nil {
+		eventV2.TaskFailed(constants.Verify, err)
+		return err
+	}
+
+	eventV2.TaskSucceeded(constants.Verify)
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+type mockVerifier struct {
+	verified []graph.Artifact
+	err      error
+}
+
+func (v *mockVerifier) Verify(_ context.Context, _ io.Writer, builds []graph.Artifact, _ func() []portforward.ForwardedPort) error {
+	v.verified = builds
+	return v.err
+}
+
+func TestVerify(t *testing.T) {
+	builds := []graph.Artifact{{ImageName: "app", Tag: "app:123"}}
+
+	tests := []struct {
+		description      string
+		verify           []*latest_v1.VerifyTestCase
+		skipTests        bool
+		verifyErr        error
+		expectedVerified []graph.Artifact
+		shouldErr        bool
+	}{
+		{
+			description:      "run the verification tests",
+			verify:           []*latest_v1.VerifyTestCase{{Name: "smoke"}},
+			expectedVerified: builds,
+		},
+		{
+			description: "failed verification",
+			verify:      []*latest_v1.VerifyTestCase{{Name: "smoke"}},
+			verifyErr:   errors.New("verification tests failed: smoke"),
+			shouldErr:   true,
+		},
+		{
+			description: "no verification tests",
+		},
+		{
+			description: "skipped tests",
+			verify:      []*latest_v1.VerifyTestCase{{Name: "smoke"}},
+			skipTests:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pipelines := []latest_v1.Pipeline{{Verify: test.verify}}
+			testEvent.InitializeState(pipelines)
+			verifier := &mockVerifier{err: test.verifyErr}
+			r := &SkaffoldRunner{
+				runCtx: &runcontext.RunContext{
+					Pipelines: runcontext.NewPipelines(pipelines),
+					Opts:      config.SkaffoldOptions{SkipTests: test.skipTests},
+				},
+				verifier: verifier,
+			}
+
+			err := r.Verify(context.Background(), ioutil.Discard, builds, nil)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedVerified, verifier.verified)
+			}
+		})
+	}
+}
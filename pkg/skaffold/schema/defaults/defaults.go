@@ -45,12 +45,15 @@ func Set(c *latest_v1.SkaffoldConfig) error {
 	setDefaultTagger(c)
 	setDefaultKustomizePath(c)
 	setDefaultLogsConfig(c)
+	setDefaultCustomResourceStatusChecks(c)
+	setDefaultSignConfig(c)
 
 	for _, a := range c.Build.Artifacts {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
+		setDefaultPlatforms(a, c.Build.Platforms)
 
-		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
+		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil && a.KoArtifact == nil {
 			defaultToKanikoArtifact(a)
 		} else {
 			defaultToDockerArtifact(a)
@@ -68,6 +71,9 @@ func Set(c *latest_v1.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.KoArtifact != nil:
+			setKoArtifactDefaults(a.KoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
@@ -207,6 +213,16 @@ func setDefaultLogsConfig(c *latest_v1.SkaffoldConfig) {
 	}
 }
 
+func setDefaultCustomResourceStatusChecks(c *latest_v1.SkaffoldConfig) {
+	for i := range c.Deploy.CustomResourceStatusChecks {
+		rc := &c.Deploy.CustomResourceStatusChecks[i]
+		rc.ReadyValue = valueOrDefault(rc.ReadyValue, "True")
+		if rc.FailedJSONPath != "" {
+			rc.FailedValue = valueOrDefault(rc.FailedValue, "True")
+		}
+	}
+}
+
 func defaultToDockerArtifact(a *latest_v1.Artifact) {
 	if a.ArtifactType == (latest_v1.ArtifactType{}) {
 		a.ArtifactType = latest_v1.ArtifactType{
@@ -215,6 +231,12 @@ func defaultToDockerArtifact(a *latest_v1.Artifact) {
 	}
 }
 
+func setDefaultSignConfig(c *latest_v1.SkaffoldConfig) {
+	if c.Build.Sign != nil {
+		c.Build.Sign.SBOM = valueOrDefault(c.Build.Sign.SBOM, constants.DefaultSBOMFormat)
+	}
+}
+
 func setCustomArtifactDefaults(a *latest_v1.CustomArtifact) {
 	if a.Dependencies == nil {
 		a.Dependencies = &latest_v1.CustomDependencies{
@@ -234,6 +256,11 @@ func setBuildpackArtifactDefaults(a *latest_v1.BuildpackArtifact) {
 	}
 }
 
+func setKoArtifactDefaults(a *latest_v1.KoArtifact) {
+	a.BaseImage = valueOrDefault(a.BaseImage, constants.DefaultKoBaseImage)
+	a.Main = valueOrDefault(a.Main, ".")
+}
+
 func setDockerArtifactDefaults(a *latest_v1.DockerArtifact) {
 	a.DockerfilePath = valueOrDefault(a.DockerfilePath, constants.DefaultDockerfilePath)
 }
@@ -242,6 +269,12 @@ func setDefaultWorkspace(a *latest_v1.Artifact) {
 	a.Workspace = valueOrDefault(a.Workspace, ".")
 }
 
+func setDefaultPlatforms(a *latest_v1.Artifact, platforms []string) {
+	if len(a.Platforms) == 0 {
+		a.Platforms = platforms
+	}
+}
+
 func setDefaultSync(a *latest_v1.Artifact) {
 	if a.Sync != nil {
 		if len(a.Sync.Manual) == 0 && len(a.Sync.Infer) == 0 && a.Sync.Auto == nil {
@@ -322,6 +322,41 @@ func TestSetDefaultsOnLocalBuild(t *testing.T) {
 	testutil.CheckDeepEqual(t, 1, *cfg2.Build.LocalBuild.Concurrency)
 }
 
+func TestSetDefaultPlatforms(t *testing.T) {
+	cfg := &latest_v1.SkaffoldConfig{
+		Pipeline: latest_v1.Pipeline{
+			Build: latest_v1.BuildConfig{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+				Artifacts: []*latest_v1.Artifact{
+					{ImageName: "first"},
+					{ImageName: "second", Platforms: []string{"linux/arm/v7"}},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, []string{"linux/amd64", "linux/arm64"}, cfg.Build.Artifacts[0].Platforms)
+	testutil.CheckDeepEqual(t, []string{"linux/arm/v7"}, cfg.Build.Artifacts[1].Platforms)
+}
+
+func TestSetDefaultSignConfig(t *testing.T) {
+	cfg := &latest_v1.SkaffoldConfig{
+		Pipeline: latest_v1.Pipeline{
+			Build: latest_v1.BuildConfig{
+				Sign: &latest_v1.SignConfig{Key: "cosign.key"},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, &latest_v1.SignConfig{Key: "cosign.key", SBOM: "spdx"}, cfg.Build.Sign)
+}
+
 func TestSetPortForwardLocalPort(t *testing.T) {
 	cfg := &latest_v1.SkaffoldConfig{
 		Pipeline: latest_v1.Pipeline{
@@ -419,3 +454,22 @@ func TestSetLogsConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCustomResourceStatusChecks(t *testing.T) {
+	cfg := &latest_v1.SkaffoldConfig{
+		Pipeline: latest_v1.Pipeline{
+			Deploy: latest_v1.DeployConfig{
+				CustomResourceStatusChecks: []latest_v1.CustomResourceStatusCheck{
+					{Group: "cert-manager.io", Kind: "Certificate", ReadyJSONPath: "{.status.conditions[0].status}"},
+					{Group: "example.com", Kind: "Database", ReadyJSONPath: "{.status.phase}", ReadyValue: "Running", FailedJSONPath: "{.status.failed}"},
+				},
+			},
+		},
+	}
+	err := Set(cfg)
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, []latest_v1.CustomResourceStatusCheck{
+		{Group: "cert-manager.io", Kind: "Certificate", ReadyJSONPath: "{.status.conditions[0].status}", ReadyValue: "True"},
+		{Group: "example.com", Kind: "Database", ReadyJSONPath: "{.status.phase}", ReadyValue: "Running", FailedJSONPath: "{.status.failed}", FailedValue: "True"},
+	}, cfg.Deploy.CustomResourceStatusChecks)
+}
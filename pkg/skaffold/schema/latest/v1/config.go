@@ -71,6 +71,9 @@ type Pipeline struct {
 	// Deploy describes how images are deployed.
 	Deploy DeployConfig `yaml:"deploy,omitempty"`
 
+	// Verify *alpha* describes the tests run against the deployed application, once it has stabilized.
+	Verify []*VerifyTestCase `yaml:"verify,omitempty"`
+
 	// PortForward describes user defined resources to port-forward.
 	PortForward []*PortForwardResource `yaml:"portForward,omitempty"`
 }
@@ -126,11 +129,16 @@ type ResourceType string
 type PortForwardResource struct {
 	// Type is the Kubernetes type that should be port forwarded.
 	// Acceptable resource types include: `Service`, `Pod` and Controller resource type that has a pod spec: `ReplicaSet`, `ReplicationController`, `Deployment`, `StatefulSet`, `DaemonSet`, `Job`, `CronJob`.
+	// The `docker` type forwards to a container of the local Docker daemon.
 	Type ResourceType `yaml:"resourceType,omitempty"`
 
-	// Name is the name of the Kubernetes resource to port forward.
+	// Name is the name of the Kubernetes resource to port forward, or the name of the Docker container.
 	Name string `yaml:"resourceName,omitempty"`
 
+	// KubeContext is the Kubernetes context of the resource to port forward, to forward a resource from another cluster than the one deployed to.
+	// Defaults to the current context.
+	KubeContext string `yaml:"kubeContext,omitempty"`
+
 	// Namespace is the namespace of the resource to port forward.
 	Namespace string `yaml:"namespace,omitempty"`
 
@@ -158,9 +166,55 @@ type BuildConfig struct {
 	// If not specified, it defaults to `gitCommit: {variant: Tags}`.
 	TagPolicy TagPolicy `yaml:"tagPolicy,omitempty"`
 
+	// Platforms *alpha* is the list of target platforms to build the images for.
+	// When more than one platform is listed, the images are pushed as a multi-platform image index.
+	// Can be overridden per artifact.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Sign *alpha* signs the pushed images and attaches a signed SBOM attestation to them in the registry.
+	Sign *SignConfig `yaml:"sign,omitempty"`
+
+	// RemoteCache *alpha* is a build cache shared across machines, that maps the hashes of the artifacts' inputs to the digests of the pushed images.
+	// It is consulted when an artifact isn't found in the local cache.
+	RemoteCache *RemoteCacheConfig `yaml:"remoteCache,omitempty"`
+
 	BuildType `yaml:",inline"`
 }
 
+// RemoteCacheConfig *alpha* describes where the shared build cache is stored.
+// Only images pushed to a registry are cached remotely.
+type RemoteCacheConfig struct {
+	// HTTP is the base URL of a key/value store. Entries are read with `GET <url>/<hash>` and written with `PUT <url>/<hash>`.
+	// Credentials for the host are taken from the docker credential helpers.
+	// For example: `https://cache.example.com/skaffold`.
+	HTTP string `yaml:"http,omitempty" yamltags:"oneOf=remoteCache"`
+
+	// Registry is the image repository in which entries are stored, as annotated `<repository>:<hash>` images.
+	// For example: `gcr.io/k8s-skaffold/skaffold-cache`.
+	Registry string `yaml:"registry,omitempty" yamltags:"oneOf=remoteCache"`
+
+	// Dir is a directory on shared storage in which entries are stored, one file per hash.
+	Dir string `yaml:"dir,omitempty" yamltags:"oneOf=remoteCache" skaffold:"filepath"`
+
+	// ReadOnly prevents Skaffold from adding the images it builds to the remote cache.
+	// Typically, CI jobs populate the cache and developers only read from it.
+	// Defaults to `false`.
+	ReadOnly bool `yaml:"readOnly,omitempty"`
+}
+
+// SignConfig *alpha* describes how pushed images are signed.
+// Signatures and attestations are stored next to the images, following the conventions of [cosign](https://github.com/sigstore/cosign).
+type SignConfig struct {
+	// Key is the path to the unencrypted, PEM-encoded, ECDSA private key used to sign the images.
+	Key string `yaml:"key" yamltags:"required" skaffold:"filepath"`
+
+	// SBOM is the format of the SBOM generated from the image layers and attached as a signed attestation.
+	// Valid values are `spdx`, `cyclonedx` and `none`.
+	// Defaults to `spdx`.
+	SBOM string `yaml:"sbom,omitempty"`
+}
+
 // TagPolicy contains all the configuration for the tagging step.
 type TagPolicy struct {
 	// GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
@@ -180,6 +234,9 @@ type TagPolicy struct {
 
 	// InputDigest *beta* tags images with their sha256 digest of their content.
 	InputDigest *InputDigest `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
+
+	// SemverTagger *alpha* tags images with the semantic version of the artifact's workspace.
+	SemverTagger *SemverTagger `yaml:"semver,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
@@ -205,6 +262,34 @@ type GitTagger struct {
 	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
 }
 
+// SemverTagger *alpha* tags images with the semantic version of the artifact's workspace.
+type SemverTagger struct {
+	// Source is where the version comes from. Valid sources are:
+	// `gitTag` (default): the latest annotated git tag that is a semantic version, bumped according to the
+	// [conventional commit](https://www.conventionalcommits.org) messages of the workspace since that tag.
+	// `versionFile`: the version written in a file of the workspace.
+	Source string `yaml:"source,omitempty"`
+
+	// VersionFile is the file holding the version when the source is `versionFile`, relative to the workspace.
+	// Defaults to `VERSION`.
+	VersionFile string `yaml:"versionFile,omitempty"`
+
+	// TagPrefix is the prefix of the git tags holding versions, for example `backend/v`.
+	// Defaults to an optional `v`.
+	TagPrefix string `yaml:"tagPrefix,omitempty"`
+
+	// Prerelease is the pre-release identifier added to the version when there are uncommitted changes.
+	// Defaults to `dirty`.
+	Prerelease string `yaml:"prerelease,omitempty"`
+
+	// BuildMetadata adds the abbreviated commit sha to the version as build metadata when there are uncommitted changes.
+	// Image tags can't contain `+`, so it is separated from the version with `_`.
+	BuildMetadata bool `yaml:"buildMetadata,omitempty"`
+
+	// IgnoreChanges specifies whether to leave the version as is when there are uncommitted changes.
+	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
+}
+
 // EnvTemplateTagger *beta* tags images with a configurable template string.
 type EnvTemplateTagger struct {
 	// Template used to produce the image name and tag.
@@ -491,6 +576,59 @@ type TestCase struct {
 	// to run on that artifact.
 	// For example: `["./test/*"]`.
 	StructureTests []string `yaml:"structureTests,omitempty" skaffold:"filepath"`
+
+	// StructureTestsMode is how the structure tests run on an image that isn't in the local Docker daemon.
+	// `pull` pulls the image and runs `container-structure-test` locally.
+	// `remote` checks files and metadata directly from the registry layers and runs command tests
+	// in a short-lived Kubernetes pod, without a Docker daemon.
+	// Defaults to `pull`.
+	StructureTestsMode string `yaml:"structureTestsMode,omitempty"`
+}
+
+// VerifyTestCase *alpha* describes a test container run against the deployed application.
+type VerifyTestCase struct {
+	// Name is a unique name for the test.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Container describes the container running the test.
+	// The test succeeds when the container exits with a zero exit code.
+	Container VerifyContainer `yaml:"container" yamltags:"required"`
+
+	// ExecutionMode is where the test container runs: `local` runs it with the local Docker daemon,
+	// and `kubernetesCluster` runs it as a Kubernetes Job in the first namespace of the deployment.
+	// Defaults to `local`.
+	ExecutionMode string `yaml:"executionMode,omitempty"`
+
+	// TimeoutSeconds sets the wait time for skaffold for the test to complete.
+	// If unset or 0, Skaffold will wait until the test completes.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+// VerifyContainer describes the container running a verification test.
+type VerifyContainer struct {
+	// Image is the image of the container. It can be the name of an artifact built by Skaffold,
+	// in which case the tag of the latest build is used.
+	// For example: `gcr.io/k8s-skaffold/integration-tests`.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Command overrides the entrypoint of the image.
+	Command []string `yaml:"command,omitempty"`
+
+	// Args are the arguments passed to the entrypoint.
+	Args []string `yaml:"args,omitempty"`
+
+	// Env lists the environment variables set in the container, in addition to those describing
+	// the endpoints of the deployed application.
+	Env []VerifyEnvVar `yaml:"env,omitempty"`
+}
+
+// VerifyEnvVar is an environment variable set in a verification test container.
+type VerifyEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Value is the value of the environment variable.
+	Value string `yaml:"value,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
@@ -503,12 +641,59 @@ type DeployConfig struct {
 	// StatusCheckDeadlineSeconds *beta* is the deadline for deployments to stabilize in seconds.
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
 
+	// CustomResourceStatusChecks *alpha* describes how to determine the readiness of custom resources.
+	// Custom resources without a matching rule are not status checked.
+	CustomResourceStatusChecks []CustomResourceStatusCheck `yaml:"customResourceStatusChecks,omitempty"`
+
+	// RollbackOnFailure *alpha* rolls back the deployed resources to their previous state when the status check fails.
+	// Defaults to `false`.
+	RollbackOnFailure bool `yaml:"rollbackOnFailure,omitempty"`
+
+	// SignatureVerification *alpha* refuses to deploy images that aren't signed with a trusted key.
+	SignatureVerification *SignatureVerificationConfig `yaml:"signatureVerification,omitempty"`
+
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after every deploy.
+	LifecycleHooks DeployHooks `yaml:"hooks,omitempty"`
+}
+
+// SignatureVerificationConfig *alpha* describes how the signatures of images are verified before they are deployed.
+type SignatureVerificationConfig struct {
+	// PublicKey is the path to the PEM-encoded ECDSA public key the images must be signed with.
+	PublicKey string `yaml:"publicKey" yamltags:"required" skaffold:"filepath"`
+}
+
+// CustomResourceStatusCheck *alpha* describes a readiness rule for a custom resource kind.
+type CustomResourceStatusCheck struct {
+	// Group is the API group of the custom resource.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group" yamltags:"required"`
+
+	// Kind is the kind of the custom resource.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// ReadyJSONPath is a JSONPath expression evaluated against the resource to determine its readiness.
+	// For example: `{.status.conditions[?(@.type=="Ready")].status}`.
+	ReadyJSONPath string `yaml:"readyJsonPath" yamltags:"required"`
+
+	// ReadyValue is the value that `readyJsonPath` must evaluate to for the resource to be considered ready.
+	// Defaults to `True`.
+	ReadyValue string `yaml:"readyValue,omitempty"`
+
+	// FailedJSONPath is an optional JSONPath expression evaluated against the resource to detect failures.
+	// For example: `{.status.conditions[?(@.type=="Failed")].status}`.
+	FailedJSONPath string `yaml:"failedJsonPath,omitempty"`
+
+	// FailedValue is the value that `failedJsonPath` must evaluate to for the resource to be considered failed.
+	// Defaults to `True`.
+	FailedValue string `yaml:"failedValue,omitempty"`
 }
 
 // DeployType contains the specific implementation and parameters needed
@@ -837,6 +1022,55 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// Platforms *alpha* is the list of target platforms to build this artifact for.
+	// Overrides the platforms set in the build configuration.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each build of the target artifact.
+	LifecycleHooks BuildHooks `yaml:"hooks,omitempty"`
+
+	// Debug *alpha* describes how `skaffold debug` configures the artifact's containers
+	// for a runtime that isn't supported out of the box.
+	Debug *DebugTransformer `yaml:"debug,omitempty"`
+}
+
+// DebugTransformer describes a custom transform that configures a container for debugging.
+// It is applied ahead of the built-in transforms, to the containers that match its
+// `commandMatch` regex or one of its `envMatch` regexes.
+type DebugTransformer struct {
+	// Runtime is the runtime ID reported in the debug configuration of the containers.
+	// For example: `myruntime`.
+	Runtime string `yaml:"runtime" yamltags:"required"`
+
+	// CommandMatch is a regex matched against the container's command-line, with the arguments separated by spaces.
+	// For example: `^/opt/myruntime/bin/run`.
+	CommandMatch string `yaml:"commandMatch,omitempty"`
+
+	// EnvMatch maps environment variable names to regexes matched against their values.
+	// For example: `{"MYRUNTIME_HOME": ".*"}`.
+	EnvMatch map[string]string `yaml:"envMatch,omitempty"`
+
+	// Command is the template of the rewritten command-line. An argument set to `{{.CommandLine}}`
+	// is replaced with the original command-line, and `{{.Ports.<name>}}` expands to the allocated port.
+	// For example: `["/dbg/myruntime/debug", "--port={{.Ports.dap}}", "--", "{{.CommandLine}}"]`.
+	// Defaults to leaving the command-line unchanged.
+	Command []string `yaml:"command,omitempty"`
+
+	// Env lists environment variables to set in the container. Values are templates like `command`'s arguments.
+	// For example: `{"MYRUNTIME_DEBUG": "port={{.Ports.dap}}"}`.
+	Env map[string]string `yaml:"env,omitempty"`
+
+	// Ports maps port names to the ports to expose on the container.
+	// A different port is allocated if the port is already in use in the pod.
+	// For example: `{"dap": 5005}`.
+	Ports map[string]int32 `yaml:"ports,omitempty"`
+
+	// Image is the name of the support image, under the debug helpers registry, whose files
+	// are installed in `/dbg` by an init container.
+	// For example: `myruntime`.
+	Image string `yaml:"image,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -857,6 +1091,34 @@ type Sync struct {
 	// Auto delegates discovery of sync rules to the build system.
 	// Only available for jib and buildpacks.
 	Auto *bool `yaml:"auto,omitempty" yamltags:"oneOf=sync"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each file sync action on the target artifact's containers.
+	LifecycleHooks SyncHooks `yaml:"hooks,omitempty"`
+
+	// Transport defines how the files are copied into and deleted from the containers. Valid values are
+	// `tar`: pipe a tar archive to `tar` in the container, which requires a shell and `tar` in the image.
+	// `helper`: inject a static sync helper binary in the pod, which works with distroless and scratch images.
+	// Defaults to `tar`.
+	Transport string `yaml:"transport,omitempty"`
+
+	// AfterSync lists the actions to run in each synced container after files are synced.
+	// For example: `[{"command": ["kill", "-HUP", "1"]}]`.
+	AfterSync []ContainerHook `yaml:"afterSync,omitempty"`
+
+	// Download lists the container paths whose changed files are copied back into the artifact's workspace.
+	Download []*DownloadRule `yaml:"download,omitempty"`
+}
+
+// DownloadRule specifies a folder in the container to copy changed files from.
+type DownloadRule struct {
+	// Src is the absolute path of the folder in the container to watch for changed files.
+	// For example: `"/app/generated"`.
+	Src string `yaml:"src,omitempty" yamltags:"required"`
+
+	// Dest is the local folder, relative to the artifact's context, where the changed files are copied to.
+	// For example: `"generated"`.
+	// Defaults to the artifact's context.
+	Dest string `yaml:"dest,omitempty"`
 }
 
 // SyncRule specifies which local files to sync to remote folders.
@@ -874,6 +1136,87 @@ type SyncRule struct {
 	// transplanting the files into the destination folder.
 	// For example: `"css/"`
 	Strip string `yaml:"strip,omitempty"`
+
+	// AfterSync lists the actions to run in each synced container after files matching this rule are synced.
+	// For example: `[{"command": ["nginx", "-s", "reload"]}]`.
+	AfterSync []ContainerHook `yaml:"afterSync,omitempty"`
+}
+
+// BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
+type BuildHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
+	PreHooks []HostHook `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
+	PostHooks []HostHook `yaml:"after,omitempty"`
+}
+
+// SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
+type SyncHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on each container running the synced artifact.
+	ContainerHook *ContainerHook `yaml:"container,omitempty" yamltags:"oneOf=hook"`
+}
+
+// SyncHooks describes the list of lifecycle hooks to execute in the host machine and in the container before and after each artifact file sync step.
+type SyncHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact sync step.
+	PreHooks []SyncHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact sync step.
+	PostHooks []SyncHookItem `yaml:"after,omitempty"`
+}
+
+// DeployHookItem describes a single lifecycle hook to execute before or after each deployer step.
+type DeployHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=hook"`
+}
+
+// DeployHooks describes the list of lifecycle hooks to execute in the host machine and in the container before and after each deployer step.
+type DeployHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deployer step. Container hooks will only run if the container exists from a previous deployment step (for instance the successive iterations of a dev-loop during `skaffold dev`).
+	PreHooks []DeployHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deployer step.
+	PostHooks []DeployHookItem `yaml:"after,omitempty"`
+}
+
+// HostHook describes a lifecycle hook definition to execute on the host machine.
+type HostHook struct {
+	// Command is the command to execute.
+	// For example: `["bash", "-c", "echo hello"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+
+	// OS is an optional slice of operating system names. If the host machine OS is different, then it skips execution.
+	// For example: `["linux", "darwin"]`.
+	OS []string `yaml:"os,omitempty"`
+}
+
+// ContainerHook describes a lifecycle hook definition to execute on a container. The container name is inferred from the scope in which this hook is defined.
+type ContainerHook struct {
+	// Command is the command to execute.
+	// For example: `["nginx", "-s", "reload"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+}
+
+// NamedContainerHook describes a lifecycle hook definition to execute on a named container.
+type NamedContainerHook struct {
+	// ContainerHook describes a lifecycle hook definition to execute on a container.
+	ContainerHook `yaml:",inline" yamltags:"skipTrim"`
+
+	// PodName is the name of the pod to execute the command in.
+	// It accepts glob patterns, for example: `app-*`.
+	PodName string `yaml:"podName" yamltags:"required"`
+
+	// ContainerName is the name of the container to execute the command in.
+	// It accepts glob patterns, for example: `app-*`. Defaults to all the containers of the matching pods.
+	ContainerName string `yaml:"containerName,omitempty"`
 }
 
 // Profile is used to override any `build`, `test` or `deploy` configuration.
@@ -954,6 +1297,10 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images from Go sources following the conventions of [ko](https://github.com/google/ko),
+	// without requiring a Docker daemon.
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -1006,6 +1353,32 @@ type BuildpackDependencies struct {
 	Ignore []string `yaml:"ignore,omitempty"`
 }
 
+// KoArtifact *alpha* describes an artifact built from Go sources, following the conventions of [ko](https://github.com/google/ko).
+// The Go binary is compiled locally and layered on top of the base image, without requiring a Docker daemon.
+type KoArtifact struct {
+	// BaseImage overrides the base image the Go binary is added to.
+	// Defaults to `gcr.io/distroless/static:nonroot`.
+	BaseImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the path of the main package to build, relative to the artifact's context.
+	// Defaults to `.`.
+	Main string `yaml:"main,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// Values can use the go template syntax.
+	// For example: `["GOPRIVATE=source.developers.google.com", "GOFLAGS={{.GOFLAGS}}"]`.
+	Env []string `yaml:"env,omitempty"`
+
+	// Ldflags are the linker flags passed to `go build`.
+	// Values can use the go template syntax.
+	// For example: `["-s", "-w", "-X main.version={{.VERSION}}"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+
+	// Flags are additional build flags passed to `go build`.
+	// For example: `["-trimpath", "-tags=netgo"]`.
+	Flags []string `yaml:"flags,omitempty"`
+}
+
 // CustomArtifact *beta* describes an artifact built from a custom build script
 // written by the user. It can be used to build images with builders that aren't directly integrated with skaffold.
 type CustomArtifact struct {
@@ -1035,14 +1408,23 @@ type CustomDependencies struct {
 // CustomTest describes the custom test command provided by the user.
 // Custom tests are run after an image build whenever build or test dependencies are changed.
 type CustomTest struct {
+	// Name identifies the test in test reports and for `--test-filter`.
+	// Defaults to the command.
+	Name string `yaml:"name,omitempty"`
+
 	// Command is the custom command to be executed.  If the command exits with a non-zero return
 	// code, the test will be considered to have failed.
 	Command string `yaml:"command" yamltags:"required"`
 
 	// TimeoutSeconds sets the wait time for skaffold for the command to complete.
 	// If unset or 0, Skaffold will wait until the command completes.
+	// The timeout applies to each attempt when the test is retried.
 	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
 
+	// Retries is the number of times the command is run again after it fails, before the test is considered to have failed.
+	// Defaults to `0`.
+	Retries int `yaml:"retries,omitempty"`
+
 	// Dependencies are additional test-specific file dependencies; changes to these files will re-run this test.
 	Dependencies *CustomTestDependencies `yaml:"dependencies,omitempty"`
 }
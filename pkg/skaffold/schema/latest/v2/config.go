@@ -99,19 +99,32 @@ type Generate struct {
 	// Manifests contains the raw kubernetes manifest paths and kustomize paths.
 	Manifests []string `yaml:"manifests,omitempty"`
 
-	// TODO: Implement the "HelmCharts" and "RemoteResoruces" fields.
+	// HelmCharts are the helm charts rendered with `helm template`.
+	HelmCharts []latest_v1.HelmRelease `yaml:"helmCharts,omitempty"`
+
+	// RemoteResources are the URLs of remote manifests. Supports `http(s)://` URLs, `gs://` URLs
+	// and git repositories in the form `<repo>.git//<path>?ref=<ref>`.
+	// For example: `["https://github.com/GoogleContainerTools/skaffold.git//examples/getting-started/k8s-pod.yaml?ref=main"]`.
+	RemoteResources []string `yaml:"remoteResources,omitempty"`
 }
 
 // Transformer describes the supported kpt transformers.
 type Transformer struct {
 	// Name is the transformer name. Can only accept skaffold whitelisted tools.
 	Name string `yaml:"name" yamltags:"required"`
+
+	// ConfigMap is the list of `key=value` pairs passed as function config to the transformer.
+	// For example: `["app=guestbook"]`.
+	ConfigMap []string `yaml:"configMap,omitempty"`
 }
 
-// Transformer describes the supported kpt transformers.
+// Validator describes the supported kpt validators.
 type Validator struct {
 	// Name is the Validator name. Can only accept skaffold whitelisted tools.
 	Name string `yaml:"name" yamltags:"required"`
+
+	// ConfigMap is the list of `key=value` pairs passed as function config to the validator.
+	ConfigMap []string `yaml:"configMap,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
@@ -127,8 +140,9 @@ type DeployConfig struct {
 	// InventoryNamespace *alpha* sets the inventory namespace.
 	InventoryNamespace string `yaml:"inventoryNamespace,omitempty"`
 
-	// StatusCheckDeadlineSeconds sets for the polling period for resource statuses. Default to 2s. Values can be "2s", "1min", "3h", etc
-	StatusCheckDeadlineSeconds string `statusCheckDeadlineSeconds:"pollPeriod,omitempty"`
+	// StatusCheckDeadlineSeconds sets the time threshold to wait for all resources to reach the current status,
+	// unless `reconcileTimeout` is set. Values can be "2s", "1m", "3h", etc
+	StatusCheckDeadlineSeconds string `yaml:"statusCheckDeadlineSeconds,omitempty"`
 	// PrunePropagationPolicy sets the propagation policy for pruning.
 	// Possible settings are Background, Foreground, Orphan.
 	// Default to "Background".
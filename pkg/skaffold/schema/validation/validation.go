@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
@@ -32,6 +34,7 @@ import (
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yamltags"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
@@ -48,18 +51,22 @@ func Process(configs []*latest_v1.SkaffoldConfig) error {
 	var errs = validateImageNames(configs)
 	for _, config := range configs {
 		errs = append(errs, visitStructs(config, validateYamltags)...)
+		errs = append(errs, visitStructs(config, validateLifecycleHook)...)
 		errs = append(errs, validateDockerNetworkMode(config.Build.Artifacts)...)
 		errs = append(errs, validateCustomDependencies(config.Build.Artifacts)...)
 		errs = append(errs, validateSyncRules(config.Build.Artifacts)...)
+		errs = append(errs, validateDebugTransformers(config.Build.Artifacts)...)
 		errs = append(errs, validatePortForwardResources(config.PortForward)...)
 		errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
 		errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
 		errs = append(errs, validateArtifactTypes(config.Build)...)
 		errs = append(errs, validateTaggingPolicy(config.Build)...)
 		errs = append(errs, validateCustomTest(config.Test)...)
+		errs = append(errs, validateStructureTestsModes(config.Test)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
 	errs = append(errs, validateSingleKubeContext(configs)...)
+	errs = append(errs, validateVerifyTestCases(configs)...)
 	if len(errs) == 0 {
 		return nil
 	}
@@ -437,7 +444,10 @@ func visitStructs(s interface{}, visitor func(interface{}) error) []error {
 }
 
 // validateSyncRules checks that all manual sync rules have a valid strip prefix
+// and that the sync transport is valid
 func validateSyncRules(artifacts []*latest_v1.Artifact) []error {
+	validTransports := []string{"", "tar", "helper"}
+
 	var errs []error
 	for _, a := range artifacts {
 		if a.Sync != nil {
@@ -447,13 +457,57 @@ func validateSyncRules(artifacts []*latest_v1.Artifact) []error {
 					errs = append(errs, err)
 				}
 			}
+			if !util.StrSliceContains(validTransports, a.Sync.Transport) {
+				err := fmt.Errorf("invalid sync transport '%s' for artifact '%s'. Valid values are 'tar' or 'helper'", a.Sync.Transport, a.ImageName)
+				errs = append(errs, err)
+			}
+			for _, r := range a.Sync.Download {
+				if !path.IsAbs(r.Src) {
+					err := fmt.Errorf("download rule source '%s' for artifact '%s' must be an absolute path in the container", r.Src, a.ImageName)
+					errs = append(errs, err)
+				}
+				if dest := filepath.Clean(r.Dest); filepath.IsAbs(dest) || dest == ".." || strings.HasPrefix(dest, ".."+string(filepath.Separator)) {
+					err := fmt.Errorf("download rule destination '%s' for artifact '%s' must be inside the artifact's context", r.Dest, a.ImageName)
+					errs = append(errs, err)
+				}
+			}
+			if len(a.Sync.Download) > 0 && a.Sync.Transport == "helper" {
+				err := fmt.Errorf("download rules for artifact '%s' require the 'tar' sync transport", a.ImageName)
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// validateDebugTransformers checks that the regexes of custom debug transformers are valid
+// and that their command templates keep the original command-line.
+func validateDebugTransformers(artifacts []*latest_v1.Artifact) []error {
+	var errs []error
+	for _, a := range artifacts {
+		if a.Debug == nil {
+			continue
+		}
+		if a.Debug.CommandMatch == "" && len(a.Debug.EnvMatch) == 0 {
+			errs = append(errs, fmt.Errorf("debug transformer for artifact '%s' must set 'commandMatch' or 'envMatch'", a.ImageName))
+		}
+		if _, err := regexp.Compile(a.Debug.CommandMatch); err != nil {
+			errs = append(errs, fmt.Errorf("invalid debug commandMatch '%s' for artifact '%s': %w", a.Debug.CommandMatch, a.ImageName, err))
+		}
+		for name, pattern := range a.Debug.EnvMatch {
+			if _, err := regexp.Compile(pattern); err != nil {
+				errs = append(errs, fmt.Errorf("invalid debug envMatch '%s' for '%s' in artifact '%s': %w", pattern, name, a.ImageName, err))
+			}
+		}
+		if len(a.Debug.Command) > 0 && !util.StrSliceContains(a.Debug.Command, "{{.CommandLine}}") {
+			errs = append(errs, fmt.Errorf("debug command for artifact '%s' must contain a '{{.CommandLine}}' argument", a.ImageName))
 		}
 	}
 	return errs
 }
 
 // validatePortForwardResources checks that all user defined port forward resources
-// have a valid resourceType
+// have a valid resourceType, and that Docker containers are forwarded by name and port number
 func validatePortForwardResources(pfrs []*latest_v1.PortForwardResource) []error {
 	var errs []error
 	validResourceTypes := map[string]struct{}{
@@ -466,16 +520,62 @@ func validatePortForwardResources(pfrs []*latest_v1.PortForwardResource) []error
 		"daemonset":             {},
 		"cronjob":               {},
 		"job":                   {},
+		"docker":                {},
 	}
 	for _, pfr := range pfrs {
 		resourceType := strings.ToLower(string(pfr.Type))
 		if _, ok := validResourceTypes[resourceType]; !ok {
 			errs = append(errs, fmt.Errorf("%s is not a valid resource type for port forwarding", pfr.Type))
+			continue
+		}
+		if resourceType != "docker" {
+			continue
+		}
+		if pfr.Name == "" {
+			errs = append(errs, errors.New("port forwarding a Docker container requires the container's name as `resourceName`"))
+		}
+		if pfr.Port.Type != schemautil.Int {
+			errs = append(errs, fmt.Errorf("port forwarding Docker container %q requires a port number", pfr.Name))
+		}
+		if pfr.KubeContext != "" {
+			errs = append(errs, fmt.Errorf("port forwarding Docker container %q can't set a `kubeContext`", pfr.Name))
 		}
 	}
 	return errs
 }
 
+// validateVerifyTestCases makes sure that verification tests have unique names and a valid execution mode.
+func validateVerifyTestCases(configs []*latest_v1.SkaffoldConfig) (errs []error) {
+	seen := map[string]bool{}
+	for _, c := range configs {
+		for _, tc := range c.Verify {
+			if seen[tc.Name] {
+				errs = append(errs, fmt.Errorf("found duplicate verify test name %q: verify test names must be unique", tc.Name))
+			}
+			seen[tc.Name] = true
+
+			switch tc.ExecutionMode {
+			case "", "local", "kubernetesCluster":
+			default:
+				errs = append(errs, fmt.Errorf("verify test %q has invalid execution mode %q: must be `local` or `kubernetesCluster`", tc.Name, tc.ExecutionMode))
+			}
+		}
+	}
+	return
+}
+
+// validateStructureTestsModes makes sure that the structure tests modes are either `pull` or `remote`.
+func validateStructureTestsModes(tcs []*latest_v1.TestCase) (errs []error) {
+	for _, tc := range tcs {
+		switch tc.StructureTestsMode {
+		case "", "pull", "remote":
+		default:
+			errs = append(errs, fmt.Errorf("tests of image %q have invalid structure tests mode %q: must be `pull` or `remote`", tc.ImageName, tc.StructureTestsMode))
+		}
+	}
+	return
+}
+
 // validateJibPluginTypes makes sure that jib type is one of `maven`, or `gradle` if set.
 func validateJibPluginTypes(artifacts []*latest_v1.Artifact) (errs []error) {
 	for _, a := range artifacts {
@@ -512,6 +612,9 @@ func validateArtifactTypes(bc latest_v1.BuildConfig) (errs []error) {
 			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom {
 				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'cluster' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'cluster' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
 			}
+			if misc.ArtifactType(a) == misc.Kaniko && len(a.Platforms) > 0 {
+				errs = append(errs, fmt.Errorf("artifact %q is built for platforms %v, which aren't supported by the 'cluster' builder for 'kaniko' artifacts. Remove the 'platforms' field or use the 'local' builder", a.ImageName, a.Platforms))
+			}
 		}
 	}
 	return
@@ -541,6 +644,21 @@ func validateSingleKubeContext(configs []*latest_v1.SkaffoldConfig) []error {
 	return nil
 }
 
+// validateLifecycleHook makes sure that host and container lifecycle hooks have a command to execute.
+func validateLifecycleHook(s interface{}) error {
+	switch h := s.(type) {
+	case latest_v1.HostHook:
+		if len(h.Command) == 0 {
+			return errors.New("host lifecycle hook command must not be empty")
+		}
+	case latest_v1.ContainerHook:
+		if len(h.Command) == 0 {
+			return errors.New("container lifecycle hook command must not be empty")
+		}
+	}
+	return nil
+}
+
 // validateCustomTest
 // - makes sure that command is not empty
 // - makes sure that dependencies.ignore is only used in conjunction with dependencies.paths
@@ -551,6 +669,9 @@ func validateCustomTest(tcs []*latest_v1.TestCase) (errs []error) {
 				errs = append(errs, fmt.Errorf("custom test command must not be empty;"))
 				return
 			}
+			if ct.Retries < 0 {
+				errs = append(errs, fmt.Errorf("custom test %q has a negative number of retries", ct.Command))
+			}
 
 			if ct.Dependencies == nil {
 				continue
@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -714,6 +715,55 @@ func TestValidateNetworkModeDockerContainerExists(t *testing.T) {
 	}
 }
 
+func TestValidateDebugTransformers(t *testing.T) {
+	tests := []struct {
+		description string
+		debug       *latest_v1.DebugTransformer
+		shouldErr   bool
+	}{
+		{
+			description: "no debug transformer",
+		},
+		{
+			description: "valid transformer",
+			debug: &latest_v1.DebugTransformer{
+				Runtime:      "myruntime",
+				CommandMatch: "^/opt/myruntime/bin/run",
+				EnvMatch:     map[string]string{"MYRUNTIME_HOME": ".*"},
+				Command:      []string{"/dbg/myruntime/debug", "--port={{.Ports.dap}}", "--", "{{.CommandLine}}"},
+				Ports:        map[string]int32{"dap": 5005},
+			},
+		},
+		{
+			description: "no match",
+			debug:       &latest_v1.DebugTransformer{Runtime: "myruntime"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid commandMatch",
+			debug:       &latest_v1.DebugTransformer{Runtime: "myruntime", CommandMatch: "run("},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid envMatch",
+			debug:       &latest_v1.DebugTransformer{Runtime: "myruntime", EnvMatch: map[string]string{"MYRUNTIME_HOME": "[a-"}},
+			shouldErr:   true,
+		},
+		{
+			description: "command drops the command-line",
+			debug:       &latest_v1.DebugTransformer{Runtime: "myruntime", CommandMatch: "run", Command: []string{"/dbg/myruntime/debug"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateDebugTransformers([]*latest_v1.Artifact{{ImageName: "img", Debug: test.debug}})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateSyncRules(t *testing.T) {
 	tests := []struct {
 		description string
@@ -798,6 +848,67 @@ func TestValidateSyncRules(t *testing.T) {
 				},
 			}},
 		},
+		{
+			description: "helper transport",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Infer:     []string{"**/*.js"},
+					Transport: "helper",
+				},
+			}},
+		},
+		{
+			description: "invalid transport",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Infer:     []string{"**/*.js"},
+					Transport: "rsync",
+				},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "download rule",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Download: []*latest_v1.DownloadRule{{Src: "/app/generated", Dest: "generated"}},
+				},
+			}},
+		},
+		{
+			description: "download rule with relative source",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Download: []*latest_v1.DownloadRule{{Src: "generated"}},
+				},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "download rule outside of context",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Download: []*latest_v1.DownloadRule{{Src: "/app/generated", Dest: "../generated"}},
+				},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "download rule with helper transport",
+			artifacts: []*latest_v1.Artifact{{
+				ImageName: "img",
+				Sync: &latest_v1.Sync{
+					Download:  []*latest_v1.DownloadRule{{Src: "/app/generated"}},
+					Transport: "helper",
+				},
+			}},
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -902,6 +1013,100 @@ func TestValidatePortForwardResources(t *testing.T) {
 	}
 }
 
+func TestValidateDockerPortForwardResources(t *testing.T) {
+	tests := []struct {
+		description string
+		resource    latest_v1.PortForwardResource
+		shouldErr   bool
+	}{
+		{
+			description: "container name and port number",
+			resource:    latest_v1.PortForwardResource{Type: "docker", Name: "postgres", Port: schemautil.FromInt(5432)},
+		},
+		{
+			description: "missing container name",
+			resource:    latest_v1.PortForwardResource{Type: "docker", Port: schemautil.FromInt(5432)},
+			shouldErr:   true,
+		},
+		{
+			description: "named port",
+			resource:    latest_v1.PortForwardResource{Type: "docker", Name: "postgres", Port: schemautil.FromString("db")},
+			shouldErr:   true,
+		},
+		{
+			description: "kube context",
+			resource:    latest_v1.PortForwardResource{Type: "docker", Name: "postgres", Port: schemautil.FromInt(5432), KubeContext: "staging"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validatePortForwardResources([]*latest_v1.PortForwardResource{&test.resource})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateVerifyTestCases(t *testing.T) {
+	tests := []struct {
+		description string
+		verify      [][]*latest_v1.VerifyTestCase
+		shouldErr   bool
+	}{
+		{
+			description: "valid execution modes",
+			verify: [][]*latest_v1.VerifyTestCase{{
+				{Name: "smoke"},
+				{Name: "local", ExecutionMode: "local"},
+				{Name: "in-cluster", ExecutionMode: "kubernetesCluster"},
+			}},
+		},
+		{
+			description: "invalid execution mode",
+			verify:      [][]*latest_v1.VerifyTestCase{{{Name: "smoke", ExecutionMode: "cloudRun"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "duplicate names across configs",
+			verify:      [][]*latest_v1.VerifyTestCase{{{Name: "smoke"}}, {{Name: "smoke"}}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var configs []*latest_v1.SkaffoldConfig
+			for _, v := range test.verify {
+				configs = append(configs, &latest_v1.SkaffoldConfig{Pipeline: latest_v1.Pipeline{Verify: v}})
+			}
+
+			errs := validateVerifyTestCases(configs)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateStructureTestsModes(t *testing.T) {
+	tests := []struct {
+		description string
+		mode        string
+		shouldErr   bool
+	}{
+		{description: "default"},
+		{description: "pull", mode: "pull"},
+		{description: "remote", mode: "remote"},
+		{description: "invalid", mode: "cluster", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateStructureTestsModes([]*latest_v1.TestCase{{ImageName: "image", StructureTestsMode: test.mode}})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateImageNames(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1432,11 +1637,55 @@ func TestValidateTaggingPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateClusterPlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest_v1.Artifact
+		shouldErr   bool
+	}{
+		{
+			description: "kaniko artifact without platforms",
+			artifact: latest_v1.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest_v1.ArtifactType{KanikoArtifact: &latest_v1.KanikoArtifact{}},
+			},
+		},
+		{
+			description: "kaniko artifact with platforms",
+			artifact: latest_v1.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest_v1.ArtifactType{KanikoArtifact: &latest_v1.KanikoArtifact{}},
+				Platforms:    []string{"linux/amd64", "linux/arm64"},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "custom artifact with platforms",
+			artifact: latest_v1.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest_v1.ArtifactType{CustomArtifact: &latest_v1.CustomArtifact{}},
+				Platforms:    []string{"linux/amd64", "linux/arm64"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateArtifactTypes(latest_v1.BuildConfig{
+				Artifacts: []*latest_v1.Artifact{&test.artifact},
+				BuildType: latest_v1.BuildType{Cluster: &latest_v1.ClusterDetails{}},
+			})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateCustomTest(t *testing.T) {
 	tests := []struct {
 		description    string
 		command        string
 		dependencies   *latest_v1.CustomTestDependencies
+		retries        int
 		expectedErrors int
 	}{
 		{
@@ -1474,6 +1723,11 @@ func TestValidateCustomTest(t *testing.T) {
 			command:      "echo Hello!",
 			description:  "nil dependencies",
 			dependencies: nil,
+		}, {
+			description:    "negative retries",
+			command:        "echo Hello!",
+			retries:        -1,
+			expectedErrors: 1,
 		},
 	}
 	for _, test := range tests {
@@ -1483,6 +1737,7 @@ func TestValidateCustomTest(t *testing.T) {
 				CustomTests: []latest_v1.CustomTest{{
 					Command:      test.command,
 					Dependencies: test.dependencies,
+					Retries:      test.retries,
 				}},
 			}
 
@@ -1491,3 +1746,76 @@ func TestValidateCustomTest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLifecycleHooks(t *testing.T) {
+	tests := []struct {
+		description    string
+		config         *latest_v1.SkaffoldConfig
+		expectedErrors int
+	}{
+		{
+			description: "no hooks",
+			config:      &latest_v1.SkaffoldConfig{},
+		},
+		{
+			description: "valid hooks",
+			config: &latest_v1.SkaffoldConfig{
+				Pipeline: latest_v1.Pipeline{
+					Build: latest_v1.BuildConfig{
+						Artifacts: []*latest_v1.Artifact{{
+							ImageName:      "img",
+							LifecycleHooks: latest_v1.BuildHooks{PreHooks: []latest_v1.HostHook{{Command: []string{"echo"}}}},
+						}},
+					},
+					Deploy: latest_v1.DeployConfig{
+						LifecycleHooks: latest_v1.DeployHooks{PostHooks: []latest_v1.DeployHookItem{{
+							ContainerHook: &latest_v1.NamedContainerHook{ContainerHook: latest_v1.ContainerHook{Command: []string{"echo"}}, PodName: "app-*"},
+						}}},
+					},
+				},
+			},
+		},
+		{
+			description: "empty host hook command",
+			config: &latest_v1.SkaffoldConfig{
+				Pipeline: latest_v1.Pipeline{
+					Build: latest_v1.BuildConfig{
+						Artifacts: []*latest_v1.Artifact{{
+							ImageName:      "img",
+							LifecycleHooks: latest_v1.BuildHooks{PreHooks: []latest_v1.HostHook{{Command: []string{}}}},
+						}},
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			description: "empty sync and deploy hook commands",
+			config: &latest_v1.SkaffoldConfig{
+				Pipeline: latest_v1.Pipeline{
+					Build: latest_v1.BuildConfig{
+						Artifacts: []*latest_v1.Artifact{{
+							ImageName: "img",
+							Sync: &latest_v1.Sync{LifecycleHooks: latest_v1.SyncHooks{PostHooks: []latest_v1.SyncHookItem{{
+								ContainerHook: &latest_v1.ContainerHook{},
+							}}}},
+						}},
+					},
+					Deploy: latest_v1.DeployConfig{
+						LifecycleHooks: latest_v1.DeployHooks{PreHooks: []latest_v1.DeployHookItem{{
+							HostHook: &latest_v1.HostHook{},
+						}}},
+					},
+				},
+			},
+			expectedErrors: 2,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := visitStructs(test.config, validateLifecycleHook)
+
+			t.CheckDeepEqual(test.expectedErrors, len(errs))
+		})
+	}
+}
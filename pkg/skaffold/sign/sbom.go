@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+)
+
+const (
+	SBOMFormatSPDX      = "spdx"
+	SBOMFormatCycloneDX = "cyclonedx"
+	SBOMFormatNone      = "none"
+
+	spdxPredicateType      = "https://spdx.dev/Document"
+	cycloneDXPredicateType = "https://cyclonedx.org/bom"
+)
+
+// for testing
+var now = time.Now
+
+// sbomFile is a regular file found in the flattened image filesystem.
+type sbomFile struct {
+	path   string
+	sha256 string
+}
+
+// GenerateSBOM lists the files of the image's flattened filesystem and
+// returns them as a software bill of materials in the given format,
+// together with the in-toto predicate type for that format.
+func GenerateSBOM(img v1.Image, name, format string) (string, []byte, error) {
+	files, err := listFiles(img)
+	if err != nil {
+		return "", nil, err
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch format {
+	case SBOMFormatSPDX:
+		content, err := spdxDocument(name, digest, files)
+		return spdxPredicateType, content, err
+	case SBOMFormatCycloneDX:
+		content, err := cycloneDXDocument(name, digest, files)
+		return cycloneDXPredicateType, content, err
+	default:
+		return "", nil, fmt.Errorf("unsupported SBOM format %q", format)
+	}
+}
+
+// listFiles walks the image's layers, with whiteouts applied, and hashes every regular file.
+func listFiles(img v1.Image) ([]sbomFile, error) {
+	fs := mutate.Extract(img)
+	defer fs.Close()
+
+	var files []sbomFile
+	tr := tar.NewReader(fs)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading image filesystem: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, tr); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", hdr.Name, err)
+		}
+		files = append(files, sbomFile{
+			path:   "/" + strings.TrimPrefix(hdr.Name, "/"),
+			sha256: hex.EncodeToString(h.Sum(nil)),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	return files, nil
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxFile struct {
+	SPDXID    string         `json:"SPDXID"`
+	FileName  string         `json:"fileName"`
+	Checksums []spdxChecksum `json:"checksums"`
+}
+
+type spdxPackage struct {
+	SPDXID           string   `json:"SPDXID"`
+	Name             string   `json:"name"`
+	VersionInfo      string   `json:"versionInfo"`
+	DownloadLocation string   `json:"downloadLocation"`
+	FilesAnalyzed    bool     `json:"filesAnalyzed"`
+	HasFiles         []string `json:"hasFiles,omitempty"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxDoc struct {
+	SPDXVersion       string           `json:"spdxVersion"`
+	DataLicense       string           `json:"dataLicense"`
+	SPDXID            string           `json:"SPDXID"`
+	Name              string           `json:"name"`
+	DocumentNamespace string           `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo `json:"creationInfo"`
+	Packages          []spdxPackage    `json:"packages"`
+	Files             []spdxFile       `json:"files,omitempty"`
+}
+
+func spdxDocument(name string, digest v1.Hash, files []sbomFile) ([]byte, error) {
+	pkg := spdxPackage{
+		SPDXID:           "SPDXRef-Package-image",
+		Name:             name,
+		VersionInfo:      digest.String(),
+		DownloadLocation: "NOASSERTION",
+		FilesAnalyzed:    true,
+	}
+	var spdxFiles []spdxFile
+	for i, f := range files {
+		id := fmt.Sprintf("SPDXRef-File-%d", i)
+		pkg.HasFiles = append(pkg.HasFiles, id)
+		spdxFiles = append(spdxFiles, spdxFile{
+			SPDXID:    id,
+			FileName:  f.path,
+			Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: f.sha256}},
+		})
+	}
+
+	return json.Marshal(spdxDoc{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: fmt.Sprintf("https://skaffold.dev/spdx/%s", digest.Hex),
+		CreationInfo: spdxCreationInfo{
+			Created:  now().UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: skaffold"},
+		},
+		Packages: []spdxPackage{pkg},
+		Files:    spdxFiles,
+	})
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxComponent struct {
+	Type    string    `json:"type"`
+	Name    string    `json:"name"`
+	Version string    `json:"version,omitempty"`
+	Hashes  []cdxHash `json:"hashes,omitempty"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Component cdxComponent `json:"component"`
+}
+
+type cdxDoc struct {
+	BOMFormat   string         `json:"bomFormat"`
+	SpecVersion string         `json:"specVersion"`
+	Version     int            `json:"version"`
+	Metadata    cdxMetadata    `json:"metadata"`
+	Components  []cdxComponent `json:"components,omitempty"`
+}
+
+func cycloneDXDocument(name string, digest v1.Hash, files []sbomFile) ([]byte, error) {
+	var components []cdxComponent
+	for _, f := range files {
+		components = append(components, cdxComponent{
+			Type:   "file",
+			Name:   f.path,
+			Hashes: []cdxHash{{Alg: "SHA-256", Content: f.sha256}},
+		})
+	}
+
+	return json.Marshal(cdxDoc{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.3",
+		Version:     1,
+		Metadata: cdxMetadata{
+			Timestamp: now().UTC().Format(time.RFC3339),
+			Component: cdxComponent{
+				Type:    "container",
+				Name:    name,
+				Version: digest.String(),
+			},
+		},
+		Components: components,
+	})
+}
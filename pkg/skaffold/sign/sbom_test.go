@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGenerateSBOM(t *testing.T) {
+	tests := []struct {
+		description           string
+		format                string
+		expectedPredicateType string
+		shouldErr             bool
+	}{
+		{description: "spdx", format: SBOMFormatSPDX, expectedPredicateType: "https://spdx.dev/Document"},
+		{description: "cyclonedx", format: SBOMFormatCycloneDX, expectedPredicateType: "https://cyclonedx.org/bom"},
+		{description: "unknown format", format: "swid", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&now, func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) })
+			img := imageWithFiles(t,
+				map[string]string{"app/main": "binary", "etc/config": "v1", "tmp/cache": "stale"},
+				map[string]string{"etc/config": "v2", "tmp/.wh.cache": ""},
+			)
+
+			predicateType, content, err := GenerateSBOM(img, "gcr.io/project/app", test.format)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedPredicateType, predicateType)
+			if test.shouldErr {
+				return
+			}
+			t.CheckTrue(json.Valid(content))
+			t.CheckContains("/app/main", string(content))
+			t.CheckContains("/etc/config", string(content))
+			t.CheckFalse(bytes.Contains(content, []byte("/tmp/cache")))
+			t.CheckContains("2021-05-01T00:00:00Z", string(content))
+		})
+	}
+}
+
+func TestListFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		img := imageWithFiles(t,
+			map[string]string{"b": "first", "a": "deleted"},
+			map[string]string{"b": "second", ".wh.a": ""},
+		)
+
+		files, err := listFiles(img)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]sbomFile{{
+			path:   "/b",
+			sha256: "16367aacb67a4a017c8da8ab95682ccb390863780f7114dda0a0e0c55644c7c4",
+		}}, files, cmp.AllowUnexported(sbomFile{}))
+	})
+}
+
+func imageWithFiles(t *testutil.T, layers ...map[string]string) v1.Image {
+	img := empty.Image
+	for _, files := range layers {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for path, content := range files {
+			t.CheckNoError(tw.WriteHeader(&tar.Header{Name: path, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+			_, err := tw.Write([]byte(content))
+			t.CheckNoError(err)
+		}
+		t.CheckNoError(tw.Close())
+
+		content := buf.Bytes()
+		layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(content)), nil
+		})
+		t.CheckNoError(err)
+		img, err = mutate.AppendLayers(img, layer)
+		t.CheckNoError(err)
+	}
+	return img
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// The signature and attestation layouts follow cosign's, so that images
+// signed by Skaffold can be verified with `cosign verify --key`.
+const (
+	simpleSigningMediaType types.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	dsseMediaType          types.MediaType = "application/vnd.dsse.envelope.v1+json"
+	inTotoPayloadType                      = "application/vnd.in-toto+json"
+	inTotoStatementType                    = "https://in-toto.io/Statement/v0.1"
+
+	signatureAnnotation     = "dev.cosignproject.cosign/signature"
+	predicateTypeAnnotation = "predicateType"
+)
+
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []inTotoSubject `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type dsseSignature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
+
+type dsseEnvelope struct {
+	PayloadType string          `json:"payloadType"`
+	Payload     string          `json:"payload"`
+	Signatures  []dsseSignature `json:"signatures"`
+}
+
+// Sign signs the pushed image of an artifact with the configured private key and, unless disabled,
+// attaches an attestation holding the image's SBOM. Both are stored next to the image in its registry.
+// Images that haven't been pushed, and thus have no digest, are skipped.
+func Sign(out io.Writer, a graph.Artifact, cfg latest_v1.SignConfig, dockerCfg docker.Config) error {
+	ref, err := docker.ParseReference(a.Tag)
+	if err != nil {
+		return signErr(a.Tag, err)
+	}
+	if ref.Digest == "" {
+		color.Yellow.Fprintf(out, "Skipping signing of %s: the image wasn't pushed to a registry\n", a.ImageName)
+		return nil
+	}
+
+	key, err := LoadPrivateKey(cfg.Key)
+	if err != nil {
+		return signErr(a.Tag, err)
+	}
+
+	image := ref.BaseName + "@" + ref.Digest
+	color.Default.Fprintf(out, "Signing %s\n", image)
+	if err := writeSignature(ref, key, dockerCfg); err != nil {
+		return signErr(image, err)
+	}
+	logrus.Debugf("Signed %s", image)
+
+	if cfg.SBOM == SBOMFormatNone {
+		return nil
+	}
+	if err := writeAttestation(ref, cfg.SBOM, key, dockerCfg); err != nil {
+		return signErr(image, err)
+	}
+	logrus.Debugf("Attached %s SBOM attestation to %s", cfg.SBOM, image)
+
+	return nil
+}
+
+func writeSignature(ref *docker.ImageReference, key *ecdsa.PrivateKey, dockerCfg docker.Config) error {
+	var payload simpleSigning
+	payload.Critical.Identity.DockerReference = ref.BaseName
+	payload.Critical.Image.DockerManifestDigest = ref.Digest
+	payload.Critical.Type = "cosign container image signature"
+
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	sig, err := signBytes(key, content)
+	if err != nil {
+		return err
+	}
+
+	layer, err := docker.NewStaticLayer(content, simpleSigningMediaType)
+	if err != nil {
+		return err
+	}
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       layer,
+		Annotations: map[string]string{signatureAnnotation: sig},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = docker.WriteRemoteImage(attachedTag(ref, "sig"), img, dockerCfg)
+	return err
+}
+
+func writeAttestation(ref *docker.ImageReference, format string, key *ecdsa.PrivateKey, dockerCfg docker.Config) error {
+	img, err := docker.RemoteImage(ref.BaseName+"@"+ref.Digest, nil, dockerCfg)
+	if err != nil {
+		return fmt.Errorf("getting image: %w", err)
+	}
+	predicateType, sbom, err := GenerateSBOM(img, ref.BaseName, format)
+	if err != nil {
+		return fmt.Errorf("generating SBOM: %w", err)
+	}
+
+	statement, err := json.Marshal(inTotoStatement{
+		Type:          inTotoStatementType,
+		PredicateType: predicateType,
+		Subject: []inTotoSubject{{
+			Name:   ref.BaseName,
+			Digest: map[string]string{"sha256": strings.TrimPrefix(ref.Digest, "sha256:")},
+		}},
+		Predicate: sbom,
+	})
+	if err != nil {
+		return err
+	}
+	sig, err := signBytes(key, pae(inTotoPayloadType, statement))
+	if err != nil {
+		return err
+	}
+	envelope, err := json.Marshal(dsseEnvelope{
+		PayloadType: inTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(statement),
+		Signatures:  []dsseSignature{{Sig: sig}},
+	})
+	if err != nil {
+		return err
+	}
+
+	layer, err := docker.NewStaticLayer(envelope, dsseMediaType)
+	if err != nil {
+		return err
+	}
+	att, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       layer,
+		Annotations: map[string]string{predicateTypeAnnotation: predicateType},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = docker.WriteRemoteImage(attachedTag(ref, "att"), att, dockerCfg)
+	return err
+}
+
+// attachedTag returns the tag under which a signature or an attestation is stored:
+// `<repo>:sha256-<hex>.<suffix>`.
+func attachedTag(ref *docker.ImageReference, suffix string) string {
+	return fmt.Sprintf("%s:%s.%s", ref.BaseName, strings.Replace(ref.Digest, ":", "-", 1), suffix)
+}
+
+// pae is the DSSE pre-authentication encoding of a payload.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+func signBytes(key *ecdsa.PrivateKey, content []byte) (string, error) {
+	h := sha256.Sum256(content)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func verifyBytes(key *ecdsa.PublicKey, content []byte, sig string) error {
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+	h := sha256.Sum256(content)
+	if !ecdsa.VerifyASN1(key, h[:], raw) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
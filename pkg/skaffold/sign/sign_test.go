@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	tests := []struct {
+		description string
+		sbom        string
+	}{
+		{description: "spdx", sbom: SBOMFormatSPDX},
+		{description: "cyclonedx", sbom: SBOMFormatCycloneDX},
+		{description: "no sbom", sbom: SBOMFormatNone},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			host, cfg := localRegistry(t)
+			tmpDir := t.NewTempDir()
+			key, pub := generateKey(t)
+			tmpDir.Write("cosign.key", encodePrivateKey(t, key))
+			_, otherKey := generateKey(t)
+
+			signed := pushRandomImage(t, host+"/signed:v1", cfg)
+			var out bytes.Buffer
+			err := Sign(&out, signed, latest_v1.SignConfig{Key: tmpDir.Path("cosign.key"), SBOM: test.sbom}, cfg)
+			t.CheckNoError(err)
+			t.CheckContains("Signing "+strings.TrimSuffix(signed.ImageName, ":v1"), out.String())
+
+			t.CheckNoError(Verify(signed, []*ecdsa.PublicKey{pub}, cfg))
+			t.CheckNoError(Verify(signed, []*ecdsa.PublicKey{otherKey, pub}, cfg))
+			t.CheckError(true, Verify(signed, []*ecdsa.PublicKey{otherKey}, cfg))
+
+			ref, err := docker.ParseReference(signed.Tag)
+			t.CheckNoError(err)
+			att, err := docker.RemoteImage(attachedTag(ref, "att"), nil, cfg)
+			if test.sbom == SBOMFormatNone {
+				t.CheckError(true, err)
+				return
+			}
+			t.CheckNoError(err)
+			manifest, err := att.Manifest()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(1, len(manifest.Layers))
+			t.CheckDeepEqual(dsseMediaType, manifest.Layers[0].MediaType)
+			t.CheckTrue(strings.HasPrefix(manifest.Layers[0].Annotations[predicateTypeAnnotation], "https://"))
+		})
+	}
+}
+
+func TestSignSkipsLocalImages(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		err := Sign(&out, graph.Artifact{ImageName: "app", Tag: "app:v1"}, latest_v1.SignConfig{Key: "missing.key"}, &mockConfig{})
+
+		t.CheckNoError(err)
+		t.CheckContains("Skipping signing of app", out.String())
+	})
+}
+
+func TestVerifyRefusesUnsignedImages(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		host, cfg := localRegistry(t)
+		_, pub := generateKey(t)
+
+		unsigned := pushRandomImage(t, host+"/unsigned:v1", cfg)
+		t.CheckErrorContains("no signature found", Verify(unsigned, []*ecdsa.PublicKey{pub}, cfg))
+
+		local := graph.Artifact{ImageName: "local", Tag: "local:v1"}
+		t.CheckErrorContains("has no digest", Verify(local, []*ecdsa.PublicKey{pub}, cfg))
+	})
+}
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+}
+
+func localRegistry(t *testutil.T) (string, docker.Config) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	return host, &mockConfig{RunContext: runcontext.RunContext{InsecureRegistries: map[string]bool{host: true}}}
+}
+
+func pushRandomImage(t *testutil.T, tag string, cfg docker.Config) graph.Artifact {
+	img, err := random.Image(1024, 2)
+	t.CheckNoError(err)
+	digest, err := docker.WriteRemoteImage(tag, img, cfg)
+	t.CheckNoError(err)
+
+	return graph.Artifact{ImageName: tag, Tag: tag + "@" + digest}
+}
+
+func generateKey(t *testutil.T) (*ecdsa.PrivateKey, *ecdsa.PublicKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.CheckNoError(err)
+	return key, &key.PublicKey
+}
+
+func encodePrivateKey(t *testutil.T, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalECPrivateKey(key)
+	t.CheckNoError(err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+// Verify checks that the image of an artifact was signed by one of the given keys.
+// Images without a digest are refused, since a tag can't be tied to a signature.
+func Verify(a graph.Artifact, keys []*ecdsa.PublicKey, dockerCfg docker.Config) error {
+	ref, err := docker.ParseReference(a.Tag)
+	if err != nil {
+		return verifyErr(a.Tag, err)
+	}
+	if ref.Digest == "" {
+		return verifyErr(a.Tag, errors.New("image has no digest and can't be verified; signed images must be pushed to a registry"))
+	}
+
+	if err := verifySignature(ref, keys, dockerCfg); err != nil {
+		return verifyErr(ref.BaseName+"@"+ref.Digest, err)
+	}
+	return nil
+}
+
+func verifySignature(ref *docker.ImageReference, keys []*ecdsa.PublicKey, dockerCfg docker.Config) error {
+	sigImage, err := docker.RemoteImage(attachedTag(ref, "sig"), nil, dockerCfg)
+	if err != nil {
+		return fmt.Errorf("no signature found: %w", err)
+	}
+	manifest, err := sigImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("reading signature: %w", err)
+	}
+
+	for _, desc := range manifest.Layers {
+		sig, found := desc.Annotations[signatureAnnotation]
+		if !found || desc.MediaType != simpleSigningMediaType {
+			continue
+		}
+		layer, err := sigImage.LayerByDigest(desc.Digest)
+		if err != nil {
+			return fmt.Errorf("reading signature: %w", err)
+		}
+		rc, err := layer.Compressed()
+		if err != nil {
+			return fmt.Errorf("reading signature: %w", err)
+		}
+		payload, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("reading signature: %w", err)
+		}
+
+		if !signedBy(payload, sig, keys) {
+			continue
+		}
+		var signed simpleSigning
+		if err := json.Unmarshal(payload, &signed); err != nil {
+			continue
+		}
+		if signed.Critical.Image.DockerManifestDigest == ref.Digest {
+			return nil
+		}
+	}
+
+	return errors.New("no valid signature found for the configured public keys")
+}
+
+func signedBy(payload []byte, sig string, keys []*ecdsa.PublicKey) bool {
+	for _, key := range keys {
+		if verifyBytes(key, payload, sig) == nil {
+			return true
+		}
+	}
+	return false
+}
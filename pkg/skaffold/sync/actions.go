@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// afterSyncActions returns the actions to run in the synced containers: the actions of the
+// manual sync rules that match a changed file, followed by the actions of the artifact.
+// Each command is listed only once, even if it's declared multiple times.
+// They're run as the first post-sync container hooks of the artifact.
+func afterSyncActions(a *latest_v1.Artifact, e filemon.Events) ([]latest_v1.ContainerHook, error) {
+	var actions []latest_v1.ContainerHook
+	for _, r := range a.Sync.Manual {
+		if len(r.AfterSync) == 0 {
+			continue
+		}
+
+		matches, err := matchesChangedFile(a.Workspace, r, e)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			actions = append(actions, r.AfterSync...)
+		}
+	}
+	actions = append(actions, a.Sync.AfterSync...)
+
+	var unique []latest_v1.ContainerHook
+	seen := make(map[string]bool)
+	for _, action := range actions {
+		key := strings.Join(action.Command, "\x00")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, action)
+	}
+	return unique, nil
+}
+
+func matchesChangedFile(workspace string, r *latest_v1.SyncRule, e filemon.Events) (bool, error) {
+	for _, f := range append(append(e.Added, e.Modified...), e.Deleted...) {
+		relPath, err := filepath.Rel(workspace, f)
+		if err != nil {
+			return false, fmt.Errorf("changed file %s can't be found relative to context %q: %w", f, workspace, err)
+		}
+
+		matches, err := doublestar.PathMatch(filepath.FromSlash(r.Src), relPath)
+		if err != nil {
+			return false, fmt.Errorf("pattern error for %q: %w", relPath, err)
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
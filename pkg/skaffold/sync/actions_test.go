@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAfterSyncActions(t *testing.T) {
+	reload := latest_v1.ContainerHook{Command: []string{"nginx", "-s", "reload"}}
+	restart := latest_v1.ContainerHook{Command: []string{"kill", "-HUP", "1"}}
+
+	tests := []struct {
+		description string
+		sync        *latest_v1.Sync
+		evt         filemon.Events
+		expected    []latest_v1.ContainerHook
+	}{
+		{
+			description: "no actions",
+			sync:        &latest_v1.Sync{Manual: []*latest_v1.SyncRule{{Src: "*.html", Dest: "."}}},
+			evt:         filemon.Events{Modified: []string{"index.html"}},
+		},
+		{
+			description: "artifact action",
+			sync:        &latest_v1.Sync{AfterSync: []latest_v1.ContainerHook{restart}},
+			evt:         filemon.Events{Modified: []string{"index.html"}},
+			expected:    []latest_v1.ContainerHook{restart},
+		},
+		{
+			description: "matching rule action runs before artifact action",
+			sync: &latest_v1.Sync{
+				Manual:    []*latest_v1.SyncRule{{Src: "conf/*.conf", Dest: "/etc/nginx", AfterSync: []latest_v1.ContainerHook{reload}}},
+				AfterSync: []latest_v1.ContainerHook{restart},
+			},
+			evt:      filemon.Events{Deleted: []string{"conf/site.conf"}},
+			expected: []latest_v1.ContainerHook{reload, restart},
+		},
+		{
+			description: "rule action is skipped when no file matches",
+			sync: &latest_v1.Sync{
+				Manual: []*latest_v1.SyncRule{{Src: "conf/*.conf", Dest: "/etc/nginx", AfterSync: []latest_v1.ContainerHook{reload}}},
+			},
+			evt: filemon.Events{Added: []string{"index.html"}},
+		},
+		{
+			description: "duplicate actions run once",
+			sync: &latest_v1.Sync{
+				Manual: []*latest_v1.SyncRule{
+					{Src: "*.html", Dest: ".", AfterSync: []latest_v1.ContainerHook{reload}},
+					{Src: "*.css", Dest: ".", AfterSync: []latest_v1.ContainerHook{reload}},
+				},
+				AfterSync: []latest_v1.ContainerHook{reload},
+			},
+			evt:      filemon.Events{Modified: []string{"index.html", "style.css"}},
+			expected: []latest_v1.ContainerHook{reload},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actions, err := afterSyncActions(&latest_v1.Artifact{Workspace: ".", Sync: test.sync}, test.evt)
+
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actions)
+		})
+	}
+}
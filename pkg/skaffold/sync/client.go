@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+// For testing
+var execInContainer = execWithClient
+
+func deleteFileWithClientFn(transport string) func(context.Context, v1.Pod, v1.Container, syncMap) error {
+	return func(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) error {
+		return execInContainer(ctx, pod, container, deleteCommand(transport, files), nil, nil)
+	}
+}
+
+func copyFileWithClientFn(transport string) func(context.Context, v1.Pod, v1.Container, syncMap) error {
+	return func(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) error {
+		return execInContainer(ctx, pod, container, copyCommand(transport), mappedTar(files), nil)
+	}
+}
+
+// execWithClient runs a command in a container through the Kubernetes API, like `kubectl exec` does.
+// The command's output is discarded if stdout is nil.
+func execWithClient(ctx context.Context, pod v1.Pod, container v1.Container, command []string, stdin io.Reader, stdout io.Writer) error {
+	config, err := kubernetesclient.RestConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container.Name,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("creating executor: %w", err)
+	}
+
+	// The stream doesn't stop when the context is cancelled, closing stdin does.
+	if closer, ok := stdin.(io.Closer); ok {
+		go func() {
+			<-ctx.Done()
+			closer.Close()
+		}()
+	}
+
+	if stdout == nil {
+		stdout = ioutil.Discard
+	}
+
+	var stderr bytes.Buffer
+	if err := executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		return fmt.Errorf("running %q in container %q of pod %q: %w: %s", strings.Join(command, " "), container.Name, pod.Name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
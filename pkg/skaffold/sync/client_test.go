@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncWithClient(t *testing.T) {
+	tests := []struct {
+		description string
+		transport   string
+		expected    []string
+	}{
+		{
+			description: "tar transport",
+			expected: []string{
+				"podname/container_name: tar xmf - -C / --no-same-owner (stdin)",
+				"podname/container_name: rm -rf -- /app/deleted.go",
+			},
+		},
+		{
+			description: "helper transport",
+			transport:   HelperTransport,
+			expected: []string{
+				"podname/container_name: /skaffold-sync/skaffold-sync-helper extract / (stdin)",
+				"podname/container_name: /skaffold-sync/skaffold-sync-helper delete -- /app/deleted.go",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("copied.go", "package main")
+			t.Override(&client.Client, func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(pod), nil
+			})
+			var executed []string
+			t.Override(&execInContainer, func(_ context.Context, pod v1.Pod, container v1.Container, command []string, stdin io.Reader, _ io.Writer) error {
+				line := pod.Name + "/" + container.Name + ": " + strings.Join(command, " ")
+				if stdin != nil {
+					if _, err := io.Copy(ioutil.Discard, stdin); err != nil {
+						return err
+					}
+					line += " (stdin)"
+				}
+				executed = append(executed, line)
+				return nil
+			})
+
+			syncer := &podSyncer{namespaces: []string{""}, useClient: true}
+			err := syncer.Sync(context.Background(), &Item{
+				Image:     "gcr.io/k8s-skaffold:123",
+				Copy:      syncMap{tmpDir.Path("copied.go"): {"/app/copied.go"}},
+				Delete:    syncMap{"deleted.go": {"/app/deleted.go"}},
+				Transport: test.transport,
+			})
+
+			t.CheckErrorAndDeepEqual(false, err, test.expected, executed)
+		})
+	}
+}
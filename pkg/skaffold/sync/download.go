@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+)
+
+// For testing
+var downloadInterval = 2 * time.Second
+
+// Downloader polls the containers for files that changed in the folders listed by
+// the artifacts' download rules, and copies them back into the artifacts' workspaces.
+type Downloader struct {
+	syncer    *podSyncer
+	runID     string
+	artifacts []*latest_v1.Artifact
+	ignore    func(...string)
+	cancel    context.CancelFunc
+}
+
+// fileListing maps file paths, relative to a download rule's source, to their modification time and size.
+type fileListing map[string]string
+
+// NewDownloader returns a Downloader for the artifacts that have download rules.
+// Files are downloaded from the containers of the current run, with the same client and namespaces as they're synced.
+// The downloaded files are passed to ignore before they're written, so that the
+// file monitor doesn't trigger a rebuild for them.
+func NewDownloader(syncer Syncer, runID string, artifacts []*latest_v1.Artifact, ignore func(...string)) *Downloader {
+	d := &Downloader{runID: runID, ignore: ignore}
+
+	s, ok := syncer.(*podSyncer)
+	if !ok {
+		return d
+	}
+	d.syncer = s
+	for _, a := range artifacts {
+		if a.Sync != nil && len(a.Sync.Download) > 0 {
+			d.artifacts = append(d.artifacts, a)
+		}
+	}
+	return d
+}
+
+// Start polls the containers running the given builds until Stop is called or the context is cancelled.
+// The files present when polling starts are not downloaded: only the ones that change afterwards are.
+func (d *Downloader) Start(ctx context.Context, builds []graph.Artifact) {
+	if len(d.artifacts) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+
+	go func() {
+		listings := make(map[string]fileListing)
+		ticker := time.NewTicker(downloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for _, a := range d.artifacts {
+				if err := d.download(ctx, a, latestTag(a.ImageName, builds), listings); err != nil {
+					logrus.Warnf("Downloading files for %s: %s", a.ImageName, err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops polling the containers.
+func (d *Downloader) Stop() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+}
+
+// download copies the files that changed since the previous listing from one of the containers running the image.
+func (d *Downloader) download(ctx context.Context, a *latest_v1.Artifact, image string, listings map[string]fileListing) error {
+	if image == "" {
+		return nil
+	}
+
+	pod, container, err := d.syncer.findContainer(ctx, image, d.runID)
+	if err != nil || pod == nil {
+		return err
+	}
+
+	for i, r := range a.Sync.Download {
+		src := strings.TrimSuffix(r.Src, "/")
+
+		out, err := d.syncer.runInContainer(ctx, *pod, *container, listCommand(src))
+		if err != nil {
+			return fmt.Errorf("listing files in %q: %w", src, err)
+		}
+
+		key := fmt.Sprintf("%s/%s/%s/%d", pod.Namespace, pod.Name, container.Name, i)
+		prev, found := listings[key]
+		curr := parseListing(src, out)
+		listings[key] = curr
+		if !found {
+			continue
+		}
+
+		changed := changedFiles(prev, curr)
+		if len(changed) == 0 {
+			continue
+		}
+
+		logrus.Infoln("Downloading files:", changed, "from", image)
+		archive, err := d.syncer.runInContainer(ctx, *pod, *container, append([]string{"tar", "cf", "-", "-C", src, "--"}, changed...))
+		if err != nil {
+			return fmt.Errorf("archiving files in %q: %w", src, err)
+		}
+
+		dest := filepath.Join(a.Workspace, r.Dest)
+		var written []string
+		for _, f := range changed {
+			written = append(written, filepath.Join(dest, filepath.FromSlash(f)))
+		}
+		d.ignore(written...)
+
+		if err := helper.Extract(bytes.NewReader(archive), dest); err != nil {
+			return fmt.Errorf("copying files to %q: %w", dest, err)
+		}
+	}
+
+	return nil
+}
+
+// findContainer returns the first running container of the given image deployed by the given run, or nil if there's none.
+func (s *podSyncer) findContainer(ctx context.Context, image string, runID string) (*v1.Pod, *v1.Container, error) {
+	client, err := s.kubeClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	for _, ns := range s.namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.RunIDLabel, runID),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+
+		for i := range pods.Items {
+			p := &pods.Items[i]
+			if p.Status.Phase != v1.PodRunning {
+				continue
+			}
+
+			for j := range p.Spec.Containers {
+				if p.Spec.Containers[j].Image == image {
+					return p, &p.Spec.Containers[j], nil
+				}
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// listCommand prints the modification time, size and path of every file under a folder.
+func listCommand(src string) []string {
+	return []string{"find", src, "-type", "f", "-exec", "stat", "-c", "%Y %s %n", "{}", "+"}
+}
+
+func parseListing(src string, out []byte) fileListing {
+	listing := make(fileListing)
+	for _, line := range strings.Split(string(out), "\n") {
+		parts := strings.SplitN(line, " ", 3)
+		if len(parts) != 3 || !strings.HasPrefix(parts[2], src+"/") {
+			continue
+		}
+		listing[strings.TrimPrefix(parts[2], src+"/")] = parts[0] + " " + parts[1]
+	}
+	return listing
+}
+
+// changedFiles returns the sorted list of files that were added or modified between two listings.
+func changedFiles(prev, curr fileListing) []string {
+	var changed []string
+	for f, stat := range curr {
+		if prev[f] != stat {
+			changed = append(changed, f)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestChangedFiles(t *testing.T) {
+	prev := parseListing("/app/gen", []byte("100 10 /app/gen/a.go\n100 20 /app/gen/b.go\n100 30 /app/gen/c.go\n"))
+	curr := parseListing("/app/gen", []byte("100 10 /app/gen/a.go\n200 20 /app/gen/b.go\n100 5 /app/gen/sub/d.go\n/other\n"))
+
+	testutil.CheckDeepEqual(t, fileListing{"a.go": "100 10", "b.go": "200 20", "sub/d.go": "100 5"}, curr)
+	testutil.CheckDeepEqual(t, []string{"b.go", "sub/d.go"}, changedFiles(prev, curr))
+}
+
+func TestDownload(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("gen/b.go", "old")
+		currentRun := pod.DeepCopy()
+		currentRun.Name = "current"
+		currentRun.Labels[label.RunIDLabel] = "run-id"
+		otherRun := pod.DeepCopy()
+		otherRun.Name = "another"
+		otherRun.Labels[label.RunIDLabel] = "other-run-id"
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(otherRun, currentRun), nil
+		})
+
+		listing := "100 3 /app/gen/b.go\n"
+		var executed []string
+		t.Override(&execInContainer, func(_ context.Context, pod v1.Pod, _ v1.Container, command []string, _ io.Reader, stdout io.Writer) error {
+			if pod.Name != "current" {
+				t.Errorf("unexpected exec in pod %q of another run", pod.Name)
+			}
+			executed = append(executed, strings.Join(command, " "))
+			switch command[0] {
+			case "find":
+				_, err := stdout.Write([]byte(listing))
+				return err
+			case "tar":
+				return writeTar(stdout, map[string]string{"b.go": "new"})
+			}
+			return nil
+		})
+
+		var ignored []string
+		downloader := NewDownloader(&podSyncer{namespaces: []string{""}, useClient: true}, "run-id", nil, func(paths ...string) { ignored = append(ignored, paths...) })
+		artifact := &latest_v1.Artifact{
+			Workspace: tmpDir.Root(),
+			Sync: &latest_v1.Sync{
+				Download: []*latest_v1.DownloadRule{{Src: "/app/gen/", Dest: "gen"}},
+			},
+		}
+		listings := make(map[string]fileListing)
+
+		// The first listing is only recorded
+		err := downloader.download(context.Background(), artifact, "gcr.io/k8s-skaffold:123", listings)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"find /app/gen -type f -exec stat -c %Y %s %n {} +"}, executed)
+		t.CheckEmpty(ignored)
+
+		// Changed files are downloaded
+		listing = "200 3 /app/gen/b.go\n"
+		err = downloader.download(context.Background(), artifact, "gcr.io/k8s-skaffold:123", listings)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("tar cf - -C /app/gen -- b.go", executed[len(executed)-1])
+		t.CheckDeepEqual([]string{tmpDir.Path("gen/b.go")}, ignored)
+		content, err := ioutil.ReadFile(tmpDir.Path("gen/b.go"))
+		t.CheckErrorAndDeepEqual(false, err, "new", string(content))
+	})
+}
+
+func writeTar(w io.Writer, files map[string]string) error {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
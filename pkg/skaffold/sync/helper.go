@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const (
+	// TarTransport syncs files by piping a tar archive to `tar` in the container.
+	TarTransport = "tar"
+
+	// HelperTransport syncs files with a static helper binary that is injected in the pod,
+	// so that it works with images that don't have a shell or `tar`.
+	HelperTransport = "helper"
+
+	// The init container copies the helper into a volume that is mounted in the synced containers.
+	helperVolume    = "skaffold-sync-helper"
+	helperMountPath = "/skaffold-sync"
+	helperPath      = helperMountPath + "/skaffold-sync-helper"
+)
+
+// HelperInjector returns a manifest transform that adds the sync helper to the pods
+// running the artifacts synced with the helper transport.
+func HelperInjector(artifacts []*latest_v1.Artifact) manifest.Transform {
+	helperImages := make(map[string]bool)
+	for _, a := range artifacts {
+		if a.Sync != nil && a.Sync.Transport == HelperTransport {
+			helperImages[a.ImageName] = true
+		}
+	}
+
+	return func(l manifest.ManifestList, builds []graph.Artifact, _ manifest.Registries) (manifest.ManifestList, error) {
+		tags := make(map[string]bool)
+		for _, b := range builds {
+			if helperImages[b.ImageName] {
+				tags[b.Tag] = true
+			}
+		}
+		if len(tags) == 0 {
+			return l, nil
+		}
+
+		return l.Visit(&helperInjector{tags: tags})
+	}
+}
+
+// helperInjector adds the helper volume to the containers running one of the given tags,
+// and the init container that populates this volume to their pod.
+type helperInjector struct {
+	tags map[string]bool
+}
+
+func (h *helperInjector) Visit(o map[string]interface{}, k string, v interface{}) bool {
+	if k != "containers" {
+		return true
+	}
+	containers, ok := v.([]interface{})
+	if !ok {
+		return true
+	}
+
+	injected := false
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if image, ok := container["image"].(string); !ok || !h.tags[image] {
+			continue
+		}
+
+		container["volumeMounts"] = append(asSlice(container["volumeMounts"]), map[string]interface{}{
+			"name":      helperVolume,
+			"mountPath": helperMountPath,
+		})
+		injected = true
+	}
+
+	if injected {
+		o["initContainers"] = append(asSlice(o["initContainers"]), map[string]interface{}{
+			"name":    helperVolume,
+			"image":   constants.DefaultSyncHelperImage,
+			"command": []interface{}{"/skaffold-sync-helper", "install", helperMountPath},
+			"volumeMounts": []interface{}{map[string]interface{}{
+				"name":      helperVolume,
+				"mountPath": helperMountPath,
+			}},
+		})
+		o["volumes"] = append(asSlice(o["volumes"]), map[string]interface{}{
+			"name":     helperVolume,
+			"emptyDir": map[string]interface{}{},
+		})
+	}
+	return false
+}
+
+func asSlice(v interface{}) []interface{} {
+	if s, ok := v.([]interface{}); ok {
+		return s
+	}
+	return nil
+}
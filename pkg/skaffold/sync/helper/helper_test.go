@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtract(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		root := t.NewTempDir().Write("app/existing.txt", "old").Root()
+
+		var archive bytes.Buffer
+		tw := tar.NewWriter(&archive)
+		writeEntry(t, tw, &tar.Header{Name: "/app/existing.txt", Typeflag: tar.TypeReg, Mode: 0644}, "new")
+		writeEntry(t, tw, &tar.Header{Name: "/app/static", Typeflag: tar.TypeDir, Mode: 0755}, "")
+		writeEntry(t, tw, &tar.Header{Name: "/app/static/css/main.css", Typeflag: tar.TypeReg, Mode: 0600}, "body {}")
+		writeEntry(t, tw, &tar.Header{Name: "/app/link", Typeflag: tar.TypeSymlink, Linkname: "existing.txt"}, "")
+		t.CheckNoError(tw.Close())
+
+		err := Extract(&archive, root)
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(filepath.Join(root, "app", "existing.txt"))
+		t.CheckErrorAndDeepEqual(false, err, "new", string(content))
+		content, err = ioutil.ReadFile(filepath.Join(root, "app", "static", "css", "main.css"))
+		t.CheckErrorAndDeepEqual(false, err, "body {}", string(content))
+		target, err := os.Readlink(filepath.Join(root, "app", "link"))
+		t.CheckErrorAndDeepEqual(false, err, "existing.txt", target)
+	})
+}
+
+func TestExtractOutsideRoot(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		root := t.NewTempDir().Root()
+
+		var archive bytes.Buffer
+		tw := tar.NewWriter(&archive)
+		writeEntry(t, tw, &tar.Header{Name: "../escape.txt", Typeflag: tar.TypeReg, Mode: 0644}, "content")
+		t.CheckNoError(tw.Close())
+
+		err := Extract(&archive, root)
+
+		t.CheckErrorContains("invalid path in tar archive", err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("app/file.txt", "").
+			Write("app/static/main.css", "").
+			Write("app/keep.txt", "")
+
+		err := Delete(tmpDir.Paths("app/file.txt", "app/static", "app/missing.txt"))
+
+		t.CheckNoError(err)
+		t.CheckFalse(fileExists(tmpDir.Path("app/file.txt")))
+		t.CheckFalse(fileExists(tmpDir.Path("app/static")))
+		t.CheckTrue(fileExists(tmpDir.Path("app/keep.txt")))
+	})
+}
+
+func writeEntry(t *testutil.T, tw *tar.Writer, header *tar.Header, content string) {
+	header.Size = int64(len(content))
+	t.CheckNoError(tw.WriteHeader(header))
+	_, err := tw.Write([]byte(content))
+	t.CheckNoError(err)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncWithHelper(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cmdRecord := &TestCmdRecorder{}
+		t.Override(&util.DefaultExecCommand, cmdRecord)
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(pod), nil
+		})
+
+		syncer := &podSyncer{kubectl: &kubectl.CLI{KubeContext: "kubecontext"}, namespaces: []string{""}}
+		err := syncer.Sync(context.Background(), &Item{
+			Image:     "gcr.io/k8s-skaffold:123",
+			Delete:    syncMap{"test.go": {"/app/test.go"}},
+			Transport: HelperTransport,
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{
+			"kubectl --context kubecontext exec podname --namespace  -c container_name -- /skaffold-sync/skaffold-sync-helper delete -- /app/test.go",
+		}, cmdRecord.cmds)
+	})
+}
+
+func TestInjectHelper(t *testing.T) {
+	manifests := manifest.ManifestList{[]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - image: app:TAG
+        name: app
+      - image: other:TAG
+        name: other
+`), []byte(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: other
+spec:
+  containers:
+  - image: other:TAG
+    name: other
+`)}
+
+	expected := manifest.ManifestList{[]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - image: app:TAG
+        name: app
+        volumeMounts:
+        - mountPath: /skaffold-sync
+          name: skaffold-sync-helper
+      - image: other:TAG
+        name: other
+      initContainers:
+      - command:
+        - /skaffold-sync-helper
+        - install
+        - /skaffold-sync
+        image: gcr.io/k8s-skaffold/skaffold-sync-helper:v1
+        name: skaffold-sync-helper
+        volumeMounts:
+        - mountPath: /skaffold-sync
+          name: skaffold-sync-helper
+      volumes:
+      - emptyDir: {}
+        name: skaffold-sync-helper
+`), []byte(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: other
+spec:
+  containers:
+  - image: other:TAG
+    name: other
+`)}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		transform := HelperInjector([]*latest_v1.Artifact{
+			{ImageName: "app", Sync: &latest_v1.Sync{Transport: HelperTransport}},
+			{ImageName: "other", Sync: &latest_v1.Sync{Transport: TarTransport}},
+		})
+
+		builds := []graph.Artifact{{ImageName: "app", Tag: "app:TAG"}, {ImageName: "other", Tag: "other:TAG"}}
+		result, err := transform(manifests, builds, manifest.Registries{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected.String(), result.String())
+	})
+}
+
+func TestInjectHelperNoHelperImages(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		transform := HelperInjector([]*latest_v1.Artifact{
+			{ImageName: "app", Sync: &latest_v1.Sync{}},
+			{ImageName: "nosync"},
+		})
+		manifests := manifest.ManifestList{[]byte("INVALID")}
+
+		result, err := transform(manifests, []graph.Artifact{{ImageName: "app", Tag: "app:TAG"}, {ImageName: "nosync", Tag: "nosync:TAG"}}, manifest.Registries{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(manifests, result)
+	})
+}
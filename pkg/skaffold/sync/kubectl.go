@@ -18,35 +18,25 @@ package sync
 
 import (
 	"context"
-	"io"
 	"os/exec"
 
 	v1 "k8s.io/api/core/v1"
-
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-func (s *podSyncer) deleteFileFn(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) *exec.Cmd {
-	args := make([]string, 0, 9+len(files))
-	args = append(args, pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "--", "rm", "-rf", "--")
-	for _, dsts := range files {
-		args = append(args, dsts...)
+func (s *podSyncer) deleteFileFn(transport string) func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd {
+	return func(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) *exec.Cmd {
+		args := []string{pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "--"}
+		args = append(args, deleteCommand(transport, files)...)
+		return s.kubectl.Command(ctx, "exec", args...)
 	}
-	return s.kubectl.Command(ctx, "exec", args...)
 }
 
-func (s *podSyncer) copyFileFn(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) *exec.Cmd {
-	// Use "m" flag to touch the files as they are copied.
-	reader, writer := io.Pipe()
-	go func() {
-		if err := util.CreateMappedTar(writer, "/", files); err != nil {
-			writer.CloseWithError(err)
-		} else {
-			writer.Close()
-		}
-	}()
-
-	copyCmd := s.kubectl.Command(ctx, "exec", pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "-i", "--", "tar", "xmf", "-", "-C", "/", "--no-same-owner")
-	copyCmd.Stdin = reader
-	return copyCmd
+func (s *podSyncer) copyFileFn(transport string) func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd {
+	return func(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) *exec.Cmd {
+		args := []string{pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "-i", "--"}
+		args = append(args, copyCommand(transport)...)
+		copyCmd := s.kubectl.Command(ctx, "exec", args...)
+		copyCmd.Stdin = mappedTar(files)
+		return copyCmd
+	}
 }
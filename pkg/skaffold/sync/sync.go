@@ -17,9 +17,11 @@ limitations under the License.
 package sync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"path"
 	"path/filepath"
@@ -64,19 +66,28 @@ func NewItem(ctx context.Context, a *latest_v1.Artifact, e filemon.Events, build
 		return nil, fmt.Errorf("could not find latest tag for image %s in builds: %v", a.ImageName, builds)
 	}
 
+	var item *Item
+	var err error
 	switch {
 	case len(a.Sync.Manual) > 0:
-		return syncItem(a, tag, e, a.Sync.Manual, cfg)
+		item, err = syncItem(a, tag, e, a.Sync.Manual, cfg)
 
 	case a.Sync.Auto != nil:
-		return autoSyncItem(ctx, a, tag, e, cfg)
+		item, err = autoSyncItem(ctx, a, tag, e, cfg)
 
 	case len(a.Sync.Infer) > 0:
-		return inferredSyncItem(a, tag, e, cfg)
+		item, err = inferredSyncItem(a, tag, e, cfg)
+	}
+	if item == nil || err != nil {
+		return item, err
+	}
 
-	default:
-		return nil, nil
+	item.Transport = a.Sync.Transport
+	item.AfterSync, err = afterSyncActions(a, e)
+	if err != nil {
+		return nil, err
 	}
+	return item, nil
 }
 
 func syncItem(a *latest_v1.Artifact, tag string, e filemon.Events, syncRules []*latest_v1.SyncRule, cfg docker.Config) (*Item, error) {
@@ -253,10 +264,15 @@ func matchSyncRules(syncRules []*latest_v1.SyncRule, relPath, containerWd string
 }
 
 func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
+	copyFn, deleteFn := runCmdFn(s.copyFileFn(item.Transport)), runCmdFn(s.deleteFileFn(item.Transport))
+	if s.useClient {
+		copyFn, deleteFn = copyFileWithClientFn(item.Transport), deleteFileWithClientFn(item.Transport)
+	}
+
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, s.namespaces); err != nil {
+		if err := perform(ctx, item.Image, item.Copy, copyFn, s.namespaces); err != nil {
 			return fmt.Errorf("copying files: %w", err)
 		}
 	}
@@ -264,7 +280,7 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	if len(item.Delete) > 0 {
 		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, s.namespaces); err != nil {
+		if err := perform(ctx, item.Image, item.Delete, deleteFn, s.namespaces); err != nil {
 			return fmt.Errorf("deleting files: %w", err)
 		}
 	}
@@ -272,7 +288,53 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	return nil
 }
 
+// copyCommand returns the command that extracts, in a container, the tar archive of the files to copy.
+func copyCommand(transport string) []string {
+	if transport == HelperTransport {
+		return []string{helperPath, "extract", "/"}
+	}
+	// Use "m" flag to touch the files as they are copied.
+	return []string{"tar", "xmf", "-", "-C", "/", "--no-same-owner"}
+}
+
+// deleteCommand returns the command that deletes files in a container.
+func deleteCommand(transport string, files syncMap) []string {
+	cmd := []string{"rm", "-rf", "--"}
+	if transport == HelperTransport {
+		cmd = []string{helperPath, "delete", "--"}
+	}
+	for _, dsts := range files {
+		cmd = append(cmd, dsts...)
+	}
+	return cmd
+}
+
+// mappedTar streams a tar archive of the files to copy.
+func mappedTar(files syncMap) io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		if err := util.CreateMappedTar(writer, "/", files); err != nil {
+			writer.CloseWithError(err)
+		} else {
+			writer.Close()
+		}
+	}()
+	return reader
+}
+
+// runCmdFn adapts a function that creates commands to a function that runs them.
+func runCmdFn(cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd) func(context.Context, v1.Pod, v1.Container, syncMap) error {
+	return func(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) error {
+		_, err := util.RunCmdOut(cmdFn(ctx, pod, container, files))
+		return err
+	}
+}
+
 func Perform(ctx context.Context, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string) error {
+	return perform(ctx, image, files, runCmdFn(cmdFn), namespaces)
+}
+
+func perform(ctx context.Context, image string, files syncMap, syncFn func(context.Context, v1.Pod, v1.Container, syncMap) error, namespaces []string) error {
 	if len(files) == 0 {
 		return nil
 	}
@@ -301,10 +363,9 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 					continue
 				}
 
-				cmd := cmdFn(ctx, p, c, files)
+				p, c := p, c
 				errs.Go(func() error {
-					_, err := util.RunCmdOut(cmd)
-					return err
+					return syncFn(ctx, p, c, files)
 				})
 				numSynced++
 			}
@@ -321,6 +382,18 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 	return nil
 }
 
+// runInContainer runs a command in a container and returns its output.
+func (s *podSyncer) runInContainer(ctx context.Context, pod v1.Pod, container v1.Container, command []string) ([]byte, error) {
+	if s.useClient {
+		var out bytes.Buffer
+		err := execInContainer(ctx, pod, container, command, nil, &out)
+		return out.Bytes(), err
+	}
+
+	args := append([]string{pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "--"}, command...)
+	return util.RunCmdOut(s.kubectl.Command(ctx, "exec", args...))
+}
+
 func Init(ctx context.Context, artifacts []*latest_v1.Artifact) error {
 	for _, a := range artifacts {
 		if a.Sync == nil {
@@ -19,16 +19,23 @@ package sync
 import (
 	"context"
 
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
 type syncMap map[string][]string
 
 type Item struct {
-	Image  string
-	Copy   map[string][]string
-	Delete map[string][]string
+	Image     string
+	Copy      map[string][]string
+	Delete    map[string][]string
+	Transport string
+	AfterSync []latest_v1.ContainerHook
 }
 
 type Syncer interface {
@@ -38,6 +45,10 @@ type Syncer interface {
 type podSyncer struct {
 	kubectl    *pkgkubectl.CLI
 	namespaces []string
+	useClient  bool
+
+	// client is created on first use, by the download poller
+	client kubernetes.Interface
 }
 
 type Config interface {
@@ -46,9 +57,22 @@ type Config interface {
 	GetNamespaces() []string
 }
 
+// kubeClient returns the Kubernetes client of the syncer.
+func (s *podSyncer) kubeClient() (kubernetes.Interface, error) {
+	if s.client == nil {
+		client, err := kubernetesclient.Client()
+		if err != nil {
+			return nil, err
+		}
+		s.client = client
+	}
+	return s.client, nil
+}
+
 func NewSyncer(cfg Config) Syncer {
 	return &podSyncer{
 		kubectl:    pkgkubectl.NewCLI(cfg, ""),
 		namespaces: cfg.GetNamespaces(),
+		useClient:  config.UseNativeKubeClient(cfg.GlobalConfig()),
 	}
 }
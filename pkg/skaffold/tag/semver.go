@@ -0,0 +1,247 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/sirupsen/logrus"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const (
+	semverSourceGitTag      = "gittag"
+	semverSourceVersionFile = "versionfile"
+)
+
+type bump int
+
+const (
+	bumpPatch bump = iota
+	bumpMinor
+	bumpMajor
+)
+
+var (
+	conventionalCommit = regexp.MustCompile(`^(\w+)(\([^)]*\))?(!)?:`)
+	breakingChange     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE:`)
+)
+
+// Semver tags an image with the semantic version of its workspace.
+type Semver struct {
+	source        string
+	versionFile   string
+	tagPrefix     string
+	prerelease    string
+	buildMetadata bool
+	ignoreChanges bool
+}
+
+// NewSemverTagger creates a new semver tagger. It fails if the version source is invalid.
+func NewSemverTagger(t *latest_v1.SemverTagger) (*Semver, error) {
+	source := strings.ToLower(t.Source)
+	switch source {
+	case "":
+		source = semverSourceGitTag
+	case semverSourceGitTag, semverSourceVersionFile:
+	default:
+		return nil, fmt.Errorf("%q is not a valid semver tagger source", t.Source)
+	}
+
+	versionFile := t.VersionFile
+	if versionFile == "" {
+		versionFile = "VERSION"
+	}
+	prerelease := t.Prerelease
+	if prerelease == "" {
+		prerelease = "dirty"
+	}
+	if _, err := semver.NewPRVersion(prerelease); err != nil {
+		return nil, fmt.Errorf("invalid semver pre-release %q: %w", prerelease, err)
+	}
+
+	return &Semver{
+		source:        source,
+		versionFile:   versionFile,
+		tagPrefix:     t.TagPrefix,
+		prerelease:    prerelease,
+		buildMetadata: t.BuildMetadata,
+		ignoreChanges: t.IgnoreChanges,
+	}, nil
+}
+
+// GenerateTag generates a tag from the version of the workspace.
+func (t *Semver) GenerateTag(image latest_v1.Artifact) (string, error) {
+	var version semver.Version
+	var err error
+	if t.source == semverSourceVersionFile {
+		version, err = t.fileVersion(image.Workspace)
+	} else {
+		version, err = t.nextVersion(image.Workspace)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if !t.ignoreChanges {
+		changes, err := runGit(image.Workspace, "status", ".", "--porcelain")
+		switch {
+		case err != nil && t.source == semverSourceVersionFile:
+			// The version file can be used outside of a git repository.
+			logrus.Debugf("unable to get git status of %s: %v", image.Workspace, err)
+		case err != nil:
+			return "", fmt.Errorf("getting git status: %w", err)
+		case len(changes) > 0:
+			if version, err = t.markDirty(image.Workspace, version); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	// Image tags can't contain `+`.
+	return strings.Replace(version.String(), "+", "_", 1), nil
+}
+
+func (t *Semver) markDirty(workspace string, version semver.Version) (semver.Version, error) {
+	pre, err := semver.NewPRVersion(t.prerelease)
+	if err != nil {
+		return version, err
+	}
+	version.Pre = append(version.Pre, pre)
+
+	if t.buildMetadata {
+		sha, err := gitAbbrevcommitsha(workspace)
+		if err != nil {
+			return version, fmt.Errorf("unable to find git commit: %w", err)
+		}
+		version.Build = append(version.Build, sha)
+	}
+	return version, nil
+}
+
+func (t *Semver) fileVersion(workspace string) (semver.Version, error) {
+	file := filepath.Join(workspace, t.versionFile)
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("reading version file: %w", err)
+	}
+
+	version, err := semver.ParseTolerant(strings.TrimSpace(string(content)))
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("%s doesn't contain a semantic version: %w", file, err)
+	}
+	return version, nil
+}
+
+// nextVersion finds the latest version tag and bumps it according to the commits since that tag.
+// The version of a commit that is tagged is the tag itself.
+func (t *Semver) nextVersion(workspace string) (semver.Version, error) {
+	var version semver.Version
+	revisions := "HEAD"
+
+	tag, err := runGit(workspace, append([]string{"describe", "--abbrev=0"}, t.matchArgs()...)...)
+	if err != nil {
+		logrus.Debugf("no version tag found, starting from 0.0.0: %v", err)
+	} else {
+		version, err = semver.ParseTolerant(t.trimPrefix(tag))
+		if err != nil {
+			return semver.Version{}, fmt.Errorf("latest version tag %q is not a semantic version: %w", tag, err)
+		}
+		revisions = tag + "..HEAD"
+	}
+
+	// Only the commits that changed the workspace are taken into account.
+	log, err := runGit(workspace, "log", "--format=%B%x00", revisions, "--", ".")
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("listing commits: %w", err)
+	}
+
+	var messages []string
+	for _, m := range strings.Split(log, "\x00") {
+		if m = strings.TrimSpace(m); m != "" {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 {
+		return version, nil
+	}
+
+	return bumpVersion(version, messages), nil
+}
+
+func (t *Semver) matchArgs() []string {
+	if t.tagPrefix == "" {
+		return []string{"--match", "v[0-9]*", "--match", "[0-9]*"}
+	}
+	return []string{"--match", t.tagPrefix + "[0-9]*"}
+}
+
+func (t *Semver) trimPrefix(tag string) string {
+	if t.tagPrefix == "" {
+		return strings.TrimPrefix(tag, "v")
+	}
+	return strings.TrimPrefix(tag, t.tagPrefix)
+}
+
+// bumpVersion derives the next version from conventional commit messages:
+// breaking changes bump the major version, features the minor version and anything else the patch version.
+func bumpVersion(version semver.Version, messages []string) semver.Version {
+	next := semver.Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch}
+
+	// The commits since a pre-release lead to its release.
+	if len(version.Pre) > 0 {
+		return next
+	}
+
+	switch commitsBump(messages) {
+	case bumpMajor:
+		next.Major++
+		next.Minor = 0
+		next.Patch = 0
+	case bumpMinor:
+		next.Minor++
+		next.Patch = 0
+	default:
+		next.Patch++
+	}
+	return next
+}
+
+func commitsBump(messages []string) bump {
+	b := bumpPatch
+	for _, m := range messages {
+		if breakingChange.MatchString(m) {
+			return bumpMajor
+		}
+
+		header := conventionalCommit.FindStringSubmatch(strings.SplitN(m, "\n", 2)[0])
+		switch {
+		case header == nil:
+		case header[3] == "!":
+			return bumpMajor
+		case strings.ToLower(header[1]) == "feat":
+			b = bumpMinor
+		}
+	}
+	return b
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	describeCmd = "git describe --abbrev=0 --match v[0-9]* --match [0-9]*"
+	statusCmd   = "git status . --porcelain"
+)
+
+func logCmd(revisions string) string {
+	return "git log --format=%B%x00 " + revisions + " -- ."
+}
+
+func TestSemver_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description string
+		tagger      latest_v1.SemverTagger
+		commands    *testutil.FakeCmd
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "tagged commit",
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "").
+				AndRunOut(statusCmd, ""),
+			expected: "1.2.3",
+		},
+		{
+			description: "fix since the tag",
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "fix: handle empty names\n\x00\ndocs: typo\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "1.2.4",
+		},
+		{
+			description: "feature since the tag",
+			commands: testutil.CmdRunOut(describeCmd, "1.2.3").
+				AndRunOut(logCmd("1.2.3..HEAD"), "fix(api): handle empty names\n\x00\nfeat(api): add a list endpoint\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "1.3.0",
+		},
+		{
+			description: "breaking change in the header",
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "feat!: drop the v1 API\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "2.0.0",
+		},
+		{
+			description: "breaking change in the footer",
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "refactor: rename the config\n\nBREAKING CHANGE: the config file is renamed\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "2.0.0",
+		},
+		{
+			description: "commits since a pre-release",
+			commands: testutil.CmdRunOut(describeCmd, "v2.0.0-rc.1").
+				AndRunOut(logCmd("v2.0.0-rc.1..HEAD"), "fix: crash on startup\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "2.0.0",
+		},
+		{
+			description: "no version tag",
+			commands: testutil.CmdRunOutErr(describeCmd, "", errors.New("no names found")).
+				AndRunOut(logCmd("HEAD"), "initial commit\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "0.0.1",
+		},
+		{
+			description: "tag prefix",
+			tagger:      latest_v1.SemverTagger{TagPrefix: "backend/v"},
+			commands: testutil.CmdRunOut("git describe --abbrev=0 --match backend/v[0-9]*", "backend/v0.4.0").
+				AndRunOut(logCmd("backend/v0.4.0..HEAD"), "feat: add metrics\n\x00").
+				AndRunOut(statusCmd, ""),
+			expected: "0.5.0",
+		},
+		{
+			description: "uncommitted changes",
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "").
+				AndRunOut(statusCmd, " M main.go"),
+			expected: "1.2.3-dirty",
+		},
+		{
+			description: "uncommitted changes with build metadata",
+			tagger:      latest_v1.SemverTagger{Prerelease: "dev", BuildMetadata: true},
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), "fix: typo\n\x00").
+				AndRunOut(statusCmd, " M main.go").
+				AndRunOut("git rev-list -1 HEAD --abbrev-commit", "eefe1b9"),
+			expected: "1.2.4-dev_eefe1b9",
+		},
+		{
+			description: "ignore changes",
+			tagger:      latest_v1.SemverTagger{IgnoreChanges: true},
+			commands: testutil.CmdRunOut(describeCmd, "v1.2.3").
+				AndRunOut(logCmd("v1.2.3..HEAD"), ""),
+			expected: "1.2.3",
+		},
+		{
+			description: "invalid version tag",
+			commands:    testutil.CmdRunOut(describeCmd, "v1.two"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			tagger, err := NewSemverTagger(&test.tagger)
+			t.CheckNoError(err)
+
+			tag, err := tagger.GenerateTag(latest_v1.Artifact{ImageName: "image", Workspace: "."})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestSemver_VersionFile(t *testing.T) {
+	tests := []struct {
+		description string
+		tagger      latest_v1.SemverTagger
+		files       map[string]string
+		commands    *testutil.FakeCmd
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "default version file",
+			files:       map[string]string{"VERSION": "1.4.0\n"},
+			commands:    testutil.CmdRunOut(statusCmd, ""),
+			expected:    "1.4.0",
+		},
+		{
+			description: "custom version file with uncommitted changes",
+			tagger:      latest_v1.SemverTagger{VersionFile: "version.txt"},
+			files:       map[string]string{"version.txt": "v2.1"},
+			commands:    testutil.CmdRunOut(statusCmd, "?? new.go"),
+			expected:    "2.1.0-dirty",
+		},
+		{
+			description: "not a git repository",
+			files:       map[string]string{"VERSION": "1.4.0"},
+			commands:    testutil.CmdRunOutErr(statusCmd, "", errors.New("not a git repository")),
+			expected:    "1.4.0",
+		},
+		{
+			description: "missing version file",
+			commands:    testutil.CmdRunOut(statusCmd, ""),
+			shouldErr:   true,
+		},
+		{
+			description: "invalid version",
+			files:       map[string]string{"VERSION": "latest"},
+			commands:    testutil.CmdRunOut(statusCmd, ""),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			t.Override(&util.DefaultExecCommand, test.commands)
+			test.tagger.Source = "versionFile"
+
+			tagger, err := NewSemverTagger(&test.tagger)
+			t.CheckNoError(err)
+
+			tag, err := tagger.GenerateTag(latest_v1.Artifact{ImageName: "image", Workspace: tmpDir.Root()})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestNewSemverTagger(t *testing.T) {
+	tests := []struct {
+		description string
+		tagger      latest_v1.SemverTagger
+		shouldErr   bool
+	}{
+		{description: "defaults"},
+		{description: "git tag source", tagger: latest_v1.SemverTagger{Source: "gitTag"}},
+		{description: "invalid source", tagger: latest_v1.SemverTagger{Source: "changelog"}, shouldErr: true},
+		{description: "invalid pre-release", tagger: latest_v1.SemverTagger{Prerelease: "not valid"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewSemverTagger(&test.tagger)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -73,6 +73,9 @@ func getTagger(runCtx *runcontext.RunContext, t *latest_v1.TagPolicy) (Tagger, e
 	case t.DateTimeTagger != nil:
 		return NewDateTimeTagger(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone), nil
 
+	case t.SemverTagger != nil:
+		return NewSemverTagger(t.SemverTagger)
+
 	case t.InputDigest != nil:
 		graph := graph.ToArtifactGraph(runCtx.Artifacts())
 		return NewInputDigestTagger(runCtx, graph)
@@ -115,6 +118,13 @@ func CreateComponents(runCtx *runcontext.RunContext, t *latest_v1.CustomTemplate
 		case c.DateTimeTagger != nil:
 			components[name] = NewDateTimeTagger(c.DateTimeTagger.Format, c.DateTimeTagger.TimeZone)
 
+		case c.SemverTagger != nil:
+			semverTagger, err := NewSemverTagger(c.SemverTagger)
+			if err != nil {
+				return nil, fmt.Errorf("creating semver component %s: %w", name, err)
+			}
+			components[name] = semverTagger
+
 		case c.InputDigest != nil:
 			graph := graph.ToArtifactGraph(runCtx.Artifacts())
 			inputDigest, _ := NewInputDigestTagger(runCtx, graph)
@@ -31,6 +31,7 @@ func TestCreateComponents(t *testing.T) {
 	digestExample, _ := NewInputDigestTagger(runCtx, graph.ToArtifactGraph(runCtx.Artifacts()))
 	gitExample, _ := NewGitCommit("", "", false)
 	envExample, _ := NewEnvTemplateTagger("test")
+	semverExample, _ := NewSemverTagger(&latest_v1.SemverTagger{})
 
 	tests := []struct {
 		description          string
@@ -47,6 +48,7 @@ func TestCreateComponents(t *testing.T) {
 					{Name: "BAR", Component: latest_v1.TagPolicy{EnvTemplateTagger: &latest_v1.EnvTemplateTagger{Template: "test"}}},
 					{Name: "BAT", Component: latest_v1.TagPolicy{DateTimeTagger: &latest_v1.DateTimeTagger{}}},
 					{Name: "BAS", Component: latest_v1.TagPolicy{InputDigest: &latest_v1.InputDigest{}}},
+					{Name: "VER", Component: latest_v1.TagPolicy{SemverTagger: &latest_v1.SemverTagger{}}},
 				},
 			},
 			expected: map[string]Tagger{
@@ -55,8 +57,18 @@ func TestCreateComponents(t *testing.T) {
 				"BAR": envExample,
 				"BAT": NewDateTimeTagger("", ""),
 				"BAS": digestExample,
+				"VER": semverExample,
 			},
 		},
+		{
+			description: "invalid semver component",
+			customTemplateTagger: &latest_v1.CustomTemplateTagger{
+				Components: []latest_v1.TaggerComponent{
+					{Name: "VER", Component: latest_v1.TagPolicy{SemverTagger: &latest_v1.SemverTagger{Source: "changelog"}}},
+				},
+			},
+			shouldErr: true,
+		},
 		{
 			description: "customTemplate is an invalid component",
 			customTemplateTagger: &latest_v1.CustomTemplateTagger{
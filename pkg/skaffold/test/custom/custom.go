@@ -17,6 +17,7 @@ limitations under the License.
 package custom
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -30,7 +31,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -41,30 +44,84 @@ var (
 
 const Windows string = "windows"
 
+// testType is the type of the custom tests in test reports.
+const testType = "custom"
+
+// Config contains the configuration needed to run custom tests.
+type Config interface {
+	docker.Config
+
+	TestReport() string
+}
+
 type Runner struct {
 	customTest latest_v1.CustomTest
 	imageName  string
 	workspace  string
+	reporting  bool
 }
 
 // New creates a new custom.Runner.
-func New(cfg docker.Config, imageName string, ws string, ct latest_v1.CustomTest) (*Runner, error) {
+func New(cfg Config, imageName string, ws string, ct latest_v1.CustomTest) (*Runner, error) {
 	return &Runner{
 		imageName:  imageName,
 		customTest: ct,
 		workspace:  ws,
+		reporting:  cfg.TestReport() != "",
 	}, nil
 }
 
+// TestName returns the name of a custom test, which defaults to its command.
+func TestName(ct latest_v1.CustomTest) string {
+	if ct.Name != "" {
+		return ct.Name
+	}
+	return ct.Command
+}
+
 // Test is the entrypoint for running custom tests
-func (ct *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
+func (ct *Runner) Test(ctx context.Context, out io.Writer, imageTag string) ([]report.Result, error) {
+	result := report.Result{
+		Image:  ct.imageName,
+		Type:   testType,
+		Name:   TestName(ct.customTest),
+		Status: report.Passed,
+	}
+
+	// Keep a copy of the output for the test report.
+	var output bytes.Buffer
+	if ct.reporting {
+		out = io.MultiWriter(out, &output)
+	}
+
 	event.TestInProgress()
-	if err := ct.runCustomTest(ctx, out, imageTag); err != nil {
+	eventV2.TestInProgress(result.ID())
+	start := time.Now()
+	err := ct.runWithRetries(ctx, out, imageTag)
+	result.Duration = time.Since(start)
+	result.Output = output.String()
+
+	if err != nil {
+		result.Status = report.Failed
+		result.Failure = err.Error()
 		event.TestFailed(ct.imageName, err)
-		return err
+		eventV2.TestFailed(result.ID(), err)
+		return []report.Result{result}, err
 	}
 	event.TestComplete()
-	return nil
+	eventV2.TestSucceeded(result.ID())
+	return []report.Result{result}, nil
+}
+
+func (ct *Runner) runWithRetries(ctx context.Context, out io.Writer, imageTag string) error {
+	retries := ct.customTest.Retries
+	for attempt := 1; ; attempt++ {
+		err := ct.runCustomTest(ctx, out, imageTag)
+		if err == nil || attempt > retries || ctx.Err() != nil {
+			return err
+		}
+		color.Yellow.Fprintf(out, "Retrying custom test %q (retry %d of %d)\n", TestName(ct.customTest), attempt, retries)
+	}
 }
 
 func (ct *Runner) runCustomTest(ctx context.Context, out io.Writer, imageTag string) error {
@@ -62,7 +62,7 @@ func TestNewCustomTestRunner(t *testing.T) {
 
 		testRunner, err := New(cfg, testCase.ImageName, testCase.Workspace, custom)
 		t.CheckNoError(err)
-		err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
+		_, err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
 
 		t.CheckNoError(err)
 	})
@@ -121,7 +121,7 @@ func TestCustomCommandError(t *testing.T) {
 
 			testRunner, err := New(cfg, testCase.ImageName, testCase.Workspace, test.custom)
 			t.CheckNoError(err)
-			err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
+			_, err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
 
 			// TODO(modali): Update the logic to check for error code instead of error string.
 			t.CheckError(test.shouldErr, err)
@@ -132,6 +132,55 @@ func TestCustomCommandError(t *testing.T) {
 	}
 }
 
+func TestCustomCommandRetries(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    func(command string) *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "succeeds after a retry",
+			commands: func(command string) *testutil.FakeCmd {
+				return testutil.CmdRunErr(command, fmt.Errorf("exit status 1")).AndRun(command)
+			},
+		},
+		{
+			description: "fails every attempt",
+			commands: func(command string) *testutil.FakeCmd {
+				return testutil.CmdRunErr(command, fmt.Errorf("exit status 1")).AndRunErr(command, fmt.Errorf("exit status 1"))
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			command := "sh -c ./flaky.sh"
+			if runtime.GOOS == Windows {
+				command = "cmd.exe /C ./flaky.sh"
+			}
+			fakeCmd := test.commands(command)
+			t.Override(&util.DefaultExecCommand, fakeCmd)
+
+			custom := latest_v1.CustomTest{
+				Name:    "flaky",
+				Command: "./flaky.sh",
+				Retries: 1,
+			}
+			cfg := &mockConfig{}
+			testEvent.InitializeState([]latest_v1.Pipeline{{}})
+
+			testRunner, err := New(cfg, "image", tmpDir.Root(), custom)
+			t.CheckNoError(err)
+			results, err := testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(2, fakeCmd.TimesCalled())
+			t.CheckDeepEqual("flaky", results[0].Name)
+		})
+	}
+}
+
 func TestTestDependenciesCommand(t *testing.T) {
 	testutil.Run(t, "Testing new custom test runner", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Touch("test.yaml")
@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// JSONFile is the name of the JSON report.
+	JSONFile = "test-report.json"
+	// JUnitFile is the name of the JUnit XML report.
+	JUnitFile = "test-report.xml"
+)
+
+// Status is the status of a test.
+type Status string
+
+const (
+	Passed Status = "passed"
+	Failed Status = "failed"
+)
+
+// Result is the result of a single test of an image.
+type Result struct {
+	// Image is the name of the tested artifact.
+	Image string
+	// Type is the kind of test, `structure` or `custom`.
+	Type     string
+	Name     string
+	Status   Status
+	Duration time.Duration
+	// Output is the output captured while the test ran.
+	Output string
+	// Failure describes why the test failed.
+	Failure string
+}
+
+// ID identifies the test in events.
+func (r Result) ID() string {
+	return fmt.Sprintf("%s/%s", r.Image, r.Name)
+}
+
+// Write writes the JSON and JUnit XML reports of the results to a directory.
+func Write(dir string, results []Result) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating test report directory: %w", err)
+	}
+
+	jsonReport, err := json.MarshalIndent(toJSON(results), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, JSONFile), jsonReport, 0644); err != nil {
+		return fmt.Errorf("writing JSON test report: %w", err)
+	}
+
+	junitReport, err := xml.MarshalIndent(toJUnit(results), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, JUnitFile), append([]byte(xml.Header), junitReport...), 0644); err != nil {
+		return fmt.Errorf("writing JUnit test report: %w", err)
+	}
+
+	return nil
+}
+
+// byImage groups the results by image, in the order the images were tested.
+func byImage(results []Result) ([]string, map[string][]Result) {
+	var images []string
+	grouped := map[string][]Result{}
+	for _, r := range results {
+		if _, found := grouped[r.Image]; !found {
+			images = append(images, r.Image)
+		}
+		grouped[r.Image] = append(grouped[r.Image], r)
+	}
+	return images, grouped
+}
+
+func summarize(results []Result) (failures int, duration time.Duration) {
+	for _, r := range results {
+		if r.Status == Failed {
+			failures++
+		}
+		duration += r.Duration
+	}
+	return
+}
+
+type jsonReport struct {
+	Total           int         `json:"total"`
+	Passed          int         `json:"passed"`
+	Failed          int         `json:"failed"`
+	DurationSeconds float64     `json:"durationSeconds"`
+	Images          []jsonImage `json:"images"`
+}
+
+type jsonImage struct {
+	Image string     `json:"image"`
+	Tests []jsonTest `json:"tests"`
+}
+
+type jsonTest struct {
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Status          Status  `json:"status"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	Output          string  `json:"output,omitempty"`
+	Failure         string  `json:"failure,omitempty"`
+}
+
+func toJSON(results []Result) jsonReport {
+	failures, duration := summarize(results)
+	report := jsonReport{
+		Total:           len(results),
+		Passed:          len(results) - failures,
+		Failed:          failures,
+		DurationSeconds: duration.Seconds(),
+		Images:          []jsonImage{},
+	}
+
+	images, grouped := byImage(results)
+	for _, image := range images {
+		var tests []jsonTest
+		for _, r := range grouped[image] {
+			tests = append(tests, jsonTest{
+				Name:            r.Name,
+				Type:            r.Type,
+				Status:          r.Status,
+				DurationSeconds: r.Duration.Seconds(),
+				Output:          r.Output,
+				Failure:         r.Failure,
+			})
+		}
+		report.Images = append(report.Images, jsonImage{Image: image, Tests: tests})
+	}
+	return report
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func toJUnit(results []Result) junitTestSuites {
+	failures, duration := summarize(results)
+	report := junitTestSuites{
+		Name:     "skaffold",
+		Tests:    len(results),
+		Failures: failures,
+		Time:     seconds(duration),
+	}
+
+	images, grouped := byImage(results)
+	for _, image := range images {
+		failures, duration := summarize(grouped[image])
+		suite := junitTestSuite{
+			Name:     image,
+			Tests:    len(grouped[image]),
+			Failures: failures,
+			Time:     seconds(duration),
+		}
+		for _, r := range grouped[image] {
+			testCase := junitTestCase{
+				Name:      r.Name,
+				Classname: fmt.Sprintf("%s.%s", image, r.Type),
+				Time:      seconds(r.Duration),
+				SystemOut: r.Output,
+			}
+			if r.Status == Failed {
+				testCase.Failure = &junitFailure{Message: r.Failure, Text: r.Failure}
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, suite)
+	}
+	return report
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
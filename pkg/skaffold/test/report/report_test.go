@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var results = []Result{
+	{Image: "app", Type: "structure", Name: "Command Test: go version", Status: Passed, Duration: time.Second, Output: "go1.15"},
+	{Image: "app", Type: "custom", Name: "./test.sh", Status: Failed, Duration: 2 * time.Second, Failure: "exit status 1"},
+	{Image: "web", Type: "custom", Name: "npm test", Status: Passed, Duration: 500 * time.Millisecond},
+}
+
+func TestWriteJSON(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		err := Write(dir.Root(), results)
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(filepath.Join(dir.Root(), JSONFile))
+		t.CheckNoError(err)
+
+		var report jsonReport
+		t.CheckNoError(json.Unmarshal(content, &report))
+		t.CheckDeepEqual(jsonReport{
+			Total:           3,
+			Passed:          2,
+			Failed:          1,
+			DurationSeconds: 3.5,
+			Images: []jsonImage{
+				{Image: "app", Tests: []jsonTest{
+					{Name: "Command Test: go version", Type: "structure", Status: Passed, DurationSeconds: 1, Output: "go1.15"},
+					{Name: "./test.sh", Type: "custom", Status: Failed, DurationSeconds: 2, Failure: "exit status 1"},
+				}},
+				{Image: "web", Tests: []jsonTest{
+					{Name: "npm test", Type: "custom", Status: Passed, DurationSeconds: 0.5},
+				}},
+			},
+		}, report)
+	})
+}
+
+func TestWriteJUnit(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		err := Write(dir.Root(), results)
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(filepath.Join(dir.Root(), JUnitFile))
+		t.CheckNoError(err)
+
+		report := string(content)
+		t.CheckContains(`<testsuites name="skaffold" tests="3" failures="1" time="3.500">`, report)
+		t.CheckContains(`<testsuite name="app" tests="2" failures="1" time="3.000">`, report)
+		t.CheckContains(`<testcase name="Command Test: go version" classname="app.structure" time="1.000">`, report)
+		t.CheckContains(`<system-out>go1.15</system-out>`, report)
+		t.CheckContains(`<failure message="exit status 1">exit status 1</failure>`, report)
+		t.CheckContains(`<testsuite name="web" tests="1" failures="0" time="0.500">`, report)
+	})
+}
+
+func TestWriteNoResults(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		err := Write(dir.Root(), nil)
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(filepath.Join(dir.Root(), JSONFile))
+		t.CheckNoError(err)
+		t.CheckContains(`"images": []`, string(content))
+	})
+}
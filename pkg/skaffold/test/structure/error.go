@@ -49,3 +49,21 @@ func dockerPullImageErr(fqn string, err error) error {
 		},
 	)
 }
+
+func readingConfigErr(file string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("reading structure test file %s: %s", file, err),
+			ErrCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	)
+}
+
+func remoteImageErr(fqn string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("unable to read image %s from its registry: %s", fqn, err),
+			ErrCode: proto.StatusCode_TEST_IMG_PULL_ERR,
+		},
+	)
+}
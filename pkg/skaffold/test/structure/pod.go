@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package structure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+const (
+	structureTestLabel = "skaffold.dev/structure-test"
+	testContainerName  = "test"
+)
+
+var (
+	// For testing
+	getClient       = kubernetesclient.Client
+	podPollInterval = time.Second
+	podTimeout      = 5 * time.Minute
+)
+
+// runCommandTest runs a command test in a short-lived pod and checks its exit code and logs.
+// Kubernetes doesn't separate stdout from stderr, so the expected and excluded errors
+// are checked against the same logs as the output.
+func (cst *Runner) runCommandTest(ctx context.Context, imageTag string, t cstCommandTest, env []cstEnvVar) (string, []string) {
+	if len(t.Setup) > 0 || len(t.Teardown) > 0 {
+		return "", []string{"setup and teardown commands are not supported in remote mode"}
+	}
+
+	output, exitCode, err := cst.runPod(ctx, imageTag, t, env)
+	if err != nil {
+		return output, []string{err.Error()}
+	}
+
+	var errs []string
+	if exitCode != t.ExitCode {
+		errs = append(errs, fmt.Sprintf("Test %q exited with incorrect error code. Expected: %d, Actual: %d", t.Name, t.ExitCode, exitCode))
+	}
+	errs = append(errs, checkOutput(output, t.ExpectedOutput, t.ExcludedOutput, "Expected string %q not found in output", "Excluded string %q found in output")...)
+	errs = append(errs, checkOutput(output, t.ExpectedError, t.ExcludedError, "Expected string %q not found in error", "Excluded string %q found in error")...)
+	return output, errs
+}
+
+func (cst *Runner) runPod(ctx context.Context, imageTag string, t cstCommandTest, env []cstEnvVar) (string, int, error) {
+	client, err := getClient()
+	if err != nil {
+		return "", 0, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, podTimeout)
+	defer cancel()
+
+	pods := client.CoreV1().Pods(cst.namespace())
+	pod, err := pods.Create(ctx, commandPod(imageTag, t, env), metav1.CreateOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("creating pod: %w", err)
+	}
+	defer func() {
+		if err := pods.Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			logrus.Debugf("unable to delete pod %s: %v", pod.Name, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", 0, fmt.Errorf("waiting for pod %s: %w", pod.Name, ctx.Err())
+		case <-time.After(podPollInterval):
+		}
+
+		current, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", 0, fmt.Errorf("getting pod %s: %w", pod.Name, err)
+		}
+
+		for _, s := range current.Status.ContainerStatuses {
+			if s.Name != testContainerName {
+				continue
+			}
+			if w := s.State.Waiting; w != nil {
+				switch w.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+					return "", 0, fmt.Errorf("starting pod %s: %s: %s", pod.Name, w.Reason, w.Message)
+				}
+			}
+			if terminated := s.State.Terminated; terminated != nil {
+				logs, err := pods.GetLogs(pod.Name, &v1.PodLogOptions{Container: testContainerName}).DoRaw(ctx)
+				if err != nil {
+					return "", 0, fmt.Errorf("getting logs of pod %s: %w", pod.Name, err)
+				}
+				return string(logs), int(terminated.ExitCode), nil
+			}
+		}
+	}
+}
+
+func (cst *Runner) namespace() string {
+	if ns := cst.cfg.GetKubeNamespace(); ns != "" {
+		return ns
+	}
+	return "default"
+}
+
+func commandPod(imageTag string, t cstCommandTest, env []cstEnvVar) *v1.Pod {
+	var envVars []v1.EnvVar
+	for _, e := range env {
+		envVars = append(envVars, v1.EnvVar{Name: e.Key, Value: e.Value})
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "structure-test-",
+			Labels:       map[string]string{structureTestLabel: "true"},
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+			Containers: []v1.Container{{
+				Name:    testContainerName,
+				Image:   imageTag,
+				Command: []string{t.Command},
+				Args:    t.Args,
+				Env:     envVars,
+			}},
+		},
+	}
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package structure
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// maxSymlinks is the maximum number of symbolic links followed when resolving a path.
+const maxSymlinks = 40
+
+var (
+	// For testing
+	remoteImage = docker.RemoteImage
+)
+
+// cstConfig is a `container-structure-test` configuration file.
+type cstConfig struct {
+	GlobalEnvVars      []cstEnvVar            `yaml:"globalEnvVars"`
+	CommandTests       []cstCommandTest       `yaml:"commandTests"`
+	FileExistenceTests []cstFileExistenceTest `yaml:"fileExistenceTests"`
+	FileContentTests   []cstFileContentTest   `yaml:"fileContentTests"`
+	MetadataTest       *cstMetadataTest       `yaml:"metadataTest"`
+	LicenseTests       []interface{}          `yaml:"licenseTests"`
+}
+
+type cstEnvVar struct {
+	Key     string `yaml:"key"`
+	Value   string `yaml:"value"`
+	IsRegex bool   `yaml:"isRegex"`
+}
+
+type cstCommandTest struct {
+	Name           string      `yaml:"name"`
+	Setup          [][]string  `yaml:"setup"`
+	Teardown       [][]string  `yaml:"teardown"`
+	EnvVars        []cstEnvVar `yaml:"envVars"`
+	Command        string      `yaml:"command"`
+	Args           []string    `yaml:"args"`
+	ExpectedOutput []string    `yaml:"expectedOutput"`
+	ExcludedOutput []string    `yaml:"excludedOutput"`
+	ExpectedError  []string    `yaml:"expectedError"`
+	ExcludedError  []string    `yaml:"excludedError"`
+	ExitCode       int         `yaml:"exitCode"`
+}
+
+type cstFileExistenceTest struct {
+	Name           string `yaml:"name"`
+	Path           string `yaml:"path"`
+	ShouldExist    *bool  `yaml:"shouldExist"`
+	Permissions    string `yaml:"permissions"`
+	UID            *int   `yaml:"uid"`
+	GID            *int   `yaml:"gid"`
+	IsExecutableBy string `yaml:"isExecutableBy"`
+}
+
+type cstFileContentTest struct {
+	Name             string   `yaml:"name"`
+	Path             string   `yaml:"path"`
+	ExpectedContents []string `yaml:"expectedContents"`
+	ExcludedContents []string `yaml:"excludedContents"`
+}
+
+type cstMetadataTest struct {
+	Env              []cstEnvVar `yaml:"env"`
+	Labels           []cstEnvVar `yaml:"labels"`
+	ExposedPorts     []string    `yaml:"exposedPorts"`
+	UnexposedPorts   []string    `yaml:"unexposedPorts"`
+	Volumes          []string    `yaml:"volumes"`
+	UnmountedVolumes []string    `yaml:"unmountedVolumes"`
+	Entrypoint       *[]string   `yaml:"entrypoint"`
+	Cmd              *[]string   `yaml:"cmd"`
+	Workdir          string      `yaml:"workdir"`
+	User             string      `yaml:"user"`
+}
+
+func readConfig(file string) (*cstConfig, error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg cstConfig
+	if err := yaml.Unmarshal(buf, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// runRemoteTests evaluates the structure tests without a Docker daemon: file and metadata tests
+// are checked against the image's registry layers and command tests run in a Kubernetes pod.
+func (cst *Runner) runRemoteTests(ctx context.Context, out io.Writer, imageTag string) ([]report.Result, error) {
+	files, err := cst.TestDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []*cstConfig
+	var contentPaths []string
+	for _, f := range files {
+		cfg, err := readConfig(f)
+		if err != nil {
+			return nil, readingConfigErr(f, err)
+		}
+		configs = append(configs, cfg)
+		for _, t := range cfg.FileContentTests {
+			contentPaths = append(contentPaths, t.Path)
+		}
+	}
+
+	color.Default.Fprintf(out, "Running structure tests for %s from its registry\n", imageTag)
+
+	img, err := remoteImage(imageTag, nil, cst.cfg)
+	if err != nil {
+		return nil, remoteImageErr(imageTag, err)
+	}
+	configFile, err := img.ConfigFile()
+	if err != nil {
+		return nil, remoteImageErr(imageTag, err)
+	}
+
+	var fs *imageFS
+	for _, cfg := range configs {
+		if len(cfg.FileExistenceTests) > 0 || len(cfg.FileContentTests) > 0 {
+			if fs, err = loadFS(img, contentPaths); err != nil {
+				return nil, remoteImageErr(imageTag, err)
+			}
+			break
+		}
+	}
+
+	var results []report.Result
+	run := func(name string, test func() (string, []string)) {
+		start := time.Now()
+		output, errs := test()
+		result := cst.result(report.Passed, time.Since(start), output, "")
+		result.Name = name
+
+		fmt.Fprintf(out, "=== RUN: %s\n", name)
+		if len(errs) > 0 {
+			result.Status = report.Failed
+			result.Failure = strings.Join(errs, "\n")
+			fmt.Fprintln(out, "--- FAIL")
+			for _, e := range errs {
+				fmt.Fprintf(out, "Error: %s\n", e)
+			}
+		} else {
+			fmt.Fprintln(out, "--- PASS")
+		}
+		results = append(results, result)
+	}
+
+	for _, cfg := range configs {
+		for _, t := range cfg.FileExistenceTests {
+			t := t
+			run("File Existence Test: "+t.Name, func() (string, []string) { return "", fs.checkExistence(t) })
+		}
+		for _, t := range cfg.FileContentTests {
+			t := t
+			run("File Content Test: "+t.Name, func() (string, []string) { return "", fs.checkContent(t) })
+		}
+		if cfg.MetadataTest != nil {
+			run("Metadata Test", func() (string, []string) { return "", checkMetadata(*cfg.MetadataTest, configFile.Config) })
+		}
+		for _, t := range cfg.CommandTests {
+			t := t
+			env := append(append([]cstEnvVar{}, cfg.GlobalEnvVars...), t.EnvVars...)
+			run("Command Test: "+t.Name, func() (string, []string) { return cst.runCommandTest(ctx, imageTag, t, env) })
+		}
+		for range cfg.LicenseTests {
+			run("License Test", func() (string, []string) {
+				return "", []string{"license tests are not supported in remote mode"}
+			})
+		}
+	}
+
+	var failures int
+	for _, r := range results {
+		if r.Status == report.Failed {
+			failures++
+		}
+	}
+	fmt.Fprintf(out, "Passes: %d, Failures: %d, Total tests: %d\n", len(results)-failures, failures, len(results))
+
+	if failures > 0 {
+		return results, fmt.Errorf("%d of %d structure tests failed for %s", failures, len(results), cst.imageName)
+	}
+	return results, nil
+}
+
+// imageFS is the flattened filesystem of an image. Only the content of the files that are tested is kept.
+type imageFS struct {
+	entries  map[string]*tar.Header
+	dirs     map[string]bool
+	contents map[string][]byte
+}
+
+func loadFS(img v1.Image, contentPaths []string) (*imageFS, error) {
+	fs := &imageFS{
+		entries:  map[string]*tar.Header{},
+		dirs:     map[string]bool{"/": true},
+		contents: map[string][]byte{},
+	}
+
+	wanted := map[string]bool{}
+	for _, p := range contentPaths {
+		wanted[cleanPath(p)] = true
+	}
+	if err := fs.extract(img, wanted); err != nil {
+		return nil, err
+	}
+
+	// The tested files might be links to files that weren't read.
+	missing := map[string]bool{}
+	for p := range wanted {
+		if target, found := fs.target(p); found {
+			if _, read := fs.contents[target]; !read {
+				missing[target] = true
+			}
+		}
+	}
+	if len(missing) > 0 {
+		if err := fs.extract(img, missing); err != nil {
+			return nil, err
+		}
+	}
+
+	return fs, nil
+}
+
+func (fs *imageFS) extract(img v1.Image, wanted map[string]bool) error {
+	rc := mutate.Extract(img)
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading image filesystem: %w", err)
+		}
+
+		p := cleanPath(hdr.Name)
+		fs.entries[p] = hdr
+		for dir := path.Dir(p); !fs.dirs[dir]; dir = path.Dir(dir) {
+			fs.dirs[dir] = true
+		}
+
+		if wanted[p] && (hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA) {
+			content, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", p, err)
+			}
+			fs.contents[p] = content
+		}
+	}
+}
+
+// resolve follows the symbolic links in every component of the path.
+func (fs *imageFS) resolve(p string) (string, bool) {
+	p = cleanPath(p)
+	for hops := 0; hops < maxSymlinks; hops++ {
+		parts := strings.Split(strings.TrimPrefix(p, "/"), "/")
+		current := "/"
+		followed := false
+		for i, part := range parts {
+			next := path.Join(current, part)
+			if hdr, found := fs.entries[next]; found && hdr.Typeflag == tar.TypeSymlink {
+				target := hdr.Linkname
+				if !path.IsAbs(target) {
+					target = path.Join(current, target)
+				}
+				p = cleanPath(path.Join(append([]string{target}, parts[i+1:]...)...))
+				followed = true
+				break
+			}
+			current = next
+		}
+		if !followed {
+			return p, true
+		}
+	}
+	return p, false
+}
+
+// target returns the path of the regular file holding the content of a path.
+func (fs *imageFS) target(p string) (string, bool) {
+	resolved, ok := fs.resolve(p)
+	if !ok {
+		return "", false
+	}
+	hdr, found := fs.entries[resolved]
+	if !found {
+		return "", false
+	}
+	if hdr.Typeflag == tar.TypeLink {
+		return cleanPath(hdr.Linkname), true
+	}
+	return resolved, true
+}
+
+func (fs *imageFS) stat(p string) (*tar.Header, bool) {
+	resolved, ok := fs.resolve(p)
+	if !ok {
+		return nil, false
+	}
+	if hdr, found := fs.entries[resolved]; found {
+		return hdr, true
+	}
+	if fs.dirs[resolved] {
+		// Parent directories are not always in the layers.
+		return &tar.Header{Name: resolved, Typeflag: tar.TypeDir, Mode: 0755}, true
+	}
+	return nil, false
+}
+
+func (fs *imageFS) checkExistence(t cstFileExistenceTest) []string {
+	hdr, exists := fs.stat(t.Path)
+	shouldExist := t.ShouldExist == nil || *t.ShouldExist
+	switch {
+	case shouldExist && !exists:
+		return []string{fmt.Sprintf("File %s should exist but does not", t.Path)}
+	case !shouldExist && exists:
+		return []string{fmt.Sprintf("File %s should not exist but does", t.Path)}
+	case !exists:
+		return nil
+	}
+
+	var errs []string
+	mode := hdr.FileInfo().Mode()
+	if t.Permissions != "" && mode.String() != t.Permissions {
+		errs = append(errs, fmt.Sprintf("%s has incorrect permissions. Expected: %s, Actual: %s", t.Path, t.Permissions, mode.String()))
+	}
+	if t.UID != nil && hdr.Uid != *t.UID {
+		errs = append(errs, fmt.Sprintf("%s has incorrect user ownership. Expected: %d, Actual: %d", t.Path, *t.UID, hdr.Uid))
+	}
+	if t.GID != nil && hdr.Gid != *t.GID {
+		errs = append(errs, fmt.Sprintf("%s has incorrect group ownership. Expected: %d, Actual: %d", t.Path, *t.GID, hdr.Gid))
+	}
+	if t.IsExecutableBy != "" {
+		bits := map[string]int64{"any": 0111, "owner": 0100, "group": 0010, "other": 0001}[t.IsExecutableBy]
+		if bits == 0 {
+			errs = append(errs, fmt.Sprintf("unknown isExecutableBy value %q: must be `any`, `owner`, `group` or `other`", t.IsExecutableBy))
+		} else if hdr.Mode&bits == 0 {
+			errs = append(errs, fmt.Sprintf("%s is not executable by %s", t.Path, t.IsExecutableBy))
+		}
+	}
+	return errs
+}
+
+func (fs *imageFS) checkContent(t cstFileContentTest) []string {
+	target, found := fs.target(t.Path)
+	if !found {
+		return []string{fmt.Sprintf("File %s does not exist", t.Path)}
+	}
+	content, read := fs.contents[target]
+	if !read {
+		return []string{fmt.Sprintf("%s is not a regular file", t.Path)}
+	}
+
+	return checkOutput(string(content), t.ExpectedContents, t.ExcludedContents, "Expected string %q not found in file content", "Excluded string %q found in file content")
+}
+
+func checkOutput(output string, expected, excluded []string, expectedMsg, excludedMsg string) []string {
+	var errs []string
+	for _, e := range expected {
+		if matched, err := regexp.MatchString(e, output); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid regex %q: %s", e, err))
+		} else if !matched {
+			errs = append(errs, fmt.Sprintf(expectedMsg, e))
+		}
+	}
+	for _, e := range excluded {
+		if matched, err := regexp.MatchString(e, output); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid regex %q: %s", e, err))
+		} else if matched {
+			errs = append(errs, fmt.Sprintf(excludedMsg, e))
+		}
+	}
+	return errs
+}
+
+func checkMetadata(t cstMetadataTest, cfg v1.Config) []string {
+	var errs []string
+
+	env := map[string]string{}
+	for _, e := range cfg.Env {
+		kv := strings.SplitN(e, "=", 2)
+		if len(kv) == 2 {
+			env[kv[0]] = kv[1]
+		}
+	}
+	errs = append(errs, checkPairs("env var", t.Env, env)...)
+	errs = append(errs, checkPairs("label", t.Labels, cfg.Labels)...)
+
+	ports := map[string]bool{}
+	for p := range cfg.ExposedPorts {
+		ports[p] = true
+		ports[strings.SplitN(p, "/", 2)[0]] = true
+	}
+	for _, p := range t.ExposedPorts {
+		if !ports[p] {
+			errs = append(errs, fmt.Sprintf("Port %s not found in config", p))
+		}
+	}
+	for _, p := range t.UnexposedPorts {
+		if ports[p] {
+			errs = append(errs, fmt.Sprintf("Port %s should not be exposed", p))
+		}
+	}
+
+	for _, v := range t.Volumes {
+		if _, found := cfg.Volumes[v]; !found {
+			errs = append(errs, fmt.Sprintf("Volume %s not found in config", v))
+		}
+	}
+	for _, v := range t.UnmountedVolumes {
+		if _, found := cfg.Volumes[v]; found {
+			errs = append(errs, fmt.Sprintf("Volume %s should not be mounted", v))
+		}
+	}
+
+	if t.Entrypoint != nil && !equal(*t.Entrypoint, cfg.Entrypoint) {
+		errs = append(errs, fmt.Sprintf("Image entrypoint %q does not match expected entrypoint: %q", cfg.Entrypoint, *t.Entrypoint))
+	}
+	if t.Cmd != nil && !equal(*t.Cmd, cfg.Cmd) {
+		errs = append(errs, fmt.Sprintf("Image cmd %q does not match expected cmd: %q", cfg.Cmd, *t.Cmd))
+	}
+	if t.Workdir != "" && t.Workdir != cfg.WorkingDir {
+		errs = append(errs, fmt.Sprintf("Image workdir %s does not match expected workdir: %s", cfg.WorkingDir, t.Workdir))
+	}
+	if t.User != "" && t.User != cfg.User {
+		errs = append(errs, fmt.Sprintf("Image user %s does not match expected user: %s", cfg.User, t.User))
+	}
+
+	return errs
+}
+
+func checkPairs(kind string, expected []cstEnvVar, actual map[string]string) []string {
+	var errs []string
+	for _, e := range expected {
+		value, found := actual[e.Key]
+		switch {
+		case !found:
+			errs = append(errs, fmt.Sprintf("%s %s not found in config", kind, e.Key))
+		case e.IsRegex:
+			if matched, err := regexp.MatchString(e.Value, value); err != nil || !matched {
+				errs = append(errs, fmt.Sprintf("%s %s value %q does not match expected value: %q", kind, e.Key, value, e.Value))
+			}
+		case value != e.Value:
+			errs = append(errs, fmt.Sprintf("%s %s value %q does not match expected value: %q", kind, e.Key, value, e.Value))
+		}
+	}
+	return errs
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package structure
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+const remoteTests = `schemaVersion: 2.0.0
+fileExistenceTests:
+- name: app
+  path: /bin/app
+  permissions: -rwxr-xr-x
+  isExecutableBy: any
+- name: no shell
+  path: /bin/sh
+  shouldExist: false
+- name: config owner
+  path: /etc/app
+  uid: 1000
+fileContentTests:
+- name: config
+  path: /etc/app/config.yaml
+  expectedContents: ['port: \d+']
+  excludedContents: ['debug: true']
+metadataTest:
+  env:
+  - key: MODE
+    value: production
+  exposedPorts: ["8080"]
+  entrypoint: ["/bin/app"]
+commandTests:
+- name: version
+  command: /bin/app
+  args: ["--version"]
+  expectedOutput: ["fake logs"]
+`
+
+func TestRemoteTests(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("test.yaml", remoteTests)
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return nil, errors.New("no Docker daemon")
+		})
+		t.Override(&remoteImage, func(string, *v1.Platform, docker.Config) (v1.Image, error) {
+			return testImage(t), nil
+		})
+		t.Override(&podPollInterval, 0)
+
+		client := fakekubeclientset.NewSimpleClientset()
+		client.PrependReactor("create", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "structure-test-abcde"}}, nil
+		})
+		client.PrependReactor("get", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  testContainerName,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}},
+			}}}}, nil
+		})
+		t.Override(&getClient, func() (kubernetes.Interface, error) { return client, nil })
+		testEvent.InitializeState([]latest_v1.Pipeline{{}})
+
+		testRunner, err := New(&mockConfig{}, &latest_v1.TestCase{
+			ImageName:          "image",
+			Workspace:          tmpDir.Root(),
+			StructureTests:     []string{"test.yaml"},
+			StructureTestsMode: "remote",
+		}, false)
+		t.CheckNoError(err)
+
+		var out bytes.Buffer
+		results, err := testRunner.Test(context.Background(), &out, "registry.io/image:tag")
+
+		t.CheckErrorContains("1 of 6 structure tests failed for image", err)
+		t.CheckContains("Passes: 5, Failures: 1, Total tests: 6", out.String())
+
+		statuses := map[string]report.Status{}
+		for _, r := range results {
+			statuses[r.Name] = r.Status
+		}
+		t.CheckDeepEqual(map[string]report.Status{
+			"File Existence Test: app":          report.Passed,
+			"File Existence Test: no shell":     report.Passed,
+			"File Existence Test: config owner": report.Failed,
+			"File Content Test: config":         report.Passed,
+			"Metadata Test":                     report.Passed,
+			"Command Test: version":             report.Passed,
+		}, statuses)
+	})
+}
+
+func TestRemoteTestsUnsupportedSetup(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		_, errs := (&Runner{}).runCommandTest(context.Background(), "image:tag", cstCommandTest{Name: "setup", Setup: [][]string{{"touch", "/tmp/a"}}}, nil)
+
+		t.CheckDeepEqual([]string{"setup and teardown commands are not supported in remote mode"}, errs)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	fs := &imageFS{entries: map[string]*tar.Header{
+		"/bin":          {Typeflag: tar.TypeSymlink, Linkname: "usr/bin"},
+		"/usr/bin/app":  {Typeflag: tar.TypeReg},
+		"/usr/bin/link": {Typeflag: tar.TypeSymlink, Linkname: "/usr/bin/app"},
+		"/loop":         {Typeflag: tar.TypeSymlink, Linkname: "/loop"},
+	}}
+
+	tests := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "/usr/bin/app", expected: "/usr/bin/app", ok: true},
+		{path: "/bin/app", expected: "/usr/bin/app", ok: true},
+		{path: "/bin/link", expected: "/usr/bin/app", ok: true},
+		{path: "bin/../bin/app", expected: "/usr/bin/app", ok: true},
+		{path: "/loop", ok: false},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.path, func(t *testutil.T) {
+			resolved, ok := fs.resolve(test.path)
+
+			t.CheckDeepEqual(test.ok, ok)
+			if test.ok {
+				t.CheckDeepEqual(test.expected, resolved)
+			}
+		})
+	}
+}
+
+func testImage(t *testutil.T) v1.Image {
+	files := []struct {
+		hdr     tar.Header
+		content string
+	}{
+		{hdr: tar.Header{Name: "bin", Typeflag: tar.TypeSymlink, Linkname: "usr/bin"}},
+		{hdr: tar.Header{Name: "usr/bin/app", Typeflag: tar.TypeReg, Mode: 0755}, content: "binary"},
+		{hdr: tar.Header{Name: "etc/app/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "etc/app/config.yaml", Typeflag: tar.TypeReg, Mode: 0644}, content: "port: 8080\n"},
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		hdr := f.hdr
+		hdr.Size = int64(len(f.content))
+		t.CheckNoError(tw.WriteHeader(&hdr))
+		_, err := tw.Write([]byte(f.content))
+		t.CheckNoError(err)
+	}
+	t.CheckNoError(tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	t.CheckNoError(err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	t.CheckNoError(err)
+	img, err = mutate.Config(img, v1.Config{
+		Env:          []string{"MODE=production"},
+		ExposedPorts: map[string]struct{}{"8080/tcp": {}},
+		Entrypoint:   []string{"/bin/app"},
+	})
+	t.CheckNoError(err)
+	return img
+}
@@ -18,85 +18,204 @@ package structure
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
+// testType is the type of the structure tests in test reports.
+const testType = "structure"
+
+// Config contains the configuration needed to run structure tests.
+type Config interface {
+	docker.Config
+
+	TestReport() string
+	GetKubeNamespace() string
+}
+
 type Runner struct {
 	structureTests []string
 	imageName      string
 	imageIsLocal   bool
 	workspace      string
 	localDaemon    docker.LocalDaemon
+	reporting      bool
+	remote         bool
+	cfg            Config
 }
 
 // New creates a new structure.Runner.
-func New(cfg docker.Config, tc *latest_v1.TestCase, imageIsLocal bool) (*Runner, error) {
-	localDaemon, err := docker.NewAPIClient(cfg)
-	if err != nil {
-		return nil, err
-	}
-	return &Runner{
+func New(cfg Config, tc *latest_v1.TestCase, imageIsLocal bool) (*Runner, error) {
+	runner := &Runner{
 		structureTests: tc.StructureTests,
 		imageName:      tc.ImageName,
 		workspace:      tc.Workspace,
-		localDaemon:    localDaemon,
 		imageIsLocal:   imageIsLocal,
-	}, nil
+		reporting:      cfg.TestReport() != "",
+		remote:         !imageIsLocal && tc.StructureTestsMode == "remote",
+		cfg:            cfg,
+	}
+
+	// Remote images tested from their registry don't need a Docker daemon.
+	if runner.remote {
+		return runner, nil
+	}
+
+	localDaemon, err := docker.NewAPIClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	runner.localDaemon = localDaemon
+	return runner, nil
 }
 
 // Test is the entrypoint for running structure tests
-func (cst *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
+func (cst *Runner) Test(ctx context.Context, out io.Writer, imageTag string) ([]report.Result, error) {
 	event.TestInProgress()
-	if err := cst.runStructureTests(ctx, out, imageTag); err != nil {
+	results, err := cst.runStructureTests(ctx, out, imageTag)
+	for _, r := range results {
+		if r.Status == report.Failed {
+			eventV2.TestFailed(r.ID(), errors.New(r.Failure))
+		} else {
+			eventV2.TestSucceeded(r.ID())
+		}
+	}
+	if err != nil {
 		event.TestFailed(cst.imageName, err)
-		return containerStructureTestErr(err)
+		return results, containerStructureTestErr(err)
 	}
 	event.TestComplete()
-	return nil
+	return results, nil
 }
 
-func (cst *Runner) runStructureTests(ctx context.Context, out io.Writer, imageTag string) error {
+func (cst *Runner) runStructureTests(ctx context.Context, out io.Writer, imageTag string) ([]report.Result, error) {
+	if cst.remote {
+		return cst.runRemoteTests(ctx, out, imageTag)
+	}
+
 	if !cst.imageIsLocal {
 		// The image is remote so we have to pull it locally.
 		// `container-structure-test` currently can't do it:
 		// https://github.com/GoogleContainerTools/container-structure-test/issues/253.
 		if err := cst.localDaemon.Pull(ctx, out, imageTag); err != nil {
-			return dockerPullImageErr(imageTag, err)
+			return nil, dockerPullImageErr(imageTag, err)
 		}
 	}
 
 	files, err := cst.TestDependencies()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logrus.Infof("Running structure tests for files %v", files)
 
 	args := []string{"test", "-v", "warn", "--image", imageTag}
+	if cst.reporting {
+		args = append(args, "--output", "json")
+	}
 	for _, f := range files {
 		args = append(args, "--config", f)
 	}
 
 	cmd := exec.CommandContext(ctx, "container-structure-test", args...)
+	cmd.Env = cst.env()
+
+	if cst.reporting {
+		return cst.runWithReport(out, cmd)
+	}
+
 	cmd.Stdout = out
 	cmd.Stderr = out
-	cmd.Env = cst.env()
 
+	start := time.Now()
 	if err := util.RunCmd(cmd); err != nil {
-		return fmt.Errorf("error running container-structure-test command: %w", err)
+		err = fmt.Errorf("error running container-structure-test command: %w", err)
+		return []report.Result{cst.result(report.Failed, time.Since(start), "", err.Error())}, err
+	}
+
+	return []report.Result{cst.result(report.Passed, time.Since(start), "", "")}, nil
+}
+
+// cstResults is the JSON output of `container-structure-test`.
+type cstResults struct {
+	Pass    int
+	Fail    int
+	Total   int
+	Results []struct {
+		Name     string
+		Pass     bool
+		Stdout   string
+		Stderr   string
+		Errors   []string
+		Duration time.Duration
 	}
+}
+
+// runWithReport runs `container-structure-test` with a JSON output and
+// converts each of its tests into a test result.
+func (cst *Runner) runWithReport(out io.Writer, cmd *exec.Cmd) ([]report.Result, error) {
+	output, runErr := util.RunCmdOut(cmd)
 
-	return nil
+	var parsed cstResults
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("error running container-structure-test command: %w", runErr)
+		}
+		return nil, fmt.Errorf("parsing container-structure-test output: %w", err)
+	}
+
+	var results []report.Result
+	for _, r := range parsed.Results {
+		result := cst.result(report.Passed, r.Duration, r.Stdout+r.Stderr, "")
+		result.Name = r.Name
+		status := "PASS"
+		if !r.Pass {
+			result.Status = report.Failed
+			result.Failure = strings.Join(r.Errors, "\n")
+			status = "FAIL"
+		}
+		fmt.Fprintf(out, "=== RUN: %s\n--- %s\n", r.Name, status)
+		for _, e := range r.Errors {
+			fmt.Fprintf(out, "Error: %s\n", e)
+		}
+		results = append(results, result)
+	}
+	fmt.Fprintf(out, "Passes: %d, Failures: %d, Total tests: %d\n", parsed.Pass, parsed.Fail, parsed.Total)
+
+	if parsed.Fail > 0 {
+		return results, fmt.Errorf("%d of %d structure tests failed for %s", parsed.Fail, parsed.Total, cst.imageName)
+	}
+	if runErr != nil {
+		return results, fmt.Errorf("error running container-structure-test command: %w", runErr)
+	}
+	return results, nil
+}
+
+func (cst *Runner) result(status report.Status, duration time.Duration, output, failure string) report.Result {
+	return report.Result{
+		Image:    cst.imageName,
+		Type:     testType,
+		Name:     "container-structure-test",
+		Status:   status,
+		Duration: duration,
+		Output:   output,
+		Failure:  failure,
+	}
 }
 
 // TestDependencies returns dependencies listed for the structure tests
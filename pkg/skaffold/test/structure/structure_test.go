@@ -21,6 +21,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
@@ -62,11 +64,45 @@ func TestNewRunner(t *testing.T) {
 
 		testRunner, err := New(cfg, testCase, true)
 		t.CheckNoError(err)
-		err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
+		_, err = testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
 		t.CheckNoError(err)
 	})
 }
 
+func TestRunnerReport(t *testing.T) {
+	output := `{"Pass":1,"Fail":1,"Total":2,"Duration":3000000000,"Results":[
+{"Name":"Command Test: go version","Pass":true,"Stdout":"go1.15\n","Stderr":"","Errors":null,"Duration":1000000000},
+{"Name":"File Existence Test: /app","Pass":false,"Stdout":"","Stderr":"","Errors":["File /app should exist but does not"],"Duration":2000000000}]}`
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("test.yaml")
+		t.Override(&cluster.FindMinikubeBinary, func() (string, semver.Version, error) { return "", semver.Version{}, errors.New("not found") })
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOutErr(
+			"container-structure-test test -v warn --image image:tag --output json --config "+tmpDir.Path("test.yaml"),
+			output,
+			errors.New("exit status 1"),
+		))
+
+		cfg := &mockConfig{testReport: "reports"}
+		testCase := &latest_v1.TestCase{
+			ImageName:      "image",
+			Workspace:      tmpDir.Root(),
+			StructureTests: []string{"test.yaml"},
+		}
+		testEvent.InitializeState([]latest_v1.Pipeline{{}})
+
+		testRunner, err := New(cfg, testCase, true)
+		t.CheckNoError(err)
+		results, err := testRunner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+		t.CheckErrorContains("1 of 2 structure tests failed for image", err)
+		t.CheckDeepEqual([]report.Result{
+			{Image: "image", Type: "structure", Name: "Command Test: go version", Status: report.Passed, Duration: time.Second, Output: "go1.15\n"},
+			{Image: "image", Type: "structure", Name: "File Existence Test: /app", Status: report.Failed, Duration: 2 * time.Second, Failure: "File /app should exist but does not"},
+		}, results)
+	})
+}
+
 func TestIgnoreDockerNotFound(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Touch("test.yaml")
@@ -98,8 +134,11 @@ type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	tests                 []*latest_v1.TestCase
 	muted                 config.Muted
+	testReport            string
 }
 
+func (c *mockConfig) TestReport() string { return c.testReport }
+
 func (c *mockConfig) Muted() config.Muted { return c.muted }
 
 func (c *mockConfig) TestCases() []*latest_v1.TestCase { return c.tests }
@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 )
 
@@ -37,8 +38,15 @@ type Config interface {
 
 	TestCases() []*latest_v1.TestCase
 	Muted() config.Muted
+	TestReport() string
+	TestConcurrency() int
+	TestFilter() []string
+	GetKubeNamespace() string
 }
 
+// structureTestsName identifies the structure tests of an image for `--test-filter`.
+const structureTestsName = "structure"
+
 // NewTester parses the provided test cases from the Skaffold config,
 // and returns a Tester instance with all the necessary test runners
 // to run all specified tests.
@@ -49,8 +57,10 @@ func NewTester(cfg Config, imagesAreLocal func(imageName string) (bool, error))
 	}
 
 	return FullTester{
-		Testers: testers,
-		muted:   cfg.Muted(),
+		Testers:     testers,
+		muted:       cfg.Muted(),
+		reportDir:   cfg.TestReport(),
+		concurrency: cfg.TestConcurrency(),
 	}, nil
 }
 
@@ -102,18 +112,108 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []graph.Artifa
 	return t.runTests(ctx, out, bRes)
 }
 
+// testJob is a single tester to run on a built image.
+type testJob struct {
+	tester ImageTester
+	tag    string
+}
+
 func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []graph.Artifact) error {
+	var jobs []testJob
 	for _, b := range bRes {
 		for _, tester := range t.Testers[b.ImageName] {
-			if err := tester.Test(ctx, out, b.Tag); err != nil {
-				return fmt.Errorf("running tests: %w", err)
-			}
+			jobs = append(jobs, testJob{tester: tester, tag: b.Tag})
+		}
+	}
+
+	var results []report.Result
+	var err error
+	if t.concurrency == 1 || len(jobs) <= 1 {
+		results, err = runSequentially(ctx, out, jobs)
+	} else {
+		results, err = runInParallel(ctx, out, jobs, t.concurrency)
+	}
+
+	// Write the report even if a test failed, that's when it's the most useful.
+	if t.reportDir != "" {
+		fmt.Fprintln(out, " - writing test report to", t.reportDir)
+		if reportErr := report.Write(t.reportDir, results); reportErr != nil && err == nil {
+			return reportErr
 		}
 	}
-	return nil
+
+	return err
+}
+
+func runSequentially(ctx context.Context, out io.Writer, jobs []testJob) ([]report.Result, error) {
+	var results []report.Result
+	for _, job := range jobs {
+		res, err := job.tester.Test(ctx, out, job.tag)
+		results = append(results, res...)
+		if err != nil {
+			return results, fmt.Errorf("running tests: %w", err)
+		}
+	}
+	return results, nil
 }
 
-func getImageTesters(cfg docker.Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest_v1.TestCase) (ImageTesters, error) {
+// runInParallel runs up to `concurrency` testers at a time, or all of them when `concurrency` is 0.
+// The output of each tester is buffered and printed in order, as soon as the previous testers are done.
+func runInParallel(ctx context.Context, out io.Writer, jobs []testJob, concurrency int) ([]report.Result, error) {
+	type outcome struct {
+		output  bytes.Buffer
+		results []report.Result
+		err     error
+		done    chan struct{}
+	}
+
+	if concurrency <= 0 || concurrency > len(jobs) {
+		concurrency = len(jobs)
+	}
+	sem := make(chan struct{}, concurrency)
+
+	outcomes := make([]*outcome, len(jobs))
+	for i, job := range jobs {
+		o := &outcome{done: make(chan struct{})}
+		outcomes[i] = o
+
+		go func(job testJob) {
+			defer close(o.done)
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			o.results, o.err = job.tester.Test(ctx, &o.output, job.tag)
+		}(job)
+	}
+
+	var results []report.Result
+	var firstErr error
+	for _, o := range outcomes {
+		<-o.done
+		o.output.WriteTo(out)
+		results = append(results, o.results...)
+		if o.err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("running tests: %w", o.err)
+		}
+	}
+	return results, firstErr
+}
+
+// selected returns true if the test is selected by `--test-filter`, either by its image, its name,
+// or both as `<image>/<name>`. All the tests are selected when there's no filter.
+func selected(filter []string, imageName, testName string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if f == imageName || f == testName || f == imageName+"/"+testName {
+			return true
+		}
+	}
+	return false
+}
+
+func getImageTesters(cfg Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest_v1.TestCase) (ImageTesters, error) {
 	runners := make(map[string][]ImageTester)
 	for _, tc := range tcs {
 		isLocal, err := imagesAreLocal(tc.ImageName)
@@ -121,7 +221,7 @@ func getImageTesters(cfg docker.Config, imagesAreLocal func(imageName string) (b
 			return nil, err
 		}
 
-		if len(tc.StructureTests) != 0 {
+		if len(tc.StructureTests) != 0 && selected(cfg.TestFilter(), tc.ImageName, structureTestsName) {
 			structureRunner, err := structure.New(cfg, tc, isLocal)
 			if err != nil {
 				return nil, err
@@ -130,6 +230,9 @@ func getImageTesters(cfg docker.Config, imagesAreLocal func(imageName string) (b
 		}
 
 		for _, customTest := range tc.CustomTests {
+			if !selected(cfg.TestFilter(), tc.ImageName, custom.TestName(customTest)) {
+				continue
+			}
 			customRunner, err := custom.New(cfg, tc.ImageName, tc.Workspace, customTest)
 			if err != nil {
 				return nil, err
@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -32,6 +34,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
@@ -264,6 +267,150 @@ func TestTestMuted(t *testing.T) {
 	})
 }
 
+func TestTestReport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("test.yaml")
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOutErr(
+			"container-structure-test test -v warn --image image:tag --output json --config "+tmpDir.Path("test.yaml"),
+			`{"Pass":0,"Fail":1,"Total":1,"Results":[{"Name":"File Existence Test: /app","Pass":false,"Errors":["File /app should exist but does not"]}]}`,
+			errors.New("exit status 1"),
+		))
+
+		cfg := &mockConfig{
+			tests: []*latest_v1.TestCase{{
+				ImageName:      "image",
+				Workspace:      tmpDir.Root(),
+				StructureTests: []string{"test.yaml"},
+			}},
+			testReport: tmpDir.Path("reports"),
+		}
+		testEvent.InitializeState([]latest_v1.Pipeline{{}})
+
+		var buf bytes.Buffer
+		tester, err := NewTester(cfg, func(imageName string) (bool, error) { return true, nil })
+		t.CheckNoError(err)
+
+		err = tester.Test(context.Background(), &buf, []graph.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+
+		t.CheckError(true, err)
+		t.CheckContains("- writing test report to "+tmpDir.Path("reports"), buf.String())
+
+		junit, err := ioutil.ReadFile(filepath.Join(tmpDir.Path("reports"), report.JUnitFile))
+		t.CheckNoError(err)
+		t.CheckContains(`<failure message="File /app should exist but does not">`, string(junit))
+	})
+}
+
+func TestTestFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      []string
+		expected    map[string]int
+	}{
+		{
+			description: "no filter",
+			expected:    map[string]int{"backend": 3, "frontend": 1},
+		},
+		{
+			description: "by image",
+			filter:      []string{"frontend"},
+			expected:    map[string]int{"frontend": 1},
+		},
+		{
+			description: "by name",
+			filter:      []string{"unit"},
+			expected:    map[string]int{"backend": 1},
+		},
+		{
+			description: "by command, when the test has no name",
+			filter:      []string{"backend/./e2e.sh"},
+			expected:    map[string]int{"backend": 1},
+		},
+		{
+			description: "structure tests",
+			filter:      []string{"structure"},
+			expected:    map[string]int{"backend": 1},
+		},
+		{
+			description: "nothing selected",
+			filter:      []string{"unknown"},
+			expected:    map[string]int{},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("test.yaml").Chdir()
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+			})
+
+			cfg := &mockConfig{
+				tests: []*latest_v1.TestCase{
+					{
+						ImageName:      "backend",
+						StructureTests: []string{"test.yaml"},
+						CustomTests: []latest_v1.CustomTest{
+							{Name: "unit", Command: "go test ./..."},
+							{Command: "./e2e.sh"},
+						},
+					},
+					{
+						ImageName:   "frontend",
+						CustomTests: []latest_v1.CustomTest{{Name: "lint", Command: "npm run lint"}},
+					},
+				},
+				testFilter: test.filter,
+			}
+
+			testers, err := getImageTesters(cfg, func(string) (bool, error) { return true, nil }, cfg.tests)
+			t.CheckNoError(err)
+
+			counts := map[string]int{}
+			for image, imageTesters := range testers {
+				counts[image] = len(imageTesters)
+			}
+			t.CheckDeepEqual(test.expected, counts)
+		})
+	}
+}
+
+func TestTestInParallel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// The second tester waits for the first one to have started, which only works if they run in parallel.
+		started := make(chan struct{})
+		first := &fakeTester{output: "first\n", run: func() { close(started) }}
+		second := &fakeTester{output: "second\n", run: func() { <-started }, err: errors.New("FAIL")}
+
+		tester := FullTester{
+			Testers:     ImageTesters{"image": {second, first}},
+			concurrency: 0,
+		}
+
+		var out bytes.Buffer
+		err := tester.runTests(context.Background(), &out, []graph.Artifact{{ImageName: "image", Tag: "image:tag"}})
+
+		t.CheckErrorContains("FAIL", err)
+		t.CheckDeepEqual("second\nfirst\n", out.String())
+	})
+}
+
+type fakeTester struct {
+	output string
+	run    func()
+	err    error
+}
+
+func (f *fakeTester) Test(_ context.Context, out io.Writer, _ string) ([]report.Result, error) {
+	f.run()
+	fmt.Fprint(out, f.output)
+	return nil, f.err
+}
+
+func (f *fakeTester) TestDependencies() ([]string, error) { return nil, nil }
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -272,8 +419,17 @@ type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	tests                 []*latest_v1.TestCase
 	muted                 config.Muted
+	testReport            string
+	testFilter            []string
 }
 
 func (c *mockConfig) Muted() config.Muted { return c.muted }
 
+func (c *mockConfig) TestReport() string { return c.testReport }
+
+func (c *mockConfig) TestFilter() []string { return c.testFilter }
+
+// TestConcurrency runs the tests sequentially, so that the fake commands are run in order.
+func (c *mockConfig) TestConcurrency() int { return 1 }
+
 func (c *mockConfig) TestCases() []*latest_v1.TestCase { return c.tests }
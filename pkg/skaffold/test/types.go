@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/report"
 )
 
 // Tester is the top level test executor in Skaffold.
@@ -45,16 +46,20 @@ type Muted interface {
 // FullTester should always be the ONLY implementation of the Tester interface;
 // newly added testing implementations should implement the imageTester interface.
 type FullTester struct {
-	Testers ImageTesters
-	muted   Muted
+	Testers     ImageTesters
+	muted       Muted
+	reportDir   string
+	concurrency int
 	// imagesAreLocal func(imageName string) (bool, error)
 }
 
 // ImageTester is the lowest-level test executor in Skaffold, responsible for
 // running a single test on a single artifact image and returning its result.
+// Test returns the results of the individual tests that were run, even when
+// some of them failed, so that they can be reported.
 // Any new test type should implement this interface.
 type ImageTester interface {
-	Test(ctx context.Context, out io.Writer, tag string) error
+	Test(ctx context.Context, out io.Writer, tag string) ([]report.Result, error)
 
 	TestDependencies() ([]string, error)
 }
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const verifyTestLabel = "skaffold.dev/verify-test"
+
+var (
+	// For testing
+	getClient       = kubernetesclient.Client
+	jobPollInterval = time.Second
+	streamJobLogs   = func(ctx context.Context, cli *kubectl.CLI, out io.Writer, namespace, job string) error {
+		return cli.RunInNamespace(ctx, nil, out, "logs", namespace, "--follow", "--pod-running-timeout=5m", "job/"+job)
+	}
+)
+
+// clusterRunner runs the test containers as Kubernetes Jobs, in the first namespace of the deployment.
+type clusterRunner struct {
+	cli        *kubectl.CLI
+	namespaces func() []string
+	runID      string
+}
+
+func (r *clusterRunner) namespace() string {
+	if namespaces := r.namespaces(); len(namespaces) > 0 && namespaces[0] != "" {
+		return namespaces[0]
+	}
+	if r.cli != nil && r.cli.Namespace != "" {
+		return r.cli.Namespace
+	}
+	return "default"
+}
+
+func (r *clusterRunner) run(ctx context.Context, out io.Writer, tc *latest_v1.VerifyTestCase, image string, env []string) error {
+	client, err := getClient()
+	if err != nil {
+		return runErr(tc.Name, fmt.Errorf("getting Kubernetes client: %w", err))
+	}
+
+	namespace := r.namespace()
+	jobs := client.BatchV1().Jobs(namespace)
+	job, err := jobs.Create(ctx, r.job(tc, image, env), metav1.CreateOptions{})
+	if err != nil {
+		return runErr(tc.Name, fmt.Errorf("creating job: %w", err))
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		if err := jobs.Delete(context.Background(), job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+			logrus.Debugf("unable to delete job %s: %v", job.Name, err)
+		}
+	}()
+
+	logsCtx, cancelLogs := context.WithCancel(ctx)
+	defer cancelLogs()
+	logsDone := make(chan struct{})
+	go func() {
+		defer close(logsDone)
+		if err := streamJobLogs(logsCtx, r.cli, out, namespace, job.Name); err != nil && logsCtx.Err() == nil {
+			logrus.Debugf("unable to stream logs of job %s: %v", job.Name, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return timedOutOrCancelledErr(tc, ctx.Err())
+		case <-time.After(jobPollInterval):
+		}
+
+		current, err := jobs.Get(ctx, job.Name, metav1.GetOptions{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return timedOutOrCancelledErr(tc, ctx.Err())
+			}
+			return runErr(tc.Name, fmt.Errorf("getting job %s: %w", job.Name, err))
+		}
+		if current.Status.Succeeded == 0 && current.Status.Failed == 0 {
+			continue
+		}
+
+		// give the logs a chance to be fully printed
+		select {
+		case <-logsDone:
+		case <-time.After(5 * time.Second):
+		}
+		if current.Status.Failed > 0 {
+			return testFailedErr(tc.Name, fmt.Sprintf("job %s failed", job.Name))
+		}
+		return nil
+	}
+}
+
+func (r *clusterRunner) job(tc *latest_v1.VerifyTestCase, image string, env []string) *batchv1.Job {
+	name := containerName(tc.Name, r.runID)
+	labels := map[string]string{
+		label.RunIDLabel: r.runID,
+		verifyTestLabel:  sanitizeName(tc.Name),
+	}
+
+	var envVars []v1.EnvVar
+	for _, e := range env {
+		kv := strings.SplitN(e, "=", 2)
+		envVars = append(envVars, v1.EnvVar{Name: kv[0], Value: kv[1]})
+	}
+
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{{
+						Name:    "verify",
+						Image:   image,
+						Command: tc.Container.Command,
+						Args:    tc.Container.Args,
+						Env:     envVars,
+					}},
+				},
+			},
+		},
+	}
+}
+
+// services lists the ports of the services deployed by Skaffold.
+func (r *clusterRunner) services(ctx context.Context) ([]service, error) {
+	client, err := getClient()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	var services []service
+	for _, ns := range r.namespaces() {
+		list, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.RunIDLabel, r.runID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing services in namespace %q: %w", ns, err)
+		}
+		for _, s := range list.Items {
+			for _, p := range s.Spec.Ports {
+				services = append(services, service{name: s.Name, namespace: s.Namespace, port: p.Port})
+			}
+		}
+	}
+	return services, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+func sanitizeName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// containerName returns a name, valid for both containers and Kubernetes objects,
+// that is unique to the test and the run.
+func containerName(testName, runID string) string {
+	if len(runID) > 8 {
+		runID = runID[:8]
+	}
+	name := sanitizeName(testName)
+	if max := 63 - len("verify--") - len(runID); len(name) > max {
+		name = strings.TrimRight(name[:max], "-")
+	}
+	return fmt.Sprintf("verify-%s-%s", name, runID)
+}
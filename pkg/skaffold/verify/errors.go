@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func testFailedErr(name string, reason string) error {
+	return fmt.Errorf("verification test %q failed: %s", name, reason)
+}
+
+func testsFailedErr(names []string) error {
+	return sErrors.NewError(fmt.Errorf("verification tests failed: %s", strings.Join(names, ", ")),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("%d verification test(s) failed: %s", len(names), strings.Join(names, ", ")),
+			ErrCode: proto.StatusCode_TEST_VERIFY_FAILED_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME,
+					Action:         "Check the logs of the failed tests",
+				},
+			},
+		},
+	)
+}
+
+func runErr(name string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("running verification test %q: %s", name, err),
+			ErrCode: proto.StatusCode_TEST_VERIFY_RUN_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME,
+					Action:         fmt.Sprintf("Check the container of verification test %q", name),
+				},
+			},
+		},
+	)
+}
+
+func timedOutOrCancelledErr(tc *latest_v1.VerifyTestCase, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return testFailedErr(tc.Name, fmt.Sprintf("timed out after %d seconds", tc.TimeoutSeconds))
+	}
+	return fmt.Errorf("verification test %q cancelled: %w", tc.Name, err)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+var (
+	// For testing
+	forwardedPortsTimeout  = 10 * time.Second
+	forwardedPortsInterval = 500 * time.Millisecond
+	dockerEnv              = func(cfg docker.Config) ([]string, error) {
+		client, err := docker.NewAPIClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return client.ExtraEnv(), nil
+	}
+)
+
+// localRunner runs the test containers with `docker run`, on the host network so that the
+// containers reach the ports forwarded to the local host.
+type localRunner struct {
+	cfg   docker.Config
+	runID string
+}
+
+func (r *localRunner) run(ctx context.Context, out io.Writer, tc *latest_v1.VerifyTestCase, image string, env []string) error {
+	extraEnv, err := dockerEnv(r.cfg)
+	if err != nil {
+		return runErr(tc.Name, fmt.Errorf("getting Docker client: %w", err))
+	}
+
+	name := containerName(tc.Name, r.runID)
+	args := []string{"run", "--rm", "--name", name, "--network", "host"}
+	for _, e := range env {
+		args = append(args, "--env", e)
+	}
+	command := tc.Container.Command
+	if len(command) > 0 {
+		args = append(args, "--entrypoint", command[0])
+	}
+	args = append(args, image)
+	if len(command) > 1 {
+		args = append(args, command[1:]...)
+	}
+	args = append(args, tc.Container.Args...)
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), extraEnv...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	err = util.RunCmd(cmd)
+	if ctx.Err() != nil {
+		// killing `docker run` doesn't stop the container
+		rm := exec.Command("docker", "rm", "--force", name)
+		rm.Env = cmd.Env
+		if _, rmErr := util.RunCmdOut(rm); rmErr != nil {
+			logrus.Debugf("unable to remove container %s: %v", name, rmErr)
+		}
+		return timedOutOrCancelledErr(tc, ctx.Err())
+	}
+	if err != nil {
+		if e, ok := err.(*exec.ExitError); ok && e.Exited() {
+			return testFailedErr(tc.Name, fmt.Sprintf("container exited with code %d", e.ExitCode()))
+		}
+		return runErr(tc.Name, err)
+	}
+	return nil
+}
+
+// waitForForwardedPorts waits, for a short while, until the forwarded ports are stable and accept connections,
+// since ports are forwarded in the background. The ports forwarded at the end of the wait are returned anyway.
+func waitForForwardedPorts(ctx context.Context, forwarded func() []portforward.ForwardedPort) []portforward.ForwardedPort {
+	ctx, cancel := context.WithTimeout(ctx, forwardedPortsTimeout)
+	defer cancel()
+
+	previous := -1
+	for {
+		ports := forwarded()
+		if len(ports) == previous && allAcceptConnections(ports) {
+			return ports
+		}
+		previous = len(ports)
+
+		select {
+		case <-ctx.Done():
+			logrus.Debugln("Not all forwarded ports are ready, running the verification tests anyway")
+			return ports
+		case <-time.After(forwardedPortsInterval):
+		}
+	}
+}
+
+func allAcceptConnections(ports []portforward.ForwardedPort) bool {
+	for _, p := range ports {
+		address := p.Address
+		if address == "" || address == "0.0.0.0" {
+			address = util.Loopback
+		}
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(address, strconv.Itoa(p.LocalPort)), time.Second)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const (
+	// LocalMode runs the test containers with the local Docker daemon.
+	LocalMode = "local"
+	// ClusterMode runs the test containers as Kubernetes Jobs.
+	ClusterMode = "kubernetesCluster"
+)
+
+type Config interface {
+	docker.Config
+
+	VerifyTestCases() []*latest_v1.VerifyTestCase
+	GetNamespaces() []string
+	Muted() config.Muted
+}
+
+// Verifier runs the verification tests against the deployed application.
+type Verifier interface {
+	// Verify runs all the tests. `forwarded` lists the ports forwarded to the local host, if any.
+	Verify(ctx context.Context, out io.Writer, builds []graph.Artifact, forwarded func() []portforward.ForwardedPort) error
+}
+
+// testRunner runs a single test container.
+type testRunner interface {
+	run(ctx context.Context, out io.Writer, tc *latest_v1.VerifyTestCase, image string, env []string) error
+}
+
+// FullVerifier runs each test container with the runner of its execution mode.
+type FullVerifier struct {
+	cfg       Config
+	testCases []*latest_v1.VerifyTestCase
+	runID     string
+	local     testRunner
+	cluster   *clusterRunner
+}
+
+// NewVerifier returns a Verifier for the verification tests of the Skaffold config.
+func NewVerifier(cfg Config, cli *kubectl.CLI, runID string) *FullVerifier {
+	return &FullVerifier{
+		cfg:       cfg,
+		testCases: cfg.VerifyTestCases(),
+		runID:     runID,
+		local:     &localRunner{cfg: cfg, runID: runID},
+		cluster:   &clusterRunner{cli: cli, namespaces: cfg.GetNamespaces, runID: runID},
+	}
+}
+
+// Verify runs all the tests, one at a time, and fails if any of them fails.
+func (v *FullVerifier) Verify(ctx context.Context, out io.Writer, builds []graph.Artifact, forwarded func() []portforward.ForwardedPort) error {
+	if len(v.testCases) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintln(out, "Running verification tests...")
+
+	if v.cfg.Muted().MuteTest() {
+		file, err := logfile.Create("verify.log")
+		if err != nil {
+			return fmt.Errorf("unable to create log file for verification tests: %w", err)
+		}
+		fmt.Fprintln(out, " - writing logs to", file.Name())
+
+		// Print logs to a memory buffer and to a file.
+		var buf bytes.Buffer
+		w := io.MultiWriter(file, &buf)
+
+		// Run the tests.
+		err = v.runTests(ctx, w, builds, forwarded)
+
+		// After the tests finish, close the log file. If the tests failed, print the full log to the console.
+		file.Close()
+		if err != nil {
+			buf.WriteTo(out)
+		}
+
+		return err
+	}
+
+	return v.runTests(ctx, out, builds, forwarded)
+}
+
+func (v *FullVerifier) runTests(ctx context.Context, out io.Writer, builds []graph.Artifact, forwarded func() []portforward.ForwardedPort) error {
+	var localEnv, clusterEnv []string
+	for _, tc := range v.testCases {
+		if tc.ExecutionMode == ClusterMode {
+			if clusterEnv == nil {
+				services, err := v.cluster.services(ctx)
+				if err != nil {
+					return err
+				}
+				clusterEnv = append(v.baseEnv(), serviceEnv(services)...)
+			}
+			continue
+		}
+		if localEnv == nil {
+			var ports []portforward.ForwardedPort
+			if forwarded != nil {
+				ports = waitForForwardedPorts(ctx, forwarded)
+			}
+			localEnv = append(v.baseEnv(), forwardedPortsEnv(ports)...)
+		}
+	}
+
+	var failed []string
+	for _, tc := range v.testCases {
+		env, runner := append([]string{}, localEnv...), v.local
+		if tc.ExecutionMode == ClusterMode {
+			env, runner = append([]string{}, clusterEnv...), testRunner(v.cluster)
+		}
+		for _, e := range tc.Container.Env {
+			env = append(env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+		}
+
+		testOut := io.MultiWriter(out, eventV2.NewLogger(constants.Verify, tc.Name, "verify"))
+		color.Default.Fprintf(out, "Running verification test %q\n", tc.Name)
+		start := time.Now()
+		if err := v.runTest(ctx, testOut, runner, tc, resolveImage(tc.Container.Image, builds), env); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			color.Red.Fprintf(out, "Verification test %q failed: %v\n", tc.Name, err)
+			failed = append(failed, tc.Name)
+			continue
+		}
+		color.Green.Fprintf(out, "Verification test %q passed in %v\n", tc.Name, time.Since(start).Round(time.Millisecond))
+	}
+
+	if len(failed) > 0 {
+		return testsFailedErr(failed)
+	}
+	return nil
+}
+
+func (v *FullVerifier) runTest(ctx context.Context, out io.Writer, runner testRunner, tc *latest_v1.VerifyTestCase, image string, env []string) error {
+	if tc.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(tc.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	return runner.run(ctx, out, tc, image, env)
+}
+
+// baseEnv describes the deployment to the test containers.
+func (v *FullVerifier) baseEnv() []string {
+	return []string{
+		fmt.Sprintf("SKAFFOLD_RUN_ID=%s", v.runID),
+		fmt.Sprintf("SKAFFOLD_KUBE_CONTEXT=%s", v.cfg.GetKubeContext()),
+		fmt.Sprintf("SKAFFOLD_NAMESPACES=%s", strings.Join(v.cfg.GetNamespaces(), ",")),
+	}
+}
+
+// resolveImage replaces the name of an artifact built by Skaffold with the tag of its latest build.
+func resolveImage(image string, builds []graph.Artifact) string {
+	for _, b := range builds {
+		if b.ImageName == image {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+// service is a port of a Kubernetes service deployed by Skaffold.
+type service struct {
+	name      string
+	namespace string
+	port      int32
+}
+
+// serviceEnv points the test containers running in the cluster at the services, by DNS name.
+// For example: `SKAFFOLD_SERVICE_WEB_8080=web.default.svc.cluster.local:8080`.
+func serviceEnv(services []service) []string {
+	var env []string
+	for _, s := range services {
+		env = append(env, fmt.Sprintf("%s=%s.%s.svc.cluster.local:%d", envName("service", s.name, fmt.Sprint(s.port)), s.name, s.namespace, s.port))
+	}
+	sort.Strings(env)
+	return env
+}
+
+// forwardedPortsEnv points the local test containers at the forwarded ports.
+// For example: `SKAFFOLD_SERVICE_WEB_8080=127.0.0.1:9000`.
+func forwardedPortsEnv(ports []portforward.ForwardedPort) []string {
+	var env []string
+	for _, p := range ports {
+		address := p.Address
+		if address == "" || address == "0.0.0.0" {
+			address = "127.0.0.1"
+		}
+		env = append(env, fmt.Sprintf("%s=%s:%d", envName(p.ResourceType, p.ResourceName, p.RemotePort), address, p.LocalPort))
+	}
+	return env
+}
+
+var invalidEnvChars = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+func envName(parts ...string) string {
+	name := strings.ToUpper(strings.Join(append([]string{"skaffold"}, parts...), "_"))
+	return invalidEnvChars.ReplaceAllString(name, "_")
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const runID = "0123456789abcdef"
+
+func TestForwardedPortsEnv(t *testing.T) {
+	env := forwardedPortsEnv([]portforward.ForwardedPort{
+		{ResourceType: "deployment", ResourceName: "my-app", RemotePort: "http", LocalPort: 4503},
+		{ResourceType: "service", ResourceName: "web", RemotePort: "8080", Address: "0.0.0.0", LocalPort: 9000},
+		{ResourceType: "service", ResourceName: "db", RemotePort: "5432", Address: "192.168.1.10", LocalPort: 5432},
+	})
+
+	testutil.CheckDeepEqual(t, []string{
+		"SKAFFOLD_DEPLOYMENT_MY_APP_HTTP=127.0.0.1:4503",
+		"SKAFFOLD_SERVICE_WEB_8080=127.0.0.1:9000",
+		"SKAFFOLD_SERVICE_DB_5432=192.168.1.10:5432",
+	}, env)
+}
+
+func TestServiceEnv(t *testing.T) {
+	env := serviceEnv([]service{
+		{name: "web", namespace: "prod", port: 80},
+		{name: "backend.v2", namespace: "prod", port: 8080},
+	})
+
+	testutil.CheckDeepEqual(t, []string{
+		"SKAFFOLD_SERVICE_BACKEND_V2_8080=backend.v2.prod.svc.cluster.local:8080",
+		"SKAFFOLD_SERVICE_WEB_80=web.prod.svc.cluster.local:80",
+	}, env)
+}
+
+func TestContainerName(t *testing.T) {
+	tests := []struct {
+		description string
+		testName    string
+		expected    string
+	}{
+		{
+			description: "simple name",
+			testName:    "smoke",
+			expected:    "verify-smoke-01234567",
+		},
+		{
+			description: "invalid characters",
+			testName:    "Smoke Tests (API)",
+			expected:    "verify-smoke-tests-api-01234567",
+		},
+		{
+			description: "long name",
+			testName:    "end-to-end-tests-of-the-checkout-flow-with-all-payment-methods",
+			expected:    "verify-end-to-end-tests-of-the-checkout-flow-with-all-01234567",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			name := containerName(test.testName, runID)
+
+			t.CheckDeepEqual(test.expected, name)
+			t.CheckTrue(len(name) <= 63)
+		})
+	}
+}
+
+func TestLocalVerify(t *testing.T) {
+	tests := []struct {
+		description string
+		run         func(port int) util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "passing test",
+			run: func(port int) util.Command {
+				return testutil.CmdRun(localCommand(port))
+			},
+		},
+		{
+			description: "failing test",
+			run: func(port int) util.Command {
+				return testutil.CmdRunErr(localCommand(port), errors.New("exit status 1"))
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			t.CheckNoError(err)
+			defer listener.Close()
+			port := listener.Addr().(*net.TCPAddr).Port
+
+			t.Override(&forwardedPortsInterval, 10*time.Millisecond)
+			t.Override(&dockerEnv, func(docker.Config) ([]string, error) { return nil, nil })
+			t.Override(&util.DefaultExecCommand, test.run(port))
+
+			verifier := NewVerifier(&mockConfig{
+				RunContext: runcontext.RunContext{KubeContext: "kind-kind", Namespaces: []string{"default"}},
+				tests: []*latest_v1.VerifyTestCase{{
+					Name: "smoke",
+					Container: latest_v1.VerifyContainer{
+						Image:   "integration-tests",
+						Command: []string{"go", "test"},
+						Args:    []string{"./..."},
+						Env:     []latest_v1.VerifyEnvVar{{Name: "VERBOSE", Value: "true"}},
+					},
+				}},
+			}, &kubectl.CLI{}, runID)
+			forwarded := func() []portforward.ForwardedPort {
+				return []portforward.ForwardedPort{{ResourceType: "service", ResourceName: "web", RemotePort: "8080", LocalPort: port}}
+			}
+
+			err = verifier.Verify(context.Background(), ioutil.Discard, []graph.Artifact{{ImageName: "integration-tests", Tag: "integration-tests:abc"}}, forwarded)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func localCommand(port int) string {
+	return "docker run --rm --name verify-smoke-01234567 --network host" +
+		" --env SKAFFOLD_RUN_ID=" + runID +
+		" --env SKAFFOLD_KUBE_CONTEXT=kind-kind" +
+		" --env SKAFFOLD_NAMESPACES=default" +
+		" --env SKAFFOLD_SERVICE_WEB_8080=127.0.0.1:" + strconv.Itoa(port) +
+		" --env VERBOSE=true" +
+		" --entrypoint go integration-tests:abc test ./..."
+}
+
+func TestClusterVerify(t *testing.T) {
+	tests := []struct {
+		description string
+		status      batchv1.JobStatus
+		shouldErr   bool
+	}{
+		{
+			description: "job succeeded",
+			status:      batchv1.JobStatus{Succeeded: 1},
+		},
+		{
+			description: "job failed",
+			status:      batchv1.JobStatus{Failed: 1},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(&v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod", Labels: map[string]string{label.RunIDLabel: runID}},
+				Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80}}},
+			})
+			var created *batchv1.Job
+			client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				created = action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+				return false, nil, nil
+			})
+			client.PrependReactor("get", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				job := created.DeepCopy()
+				job.Status = test.status
+				return true, job, nil
+			})
+			t.Override(&getClient, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&jobPollInterval, 10*time.Millisecond)
+			t.Override(&streamJobLogs, func(_ context.Context, _ *kubectl.CLI, out io.Writer, namespace, job string) error {
+				fmt.Fprintf(out, "logs of %s/%s\n", namespace, job)
+				return nil
+			})
+
+			verifier := NewVerifier(&mockConfig{
+				RunContext: runcontext.RunContext{Namespaces: []string{"prod"}},
+				tests: []*latest_v1.VerifyTestCase{{
+					Name:          "in-cluster",
+					ExecutionMode: ClusterMode,
+					Container:     latest_v1.VerifyContainer{Image: "curlimages/curl", Args: []string{"$(SKAFFOLD_SERVICE_WEB_80)"}},
+				}},
+			}, &kubectl.CLI{}, runID)
+
+			var out bytes.Buffer
+			err := verifier.Verify(context.Background(), &out, nil, nil)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckContains("logs of prod/verify-in-cluster-01234567", out.String())
+			t.CheckDeepEqual("curlimages/curl", created.Spec.Template.Spec.Containers[0].Image)
+			t.CheckDeepEqual(v1.EnvVar{Name: "SKAFFOLD_SERVICE_WEB_80", Value: "web.prod.svc.cluster.local:80"}, created.Spec.Template.Spec.Containers[0].Env[3])
+		})
+	}
+}
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	tests                 []*latest_v1.VerifyTestCase
+	muted                 config.Muted
+}
+
+func (c *mockConfig) Muted() config.Muted { return c.muted }
+
+func (c *mockConfig) VerifyTestCases() []*latest_v1.VerifyTestCase { return c.tests }
@@ -38,6 +38,8 @@ const (
 	BuilderType_KANIKO BuilderType = 5
 	// Docker Builder
 	BuilderType_DOCKER BuilderType = 6
+	// Ko Builder
+	BuilderType_KO BuilderType = 7
 )
 
 var BuilderType_name = map[int32]string{
@@ -48,6 +50,7 @@ var BuilderType_name = map[int32]string{
 	4: "CUSTOM",
 	5: "KANIKO",
 	6: "DOCKER",
+	7: "KO",
 }
 
 var BuilderType_value = map[string]int32{
@@ -58,6 +61,7 @@ var BuilderType_value = map[string]int32{
 	"CUSTOM":               4,
 	"KANIKO":               5,
 	"DOCKER":               6,
+	"KO":                   7,
 }
 
 func (x BuilderType) String() string {
@@ -324,6 +328,10 @@ const (
 	StatusCode_INIT_DOCKER_NETWORK_INVALID_MODE StatusCode = 125
 	// Error parsing Docker Network mode
 	StatusCode_INIT_DOCKER_NETWORK_PARSE_ERR StatusCode = 126
+	// Multi-platform images can only be built when pushing images
+	StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR StatusCode = 127
+	// Failed to sign the built image or to attach its SBOM attestation
+	StatusCode_BUILD_SIGN_ERR StatusCode = 128
 	// Container image pull error
 	StatusCode_STATUSCHECK_IMAGE_PULL_ERR StatusCode = 300
 	// Container creating error
@@ -334,6 +342,16 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// Job failed to complete
+	StatusCode_STATUSCHECK_JOB_FAILED StatusCode = 305
+	// Custom resource reported a failed condition
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED StatusCode = 306
+	// Job waiting to complete
+	StatusCode_STATUSCHECK_JOB_PENDING StatusCode = 307
+	// Pod waiting to be ready
+	StatusCode_STATUSCHECK_POD_PENDING StatusCode = 308
+	// Custom resource waiting to be ready
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING StatusCode = 309
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -477,6 +495,10 @@ const (
 	StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE StatusCode = 1022
 	// Kubernetes cluster reported an internal system error
 	StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR StatusCode = 1023
+	// Unable to roll back a failed deployment
+	StatusCode_DEPLOY_ROLLBACK_ERR StatusCode = 1024
+	// Image to deploy isn't signed with the trusted key
+	StatusCode_DEPLOY_VERIFY_SIGNATURE_ERR StatusCode = 1025
 	// Error expanding paths
 	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1101
 	// Error running container-structure-test
@@ -503,6 +525,10 @@ const (
 	StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR StatusCode = 1113
 	// Error retrieving the command
 	StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR StatusCode = 1114
+	// Post-deploy verification test failed
+	StatusCode_TEST_VERIFY_FAILED_ERR StatusCode = 1115
+	// Unable to run a post-deploy verification test
+	StatusCode_TEST_VERIFY_RUN_ERR StatusCode = 1116
 	// Catch-all configuration file parsing error
 	StatusCode_CONFIG_FILE_PARSING_ERR StatusCode = 1201
 	// Main configuration file not found
@@ -525,6 +551,12 @@ const (
 	StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR StatusCode = 1210
 	// Same config imported at least twice with different set of profiles
 	StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR StatusCode = 1211
+	// Error running a build lifecycle hook
+	StatusCode_BUILD_LIFECYCLE_HOOK_ERR StatusCode = 1301
+	// Error running a sync lifecycle hook
+	StatusCode_SYNC_LIFECYCLE_HOOK_ERR StatusCode = 1302
+	// Error running a deploy lifecycle hook
+	StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR StatusCode = 1303
 )
 
 var StatusCode_name = map[int32]string{
@@ -559,11 +591,18 @@ var StatusCode_name = map[int32]string{
 	124:  "INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST",
 	125:  "INIT_DOCKER_NETWORK_INVALID_MODE",
 	126:  "INIT_DOCKER_NETWORK_PARSE_ERR",
+	127:  "BUILD_MULTI_PLATFORM_NO_PUSH_ERR",
+	128:  "BUILD_SIGN_ERR",
 	300:  "STATUSCHECK_IMAGE_PULL_ERR",
 	301:  "STATUSCHECK_CONTAINER_CREATING",
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_JOB_FAILED",
+	306:  "STATUSCHECK_CUSTOM_RESOURCE_FAILED",
+	307:  "STATUSCHECK_JOB_PENDING",
+	308:  "STATUSCHECK_POD_PENDING",
+	309:  "STATUSCHECK_CUSTOM_RESOURCE_PENDING",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
@@ -636,6 +675,8 @@ var StatusCode_name = map[int32]string{
 	1021: "DEPLOY_PARSE_MANIFEST_IMAGES_ERR",
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
 	1023: "DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR",
+	1024: "DEPLOY_ROLLBACK_ERR",
+	1025: "DEPLOY_VERIFY_SIGNATURE_ERR",
 	1101: "TEST_USER_CONFIG_ERR",
 	1102: "TEST_CST_USER_ERR",
 	1103: "TEST_IMG_PULL_ERR",
@@ -649,6 +690,8 @@ var StatusCode_name = map[int32]string{
 	1112: "TEST_CUSTOM_DEPENDENCIES_CMD_ERR",
 	1113: "TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR",
 	1114: "TEST_CUSTOM_CMD_RETRIEVE_ERR",
+	1115: "TEST_VERIFY_FAILED_ERR",
+	1116: "TEST_VERIFY_RUN_ERR",
 	1201: "CONFIG_FILE_PARSING_ERR",
 	1202: "CONFIG_FILE_NOT_FOUND_ERR",
 	1203: "CONFIG_DEPENDENCY_NOT_FOUND_ERR",
@@ -660,6 +703,9 @@ var StatusCode_name = map[int32]string{
 	1209: "CONFIG_DEFAULT_VALUES_ERR",
 	1210: "CONFIG_FILE_PATHS_SUBSTITUTION_ERR",
 	1211: "CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR",
+	1301: "BUILD_LIFECYCLE_HOOK_ERR",
+	1302: "SYNC_LIFECYCLE_HOOK_ERR",
+	1303: "DEPLOY_LIFECYCLE_HOOK_ERR",
 }
 
 var StatusCode_value = map[string]int32{
@@ -694,11 +740,18 @@ var StatusCode_value = map[string]int32{
 	"INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST": 124,
 	"INIT_DOCKER_NETWORK_INVALID_MODE":             125,
 	"INIT_DOCKER_NETWORK_PARSE_ERR":                126,
+	"BUILD_MULTI_PLATFORM_NO_PUSH_ERR":             127,
+	"BUILD_SIGN_ERR":                               128,
 	"STATUSCHECK_IMAGE_PULL_ERR":                   300,
 	"STATUSCHECK_CONTAINER_CREATING":               301,
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_JOB_FAILED":                       305,
+	"STATUSCHECK_CUSTOM_RESOURCE_FAILED":           306,
+	"STATUSCHECK_JOB_PENDING":                      307,
+	"STATUSCHECK_POD_PENDING":                      308,
+	"STATUSCHECK_CUSTOM_RESOURCE_PENDING":          309,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
@@ -771,6 +824,8 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":             1021,
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
 	"DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR":           1023,
+	"DEPLOY_ROLLBACK_ERR":                          1024,
+	"DEPLOY_VERIFY_SIGNATURE_ERR":                  1025,
 	"TEST_USER_CONFIG_ERR":                         1101,
 	"TEST_CST_USER_ERR":                            1102,
 	"TEST_IMG_PULL_ERR":                            1103,
@@ -784,6 +839,8 @@ var StatusCode_value = map[string]int32{
 	"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":             1112,
 	"TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR":      1113,
 	"TEST_CUSTOM_CMD_RETRIEVE_ERR":                 1114,
+	"TEST_VERIFY_FAILED_ERR":                       1115,
+	"TEST_VERIFY_RUN_ERR":                          1116,
 	"CONFIG_FILE_PARSING_ERR":                      1201,
 	"CONFIG_FILE_NOT_FOUND_ERR":                    1202,
 	"CONFIG_DEPENDENCY_NOT_FOUND_ERR":              1203,
@@ -795,6 +852,9 @@ var StatusCode_value = map[string]int32{
 	"CONFIG_DEFAULT_VALUES_ERR":                    1209,
 	"CONFIG_FILE_PATHS_SUBSTITUTION_ERR":           1210,
 	"CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR":     1211,
+	"BUILD_LIFECYCLE_HOOK_ERR":                     1301,
+	"SYNC_LIFECYCLE_HOOK_ERR":                      1302,
+	"DEPLOY_LIFECYCLE_HOOK_ERR":                    1303,
 }
 
 func (x StatusCode) String() string {
@@ -910,6 +970,8 @@ const (
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD   SuggestionCode = 1002
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS SuggestionCode = 1003
 	SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME       SuggestionCode = 1004
+	// Check the lifecycle hook command and its configuration
+	SuggestionCode_CHECK_LIFECYCLE_HOOK SuggestionCode = 1100
 )
 
 var SuggestionCode_name = map[int32]string{
@@ -965,6 +1027,7 @@ var SuggestionCode_name = map[int32]string{
 	1002: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD",
 	1003: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS",
 	1004: "CHECK_TEST_COMMAND_AND_IMAGE_NAME",
+	1100: "CHECK_LIFECYCLE_HOOK",
 }
 
 var SuggestionCode_value = map[string]int32{
@@ -1020,6 +1083,7 @@ var SuggestionCode_value = map[string]int32{
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD":                  1002,
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS":                1003,
 	"CHECK_TEST_COMMAND_AND_IMAGE_NAME":                      1004,
+	"CHECK_LIFECYCLE_HOOK":                                   1100,
 }
 
 func (x SuggestionCode) String() string {
@@ -1044,175 +1108,186 @@ func init() {
 func init() { proto.RegisterFile("enums.proto", fileDescriptor_888b6bd9597961ff) }
 
 var fileDescriptor_888b6bd9597961ff = []byte{
-	// 2713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x57, 0x90, 0x5c, 0xc5,
-	0xd5, 0x66, 0x76, 0x76, 0x77, 0x66, 0x5b, 0x02, 0x9a, 0x46, 0x12, 0xab, 0x1c, 0x40, 0x02, 0x16,
-	0x7e, 0x89, 0xff, 0xe7, 0x2f, 0x1e, 0xfc, 0xd6, 0x73, 0xef, 0x99, 0x99, 0xd6, 0xdc, 0xdb, 0xf7,
-	0x56, 0x77, 0xdf, 0x5d, 0xad, 0x5e, 0x6e, 0x81, 0x35, 0x5a, 0x04, 0x2b, 0x8d, 0xd0, 0xee, 0x62,
-	0xe3, 0x54, 0x7e, 0x70, 0x0e, 0x55, 0xc6, 0x39, 0x3d, 0x38, 0x96, 0x5f, 0x9c, 0x73, 0x36, 0x94,
-	0x5f, 0x8c, 0x31, 0xe0, 0x6c, 0x70, 0xf9, 0xd1, 0xae, 0x72, 0xc0, 0xa1, 0x30, 0x39, 0xba, 0x4e,
-	0xf7, 0x4d, 0x13, 0x16, 0x3f, 0x50, 0x8c, 0xfa, 0xfb, 0xfa, 0xf4, 0x39, 0xa7, 0x4f, 0x9f, 0x70,
-	0x97, 0x6c, 0xe9, 0x9f, 0xdb, 0x38, 0xbb, 0x76, 0xf4, 0xfc, 0x85, 0xc1, 0xfa, 0x80, 0x6d, 0xb1,
-	0xff, 0x3b, 0x6a, 0x97, 0x16, 0x06, 0x64, 0x4b, 0x6b, 0xe3, 0xcc, 0xea, 0xa9, 0xfe, 0x05, 0x73,
-	0xd7, 0xf9, 0x3e, 0x9b, 0x27, 0xdb, 0x12, 0xd9, 0x93, 0xd1, 0x92, 0x4c, 0x5b, 0x89, 0x08, 0x7c,
-	0x50, 0xa9, 0x59, 0x8e, 0x81, 0x5e, 0xc4, 0x1a, 0xa4, 0x7e, 0x5c, 0xb4, 0x68, 0x8d, 0xcd, 0x91,
-	0x99, 0x16, 0x3f, 0x09, 0x01, 0x9d, 0x62, 0x97, 0x10, 0x62, 0x59, 0x31, 0xf7, 0x7a, 0x9a, 0xd6,
-	0x19, 0x21, 0xb3, 0x5e, 0xa2, 0x4d, 0x14, 0xd2, 0x69, 0xfc, 0xdd, 0xe3, 0x52, 0xf4, 0x22, 0x3a,
-	0x83, 0xbf, 0xfd, 0xc8, 0xeb, 0x81, 0xa2, 0xb3, 0x0b, 0x3e, 0x99, 0xb3, 0x07, 0xda, 0xe3, 0x76,
-	0x10, 0x36, 0x74, 0x5c, 0x7e, 0xd8, 0x16, 0xd2, 0xf0, 0x82, 0x44, 0x1b, 0x50, 0xb4, 0x86, 0x27,
-	0x77, 0xbc, 0x16, 0x9d, 0xc2, 0x93, 0x83, 0xc8, 0xe3, 0x01, 0xad, 0x2f, 0xf4, 0x08, 0x31, 0xfd,
-	0xb5, 0xf5, 0x4c, 0xeb, 0xed, 0xe4, 0xb2, 0x5c, 0x8c, 0x01, 0x6d, 0x72, 0x29, 0x4d, 0x32, 0x9d,
-	0x48, 0x61, 0x68, 0x8d, 0xed, 0x21, 0xf3, 0x5e, 0x24, 0x0d, 0x17, 0x12, 0x54, 0xaa, 0x8d, 0x4a,
-	0x3c, 0x93, 0x28, 0xb0, 0x64, 0x3a, 0xb5, 0x10, 0x91, 0xad, 0x7e, 0xff, 0xfc, 0xea, 0xe0, 0xae,
-	0x4c, 0xdc, 0x4e, 0xb2, 0x3d, 0x17, 0xe7, 0x43, 0x1c, 0x44, 0xcb, 0xa5, 0x17, 0x9a, 0x64, 0xba,
-	0x0b, 0x41, 0x48, 0x6b, 0xec, 0x62, 0x32, 0xd7, 0xb3, 0xb6, 0x8a, 0x93, 0x40, 0xa7, 0x50, 0xe3,
-	0x5e, 0xd2, 0x02, 0xcf, 0xa0, 0x76, 0x82, 0x6c, 0xf1, 0x56, 0x37, 0x0a, 0xf5, 0x2a, 0x4e, 0xcd,
-	0xac, 0xca, 0xc5, 0x6d, 0x25, 0xcd, 0x50, 0x48, 0x81, 0x3b, 0x33, 0x43, 0x7b, 0xe0, 0x0c, 0x8d,
-	0x4c, 0x17, 0x14, 0xad, 0x2f, 0x1c, 0x27, 0xcd, 0x60, 0xb0, 0x12, 0xf4, 0xef, 0xec, 0xaf, 0xe2,
-	0xb2, 0x0f, 0xad, 0xa4, 0xe3, 0xf4, 0x10, 0xb2, 0x1d, 0xd1, 0x1a, 0xfe, 0x5a, 0xe2, 0x4a, 0xba,
-	0x5d, 0xa0, 0x54, 0xa4, 0x68, 0x1d, 0x7f, 0xb6, 0xb9, 0xe1, 0x01, 0x9d, 0xc6, 0x9f, 0x31, 0x97,
-	0xc2, 0xa3, 0x33, 0x0b, 0x77, 0x1f, 0x22, 0x44, 0xaf, 0xdf, 0xbc, 0xbe, 0xb1, 0xe6, 0x0d, 0x4e,
-	0xf5, 0xd9, 0x2c, 0x99, 0x8a, 0x7a, 0xf4, 0x22, 0x36, 0x4f, 0x2e, 0xd7, 0x86, 0x9b, 0x44, 0x7b,
-	0x5d, 0xf0, 0x7a, 0xa9, 0x4e, 0x3c, 0x0f, 0xb4, 0xa6, 0x3f, 0xa9, 0x31, 0x46, 0x2e, 0x76, 0xd7,
-	0x92, 0xaf, 0xdd, 0x57, 0x63, 0x97, 0x93, 0x4b, 0x9c, 0x53, 0x8a, 0xc5, 0x9f, 0xd6, 0xd8, 0x65,
-	0x64, 0xab, 0x75, 0x7c, 0xbe, 0x74, 0xbf, 0x75, 0xb9, 0xdb, 0x1b, 0x27, 0xba, 0x9b, 0x72, 0xbb,
-	0x9e, 0xfa, 0x20, 0x05, 0xf8, 0xb4, 0xcf, 0x76, 0x93, 0x2b, 0x32, 0x54, 0x45, 0xc7, 0xc1, 0x33,
-	0xa9, 0x8c, 0x4c, 0xda, 0x8e, 0x12, 0xe9, 0xd3, 0xd3, 0xec, 0x4a, 0xb2, 0xdf, 0x81, 0x2e, 0x68,
-	0x52, 0x9f, 0x43, 0x18, 0x49, 0x4b, 0x51, 0x89, 0x94, 0x42, 0x76, 0xe8, 0x0a, 0xdb, 0x46, 0xa8,
-	0x23, 0x25, 0x1a, 0x54, 0xea, 0x0c, 0xbf, 0xb5, 0x3c, 0x35, 0xdb, 0x9a, 0x48, 0xbe, 0xc8, 0x45,
-	0xc0, 0x5b, 0x01, 0xd0, 0x33, 0x6c, 0x2f, 0xd9, 0x39, 0x8a, 0x26, 0xa6, 0x1b, 0x29, 0x71, 0x12,
-	0x7c, 0x7a, 0x5b, 0xa9, 0x54, 0x06, 0xeb, 0x65, 0x6d, 0x20, 0x44, 0xd9, 0xf4, 0x76, 0x76, 0x90,
-	0xec, 0x1d, 0x02, 0x51, 0x9b, 0x30, 0xf2, 0x45, 0x5b, 0x80, 0x6f, 0x29, 0xab, 0xec, 0x2a, 0x72,
-	0x60, 0x8c, 0x22, 0xc2, 0x38, 0x80, 0x10, 0xa4, 0xc9, 0x58, 0x67, 0xd9, 0x3e, 0xb2, 0x6b, 0xc4,
-	0x3a, 0xc3, 0xd3, 0x20, 0xd2, 0xda, 0xe2, 0xe7, 0xc6, 0xf0, 0x76, 0xa4, 0x5a, 0xc2, 0xf7, 0x41,
-	0x5a, 0x7c, 0x30, 0x66, 0x84, 0x17, 0xc9, 0x76, 0x20, 0x3c, 0x63, 0xe1, 0xf3, 0xec, 0x00, 0xd9,
-	0x33, 0x04, 0x5b, 0xcf, 0x54, 0xdc, 0x7b, 0x07, 0x3b, 0x44, 0xf6, 0x0d, 0x31, 0x84, 0x5c, 0xe4,
-	0x81, 0xf0, 0xd3, 0x98, 0x2b, 0xee, 0xac, 0xbd, 0x30, 0xaa, 0x44, 0x5b, 0x04, 0x50, 0x91, 0xb1,
-	0x36, 0x66, 0xaa, 0xc7, 0xbd, 0x2e, 0xa4, 0x6d, 0x15, 0x85, 0x69, 0x9c, 0x04, 0x81, 0x95, 0xb2,
-	0xce, 0xf6, 0x93, 0xdd, 0x43, 0xac, 0x0e, 0x98, 0xd4, 0x17, 0x1d, 0x8c, 0x14, 0x24, 0x6c, 0x94,
-	0x4e, 0x55, 0xd0, 0x11, 0xda, 0xa8, 0xe5, 0x51, 0xca, 0x9d, 0x25, 0x25, 0x7f, 0x42, 0xc7, 0x45,
-	0x2b, 0x8d, 0x83, 0xa4, 0x23, 0xa4, 0x7b, 0x45, 0xaf, 0x2a, 0x2f, 0x1d, 0xa1, 0x8e, 0xe2, 0x7e,
-	0x00, 0xf8, 0x70, 0xad, 0x80, 0x57, 0x97, 0xb7, 0x8a, 0x68, 0xc8, 0x17, 0x41, 0x16, 0xe0, 0x5d,
-	0x6c, 0x81, 0x1c, 0x11, 0x52, 0x98, 0xe2, 0xc6, 0xc0, 0x2c, 0x45, 0xaa, 0x97, 0x06, 0x42, 0x1b,
-	0x21, 0x3b, 0x69, 0x91, 0x34, 0x34, 0x7d, 0x0d, 0x3b, 0x4a, 0x16, 0x26, 0x71, 0x73, 0xf7, 0x95,
-	0x09, 0x46, 0xf2, 0x10, 0xe8, 0x6b, 0xd9, 0x0d, 0xe4, 0xfa, 0x49, 0xfc, 0x92, 0xe7, 0x47, 0xa0,
-	0xad, 0x57, 0xe1, 0x84, 0xd0, 0x86, 0xbe, 0x0e, 0xbd, 0xfa, 0x72, 0x27, 0x84, 0x91, 0x0f, 0xf4,
-	0xf5, 0xe8, 0x91, 0x49, 0xac, 0x98, 0x2b, 0x0d, 0xd6, 0xac, 0x37, 0xb0, 0xfd, 0x64, 0x57, 0xf5,
-	0x49, 0x8b, 0x90, 0x77, 0xa0, 0xbc, 0x98, 0xcf, 0x4f, 0xb1, 0x2b, 0xc9, 0xbe, 0x2a, 0xa1, 0xd4,
-	0xc9, 0x53, 0xc0, 0xd1, 0x74, 0xfa, 0x85, 0x29, 0x76, 0x88, 0xec, 0xad, 0x92, 0x54, 0x22, 0x2b,
-	0x44, 0x14, 0xf4, 0xc5, 0x29, 0x76, 0x98, 0x1c, 0x98, 0x2c, 0xc8, 0x80, 0x0a, 0x85, 0xe4, 0x06,
-	0x7c, 0xfa, 0xa5, 0x29, 0x76, 0x1d, 0x39, 0x52, 0xa5, 0xb9, 0x0c, 0x82, 0xcf, 0x22, 0x55, 0x51,
-	0x10, 0x44, 0x89, 0x49, 0x63, 0x90, 0x3e, 0x9e, 0xfb, 0xe5, 0x97, 0x91, 0xa9, 0x40, 0x1b, 0xae,
-	0xac, 0x7a, 0x7f, 0x9c, 0x62, 0xbb, 0xc8, 0xf6, 0x2a, 0x2d, 0x91, 0x5d, 0xe0, 0x81, 0xe9, 0x2e,
-	0xd3, 0x3f, 0x8d, 0x89, 0x90, 0x91, 0x0f, 0x69, 0x08, 0x61, 0xa4, 0x96, 0xd3, 0x58, 0x81, 0xd6,
-	0x89, 0x02, 0xfa, 0x9e, 0xfa, 0xa8, 0x1b, 0x2c, 0xcd, 0x17, 0xba, 0x57, 0x92, 0xee, 0xae, 0xb3,
-	0x6b, 0xc9, 0x55, 0x63, 0xa4, 0xdc, 0xe9, 0xd5, 0xfc, 0xf2, 0xde, 0xfa, 0xa8, 0xc7, 0x2c, 0x35,
-	0xc6, 0xa7, 0x95, 0x8b, 0x7b, 0xdf, 0xe4, 0x33, 0x13, 0x89, 0xff, 0xf2, 0x13, 0x27, 0xe8, 0xfd,
-	0x75, 0x76, 0x90, 0xec, 0x99, 0x40, 0x52, 0xc0, 0xbd, 0xae, 0xa5, 0x7c, 0xa0, 0x3e, 0x7a, 0xc7,
-	0x4e, 0x2d, 0x4c, 0x91, 0xc0, 0xfd, 0x65, 0xfa, 0xc1, 0x31, 0x65, 0xda, 0x5c, 0x04, 0xe0, 0xa7,
-	0xd9, 0x41, 0xe8, 0xc3, 0x0f, 0xd5, 0xd9, 0xd5, 0xe4, 0x50, 0x95, 0x93, 0x95, 0x30, 0x74, 0xb9,
-	0x04, 0xcf, 0x88, 0xc8, 0x25, 0x9d, 0x8f, 0x8c, 0x69, 0x9d, 0x13, 0xd1, 0xb8, 0x9e, 0x08, 0x02,
-	0xf0, 0xe9, 0x47, 0xc7, 0x3c, 0x55, 0x48, 0x0b, 0x04, 0xde, 0x74, 0x1b, 0x8c, 0xd7, 0xb5, 0xf2,
-	0x3e, 0x56, 0x1f, 0xbd, 0xa0, 0x4a, 0x40, 0x94, 0xb4, 0x8f, 0x8f, 0xf9, 0x21, 0x8e, 0xfc, 0x14,
-	0x63, 0x5f, 0xf0, 0x40, 0x9c, 0x44, 0x13, 0x7e, 0x5c, 0xc7, 0x22, 0x95, 0xa7, 0x06, 0x57, 0x05,
-	0x1e, 0xaf, 0x8f, 0x96, 0xb4, 0x0c, 0xa7, 0x4f, 0xd4, 0xd9, 0x11, 0x72, 0x70, 0x02, 0x32, 0x72,
-	0x01, 0x4f, 0xd6, 0xd9, 0x02, 0x39, 0x3c, 0x39, 0x06, 0x97, 0xb8, 0xb0, 0xa9, 0x21, 0x97, 0xf9,
-	0x54, 0x9d, 0xed, 0x23, 0x3b, 0x27, 0xc9, 0x84, 0x45, 0x90, 0x86, 0xbe, 0x50, 0xaf, 0x94, 0xcc,
-	0x7c, 0xd3, 0xd3, 0x75, 0x2c, 0x99, 0x7a, 0x59, 0x7a, 0xc5, 0xd2, 0x33, 0xf5, 0xb2, 0xdc, 0xe6,
-	0x6b, 0xcf, 0xd6, 0xd9, 0x36, 0x72, 0xa9, 0x0f, 0x8b, 0xf6, 0xbd, 0xe7, 0xab, 0xcf, 0xd9, 0x55,
-	0x2f, 0x00, 0x2e, 0x93, 0xb8, 0x58, 0x7d, 0xde, 0x8a, 0x1c, 0x22, 0xbe, 0x58, 0x67, 0x3b, 0xc9,
-	0xb6, 0x91, 0x8a, 0xe7, 0xa0, 0x97, 0xea, 0x45, 0xcd, 0xce, 0x97, 0xde, 0x38, 0x8d, 0x62, 0xad,
-	0x4e, 0x56, 0x8a, 0x73, 0xe6, 0x23, 0xd3, 0xec, 0x00, 0xd9, 0x9d, 0xab, 0xe0, 0xd2, 0x34, 0xa8,
-	0xac, 0x5b, 0xf3, 0x21, 0xd6, 0xf4, 0x07, 0x33, 0x18, 0x8a, 0x63, 0x0c, 0x2b, 0xdb, 0x12, 0x7e,
-	0x38, 0x83, 0xd7, 0x38, 0x46, 0xc8, 0x5c, 0x62, 0x29, 0x3f, 0x9a, 0x99, 0x78, 0x0a, 0x96, 0x36,
-	0xd1, 0x41, 0x0a, 0xbd, 0x67, 0x86, 0x5d, 0x45, 0xf6, 0x97, 0xae, 0xd0, 0x49, 0x1c, 0x47, 0x0a,
-	0xab, 0xea, 0xe2, 0xff, 0xa6, 0x21, 0x97, 0xa2, 0x8d, 0xbd, 0xdc, 0xbd, 0x33, 0xa3, 0xcf, 0xc2,
-	0x76, 0x07, 0x1e, 0x97, 0x1e, 0xd8, 0x20, 0xfd, 0xc4, 0xec, 0xe8, 0xb3, 0xf0, 0x81, 0xfb, 0x81,
-	0x90, 0x90, 0xc2, 0x09, 0x0f, 0xc0, 0x07, 0x9f, 0x7e, 0x72, 0x16, 0x1d, 0xe1, 0x2c, 0x2c, 0x77,
-	0x7e, 0x6a, 0x96, 0x6d, 0x27, 0x34, 0x53, 0xba, 0x5c, 0xfe, 0xf4, 0x2c, 0xdb, 0x4d, 0x76, 0x8c,
-	0xd4, 0xc2, 0x1c, 0xfc, 0xcc, 0x2c, 0x26, 0xa9, 0xe1, 0x6a, 0x9f, 0x1d, 0x47, 0x3f, 0x3b, 0xcb,
-	0xf6, 0x92, 0x79, 0x6b, 0x8d, 0xcd, 0xb9, 0x90, 0x1a, 0xde, 0xe9, 0x14, 0xad, 0xcc, 0x9b, 0x1b,
-	0x68, 0x89, 0x85, 0xf3, 0x0e, 0x31, 0x8d, 0x79, 0xa2, 0x5d, 0x1b, 0x11, 0x29, 0xfa, 0x96, 0x06,
-	0x3a, 0x64, 0x98, 0x50, 0xe9, 0x90, 0x32, 0xd6, 0x5b, 0x1b, 0x18, 0x9d, 0xd5, 0x53, 0xf2, 0xb6,
-	0xde, 0xe1, 0x6f, 0x2b, 0x8f, 0xc9, 0xf0, 0xa2, 0xe3, 0x75, 0x84, 0xb7, 0x8f, 0x11, 0xf2, 0x8b,
-	0xcd, 0x08, 0xef, 0x68, 0xa0, 0x5f, 0x1c, 0xc1, 0x36, 0x01, 0x6e, 0xf9, 0x9d, 0xa5, 0x7a, 0xd9,
-	0xbe, 0x25, 0x8e, 0xef, 0xda, 0x28, 0x51, 0xb1, 0xf2, 0x5d, 0x0d, 0x4c, 0x2c, 0x55, 0x16, 0xa6,
-	0xf7, 0x36, 0xf7, 0xaa, 0x27, 0xbc, 0xbb, 0x81, 0x77, 0x96, 0x7b, 0x3e, 0x6b, 0xa0, 0x47, 0x32,
-	0xd4, 0x5f, 0x1a, 0x98, 0x51, 0x8a, 0x90, 0x6a, 0x25, 0x9d, 0xb4, 0x0b, 0x41, 0x6c, 0x6b, 0x86,
-	0x51, 0x02, 0x16, 0x5d, 0x65, 0xfc, 0x6b, 0x83, 0x5d, 0x41, 0x58, 0x21, 0xca, 0xbd, 0x20, 0x04,
-	0xfe, 0xd6, 0xc0, 0xdb, 0xc8, 0x00, 0xec, 0xf0, 0x53, 0x1e, 0xc7, 0xc1, 0x72, 0x1a, 0xf0, 0x16,
-	0x04, 0x9a, 0x3e, 0xda, 0xc0, 0x97, 0x54, 0x85, 0xf3, 0xae, 0x93, 0xfe, 0xbd, 0xba, 0x53, 0x46,
-	0x69, 0x88, 0x66, 0xe2, 0x05, 0x58, 0x47, 0xd3, 0x7f, 0x34, 0xd8, 0x1e, 0x72, 0x45, 0x75, 0xe7,
-	0x22, 0x28, 0x9d, 0xab, 0xfd, 0xcf, 0x86, 0x8b, 0xfb, 0x12, 0x0d, 0x85, 0x1c, 0x62, 0xfc, 0xab,
-	0xe1, 0x5e, 0x97, 0x65, 0xe4, 0x09, 0xb5, 0x4a, 0xf8, 0x4d, 0xd3, 0x3d, 0x8c, 0x21, 0x42, 0xd4,
-	0x6e, 0xdb, 0x98, 0xc6, 0x8e, 0xc1, 0xb2, 0x1e, 0x6b, 0x54, 0x58, 0xa0, 0xca, 0x34, 0xd6, 0x8e,
-	0x30, 0x26, 0x03, 0x40, 0x4f, 0xd2, 0x7f, 0x57, 0x6d, 0xc1, 0x3a, 0x52, 0xbc, 0x2c, 0x2b, 0xe4,
-	0xf1, 0xaa, 0x10, 0x0b, 0x2b, 0x08, 0x23, 0x03, 0xc3, 0xac, 0x27, 0xaa, 0x42, 0xb0, 0x91, 0x1a,
-	0x86, 0x9f, 0xac, 0x3a, 0x24, 0xd7, 0xb7, 0xf0, 0xe6, 0x53, 0x36, 0x5e, 0x0b, 0x34, 0x9b, 0xaf,
-	0x4a, 0xfc, 0xe9, 0x61, 0x0d, 0xe3, 0x80, 0x7b, 0x90, 0xb5, 0x37, 0x08, 0x3f, 0x53, 0x0d, 0x15,
-	0xa3, 0xb8, 0xd4, 0xed, 0x48, 0x85, 0xc3, 0x0a, 0x3c, 0x5b, 0xbd, 0x4b, 0x0d, 0xc6, 0xdd, 0xb1,
-	0x85, 0x9e, 0xab, 0x9e, 0x5e, 0x6c, 0x5a, 0x52, 0xc2, 0x38, 0xf1, 0xcf, 0x57, 0xa3, 0xcc, 0xf5,
-	0x5b, 0x05, 0xcb, 0x2a, 0xe1, 0x7a, 0xf8, 0x17, 0x1a, 0xec, 0x1a, 0x72, 0x65, 0xf5, 0x56, 0xb3,
-	0xe0, 0x96, 0xae, 0xdd, 0x2b, 0x5b, 0x86, 0x17, 0x1b, 0x58, 0x81, 0x47, 0x42, 0x5b, 0x48, 0x03,
-	0x4a, 0xf2, 0xa0, 0x3a, 0x7f, 0xbc, 0x64, 0x95, 0x76, 0xf9, 0x5a, 0x97, 0x99, 0x11, 0xa1, 0x07,
-	0x9a, 0x6c, 0x07, 0xb9, 0xcc, 0x42, 0x5e, 0x0e, 0xe3, 0xfa, 0x83, 0xe5, 0xba, 0x08, 0x3b, 0x65,
-	0xf7, 0xf7, 0x50, 0x13, 0x8d, 0x74, 0x7c, 0xeb, 0xe0, 0xd4, 0x0b, 0xfd, 0x4a, 0xf7, 0xf8, 0xf3,
-	0x26, 0x16, 0xbf, 0x51, 0x1c, 0x9b, 0x3f, 0x19, 0xc9, 0xf4, 0x24, 0xa8, 0x08, 0xfb, 0x55, 0xe7,
-	0xcb, 0x5f, 0x34, 0xd1, 0x21, 0x93, 0xb8, 0x46, 0x84, 0xe0, 0x63, 0x5f, 0x87, 0xb4, 0x5f, 0x36,
-	0xb1, 0xee, 0x4e, 0xa2, 0x15, 0xb9, 0xd2, 0xf2, 0x7e, 0xb5, 0x29, 0x0f, 0x4e, 0x80, 0x97, 0x14,
-	0xaf, 0xfd, 0xd7, 0x4d, 0x4c, 0x1b, 0x93, 0x79, 0x22, 0x9f, 0xb4, 0x7e, 0xdb, 0xc4, 0x40, 0x9b,
-	0x48, 0x52, 0x8a, 0xfe, 0x6e, 0x4c, 0x73, 0x1f, 0xb0, 0x05, 0x05, 0xe9, 0x09, 0xd0, 0x96, 0x8a,
-	0xb4, 0x87, 0x9b, 0xec, 0x7a, 0x72, 0xf5, 0xa6, 0xb4, 0x44, 0x86, 0x5c, 0xe9, 0x2e, 0xcf, 0x5c,
-	0xfb, 0x48, 0x13, 0x2b, 0xdd, 0xd8, 0x91, 0xd5, 0x0c, 0xf4, 0x7b, 0xab, 0x55, 0x76, 0x7d, 0x76,
-	0xac, 0x42, 0xcf, 0x67, 0x09, 0x9b, 0x7e, 0x65, 0x0e, 0xef, 0xa6, 0x8a, 0x16, 0x43, 0x97, 0xc5,
-	0xbf, 0x3a, 0x87, 0x2f, 0xb0, 0x2c, 0x8b, 0x4e, 0x93, 0xe5, 0x11, 0xd6, 0xd7, 0xe6, 0xb0, 0x13,
-	0xcb, 0x59, 0x49, 0x1c, 0x08, 0xcf, 0x46, 0x1f, 0x0f, 0x41, 0xa7, 0x9a, 0x87, 0xe0, 0x44, 0x23,
-	0xf5, 0xeb, 0x73, 0x68, 0xdf, 0x26, 0x54, 0xee, 0x29, 0x1c, 0x4b, 0x91, 0xec, 0x02, 0xfb, 0x1b,
-	0x73, 0x58, 0xcf, 0x32, 0x76, 0x8b, 0xfb, 0x08, 0x99, 0x2c, 0xdc, 0xbe, 0x59, 0xc5, 0x6c, 0x94,
-	0x94, 0x0a, 0x7d, 0xab, 0x6a, 0x96, 0x4b, 0xac, 0xb1, 0x8a, 0x4a, 0xb9, 0xdf, 0xae, 0xe2, 0x3e,
-	0xb4, 0x79, 0x12, 0x98, 0x74, 0x91, 0x07, 0x49, 0x86, 0x7f, 0x67, 0x0e, 0x9f, 0xc9, 0xb0, 0xd3,
-	0x4c, 0x57, 0xa7, 0x3a, 0x69, 0x69, 0x23, 0x4c, 0x19, 0x18, 0xdf, 0x9d, 0x63, 0xff, 0x43, 0xae,
-	0xc9, 0x88, 0x61, 0x12, 0x18, 0x81, 0x03, 0x78, 0xa4, 0x4c, 0x7e, 0xde, 0xf0, 0xb4, 0xfc, 0xbd,
-	0xb9, 0x85, 0xc7, 0xb6, 0x92, 0x4b, 0xf4, 0xc6, 0xca, 0x4a, 0x7f, 0x6d, 0xfd, 0xcc, 0xe0, 0x9c,
-	0xfd, 0x2e, 0xd2, 0x20, 0x75, 0x29, 0x02, 0x7a, 0x11, 0xdb, 0x46, 0x28, 0xf7, 0xfd, 0x42, 0x21,
-	0x05, 0x71, 0x44, 0x4f, 0xb1, 0x1d, 0x84, 0xe5, 0x9d, 0x43, 0x65, 0xbd, 0x8f, 0xc3, 0xdb, 0xf8,
-	0x7a, 0xda, 0x09, 0xa2, 0x16, 0x0f, 0xb2, 0xf7, 0x4a, 0x4f, 0xe3, 0x78, 0xde, 0xf1, 0x82, 0x28,
-	0x29, 0x1a, 0x02, 0x9e, 0x98, 0x6e, 0x06, 0xe3, 0x80, 0xb0, 0xc2, 0x76, 0x92, 0xed, 0x93, 0xa1,
-	0x5b, 0xd9, 0x3c, 0xd9, 0xe6, 0x8e, 0xc8, 0x44, 0x64, 0x1f, 0x4f, 0xe8, 0x99, 0x12, 0xc9, 0xb6,
-	0xe6, 0xdf, 0x49, 0x6e, 0x43, 0x75, 0xdb, 0xe2, 0x84, 0x4b, 0x0b, 0xae, 0x13, 0x71, 0xdf, 0x33,
-	0x76, 0x10, 0x96, 0x71, 0xf3, 0x09, 0xdc, 0xa8, 0x65, 0xba, 0xca, 0x0e, 0x91, 0x7d, 0xc8, 0xaf,
-	0x0c, 0xf4, 0x45, 0x49, 0xce, 0x8c, 0x38, 0x9b, 0x73, 0x74, 0x8f, 0xb7, 0xdb, 0x51, 0xe0, 0x17,
-	0x7d, 0x5a, 0xf1, 0xad, 0x80, 0x9e, 0x43, 0x43, 0x91, 0x53, 0x99, 0xd6, 0x73, 0x4b, 0xb8, 0xad,
-	0x35, 0x03, 0x76, 0x98, 0x1c, 0x44, 0xc6, 0xa6, 0xe3, 0xb1, 0x1d, 0xa3, 0xcf, 0xe3, 0x88, 0x3e,
-	0x64, 0xda, 0x38, 0x31, 0x37, 0xf6, 0x0e, 0xf6, 0x0a, 0x72, 0xd3, 0x04, 0x91, 0xb6, 0x0a, 0x2e,
-	0x75, 0x01, 0xd3, 0x83, 0x51, 0xdc, 0x1b, 0x1e, 0xed, 0xdd, 0x39, 0x17, 0xb0, 0xae, 0x64, 0xbd,
-	0xfe, 0x58, 0x8b, 0x41, 0xef, 0xab, 0xd9, 0xe0, 0xb6, 0x70, 0xd1, 0x6d, 0xb9, 0x2e, 0x32, 0xfb,
-	0xfc, 0x25, 0xa4, 0x36, 0x98, 0x06, 0xec, 0xd7, 0xc1, 0xfb, 0xed, 0x52, 0x12, 0x77, 0x14, 0xf7,
-	0xc1, 0x2d, 0xfd, 0xac, 0xc6, 0x6e, 0x20, 0xd7, 0x4d, 0xf2, 0x9a, 0xeb, 0x36, 0x72, 0x1f, 0x47,
-	0x8b, 0xa0, 0x94, 0xf0, 0x41, 0xd3, 0x07, 0xec, 0xb7, 0xb6, 0xaa, 0x90, 0x1b, 0xff, 0x8f, 0x3e,
-	0x58, 0x63, 0x47, 0xc9, 0xb5, 0x9b, 0x8a, 0xc9, 0xeb, 0x0c, 0x3e, 0xdf, 0x98, 0x7b, 0x40, 0x1f,
-	0xaa, 0x61, 0x2f, 0x9b, 0x2b, 0x97, 0x7f, 0xa1, 0xfc, 0x43, 0x0d, 0xab, 0xc9, 0xe8, 0x64, 0x13,
-	0x44, 0x1d, 0x8d, 0x63, 0x7f, 0x61, 0x29, 0x96, 0x78, 0x21, 0x41, 0x6b, 0x0c, 0xb4, 0x16, 0xe0,
-	0xb8, 0x5f, 0x60, 0xe5, 0x36, 0x5b, 0xf8, 0x70, 0xc6, 0x3f, 0x48, 0xf6, 0x70, 0xdf, 0xc7, 0x49,
-	0x77, 0xd3, 0x79, 0x7b, 0x3f, 0xd9, 0x35, 0x44, 0x19, 0x9b, 0xb5, 0x0f, 0x93, 0x03, 0x43, 0x84,
-	0x4d, 0xe6, 0xec, 0x7d, 0x64, 0xe7, 0x10, 0x6d, 0x74, 0xc6, 0x1e, 0x3d, 0x67, 0x6c, 0xbe, 0xde,
-	0x4b, 0xe6, 0x47, 0x08, 0x43, 0xb3, 0xf5, 0x6e, 0xb2, 0x63, 0x58, 0x8d, 0xea, 0x5c, 0x5d, 0x39,
-	0x7c, 0xe2, 0x4c, 0x5d, 0xf8, 0xa8, 0x1b, 0x69, 0x53, 0x8d, 0xa2, 0x0f, 0xdb, 0x51, 0xd0, 0x7e,
-	0xc2, 0x28, 0xa2, 0x08, 0x67, 0xd2, 0xed, 0x84, 0x26, 0xd2, 0x36, 0xf7, 0xe5, 0xf2, 0x93, 0x76,
-	0xc8, 0xc3, 0x6a, 0x95, 0x05, 0x33, 0xd6, 0x6f, 0xfa, 0xb9, 0x69, 0x3b, 0xbe, 0x00, 0x6a, 0x23,
-	0xb1, 0x8b, 0x6f, 0x07, 0xbc, 0x53, 0x74, 0x7b, 0x6d, 0x1e, 0x68, 0xa0, 0x0f, 0x4f, 0xa3, 0xfa,
-	0x59, 0x54, 0x64, 0xa3, 0x7f, 0x9e, 0x32, 0xe9, 0xf7, 0x67, 0x2a, 0x99, 0xb4, 0x18, 0xbd, 0xf3,
-	0x32, 0xe2, 0x43, 0xdb, 0x4e, 0xd7, 0x91, 0xc4, 0xa9, 0x6e, 0x9e, 0x5c, 0x5e, 0x10, 0xb9, 0xec,
-	0x64, 0x61, 0x85, 0xe3, 0x5c, 0x15, 0xc9, 0xe4, 0x1b, 0x50, 0x38, 0xc5, 0x95, 0xd5, 0x29, 0x1b,
-	0xd8, 0xb3, 0xb4, 0x5b, 0x91, 0x7c, 0xcf, 0x0c, 0x3b, 0x46, 0x16, 0x36, 0x53, 0xa1, 0xa8, 0x0b,
-	0x1a, 0x82, 0xcc, 0x6d, 0xf7, 0xce, 0xb0, 0x4b, 0x09, 0x89, 0x62, 0x90, 0xa9, 0xd0, 0x3a, 0x01,
-	0xfa, 0xa6, 0x46, 0x25, 0x7c, 0xb3, 0x3a, 0x1b, 0x85, 0x21, 0x97, 0x3e, 0xfd, 0xb3, 0x6d, 0x97,
-	0x6d, 0x02, 0x1b, 0x02, 0x6c, 0x3f, 0x12, 0x25, 0x06, 0x07, 0x85, 0x05, 0x72, 0x78, 0xd2, 0xde,
-	0xb1, 0x06, 0x00, 0xa7, 0x05, 0x2c, 0x8e, 0xff, 0x95, 0x6b, 0x8b, 0x11, 0x8e, 0x10, 0x47, 0xc8,
-	0x41, 0xc7, 0x76, 0x2d, 0x40, 0xc6, 0xc5, 0xff, 0x5c, 0x8b, 0x6a, 0x33, 0xcd, 0xa3, 0x8d, 0xd6,
-	0x4d, 0x27, 0xff, 0x7f, 0xe5, 0xcc, 0xfa, 0xad, 0x1b, 0xb7, 0x1c, 0x7d, 0xe5, 0xe0, 0xec, 0xb1,
-	0xce, 0x60, 0xb0, 0xb2, 0xda, 0xf7, 0x06, 0xe7, 0xd6, 0x6f, 0x3e, 0x73, 0xae, 0x7f, 0xc1, 0x0c,
-	0x06, 0xab, 0x6b, 0xc7, 0xd6, 0x6e, 0xbf, 0xf9, 0xf4, 0xe9, 0xc1, 0xea, 0xa9, 0x63, 0xf6, 0x8f,
-	0x35, 0xc7, 0xec, 0x1f, 0x6b, 0x6e, 0x99, 0xb5, 0xff, 0xb8, 0xf1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x15, 0x48, 0xc5, 0x08, 0xcf, 0x19, 0x00, 0x00,
+	// 2882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x85, 0x59, 0x57, 0x90, 0x1c, 0xc5,
+	0x19, 0xe6, 0x6e, 0xef, 0x6e, 0xf7, 0x5a, 0x81, 0xd6, 0xa0, 0x9c, 0x05, 0x48, 0xc0, 0x81, 0x25,
+	0x6c, 0x5c, 0x3c, 0xf8, 0xad, 0x77, 0xa6, 0x77, 0x77, 0xb4, 0xb3, 0x33, 0x5b, 0x13, 0xee, 0xb4,
+	0x7a, 0x99, 0x12, 0xd6, 0xea, 0x24, 0x38, 0xdd, 0x0a, 0xdd, 0x1d, 0x58, 0x8e, 0xb8, 0xca, 0xd9,
+	0xa6, 0xca, 0x36, 0x06, 0x9c, 0x1e, 0x1c, 0xcb, 0x2f, 0x4e, 0x24, 0x67, 0x1b, 0xbb, 0xfc, 0x82,
+	0x4d, 0x72, 0x36, 0xd8, 0x3c, 0xda, 0x55, 0x0e, 0x38, 0x94, 0x0d, 0x22, 0x83, 0xff, 0xbf, 0xc3,
+	0x4c, 0xcf, 0xec, 0x9e, 0xfc, 0x80, 0xb8, 0xeb, 0xff, 0x9b, 0xbf, 0xff, 0xd4, 0x7f, 0x3a, 0xb2,
+	0xa6, 0xbf, 0xb8, 0x72, 0x7a, 0xe9, 0xe0, 0x99, 0xb3, 0x83, 0xe5, 0x81, 0xb5, 0x46, 0xfc, 0xef,
+	0xa0, 0x38, 0x9a, 0xb9, 0x95, 0xac, 0xa9, 0xaf, 0x9c, 0x5a, 0x38, 0xde, 0x3f, 0x1b, 0x9f, 0x3b,
+	0xd3, 0xb7, 0xb6, 0x92, 0x8d, 0x89, 0xdf, 0xf6, 0x83, 0x39, 0x3f, 0xad, 0x27, 0xae, 0xe7, 0xf0,
+	0x30, 0x8d, 0x7b, 0x5d, 0x4e, 0x2f, 0xb2, 0xaa, 0xa4, 0x72, 0xd8, 0xad, 0xd3, 0x31, 0x6b, 0x9a,
+	0x4c, 0xd6, 0xd9, 0x51, 0xee, 0xd1, 0x71, 0x6b, 0x3d, 0x21, 0x02, 0xd5, 0x65, 0x76, 0x3b, 0xa2,
+	0x15, 0x8b, 0x90, 0x29, 0x3b, 0x89, 0xe2, 0xa0, 0x43, 0x27, 0xf0, 0xe7, 0x36, 0xf3, 0xdd, 0x76,
+	0x40, 0x27, 0xf1, 0x67, 0x27, 0xb0, 0xdb, 0x3c, 0xa4, 0x53, 0xd6, 0x14, 0x19, 0x87, 0xb3, 0xea,
+	0x8c, 0x43, 0xa6, 0xc5, 0xc5, 0xe2, 0xda, 0xcd, 0xc4, 0x2a, 0x5c, 0xab, 0x2f, 0x5d, 0x43, 0xaa,
+	0xb6, 0x07, 0x1c, 0xe1, 0xcb, 0x31, 0x94, 0xa0, 0x69, 0xd7, 0xe1, 0x5a, 0x90, 0xc0, 0x0b, 0x6c,
+	0xe6, 0xd1, 0xca, 0x4c, 0x9b, 0x90, 0xb8, 0xbf, 0xb4, 0xac, 0xa4, 0xdf, 0x44, 0x36, 0x68, 0x36,
+	0x31, 0x8f, 0x62, 0xcd, 0xa5, 0x46, 0x26, 0x12, 0xdf, 0x8d, 0x81, 0xc5, 0x4e, 0xb2, 0xd5, 0x0e,
+	0xfc, 0x98, 0xb9, 0x3e, 0x28, 0x16, 0xc5, 0x61, 0x62, 0xc7, 0x49, 0xc8, 0x05, 0x98, 0x8e, 0xcf,
+	0x04, 0x64, 0xad, 0xd3, 0x3f, 0xb3, 0x30, 0x38, 0xa7, 0xd8, 0x6d, 0x23, 0x9b, 0x34, 0x3b, 0x87,
+	0x77, 0xbd, 0xa0, 0x97, 0x5b, 0x03, 0x58, 0xb6, 0xb8, 0xd7, 0x01, 0x96, 0xeb, 0xc8, 0x74, 0x5b,
+	0xe8, 0xec, 0x1e, 0xe5, 0x20, 0x1b, 0x48, 0xdc, 0x4e, 0xea, 0xdc, 0x8e, 0x51, 0x3a, 0x97, 0xac,
+	0xb1, 0x17, 0x56, 0x32, 0xf1, 0x0c, 0xe3, 0x2a, 0xad, 0x34, 0xbb, 0xb5, 0xa4, 0xd6, 0x71, 0xc1,
+	0x58, 0xf0, 0xa5, 0x52, 0xb4, 0xcd, 0xa5, 0xa2, 0x41, 0xdc, 0x02, 0xe5, 0x2b, 0x33, 0x87, 0x49,
+	0xcd, 0x1b, 0xcc, 0x7b, 0xfd, 0x5b, 0xfa, 0x0b, 0x78, 0xec, 0xf0, 0x7a, 0xd2, 0x94, 0x72, 0xb8,
+	0x7e, 0x23, 0x80, 0x8f, 0xe0, 0xa7, 0x39, 0x16, 0xfa, 0xf2, 0x2b, 0x1e, 0x86, 0x01, 0x7c, 0x85,
+	0x3f, 0x36, 0x58, 0x0c, 0x96, 0x9a, 0xc0, 0x1f, 0xbb, 0xe0, 0x0f, 0x9b, 0x4e, 0xce, 0x3c, 0xb4,
+	0x9f, 0x90, 0x68, 0xf9, 0xd8, 0xf2, 0xca, 0x92, 0x3d, 0x38, 0xde, 0x47, 0x8f, 0x04, 0x6d, 0xe0,
+	0xb5, 0x95, 0x5c, 0x12, 0xc5, 0x2c, 0x4e, 0x22, 0xbb, 0xc5, 0xed, 0x76, 0x1a, 0x25, 0xb6, 0xcd,
+	0xa3, 0x88, 0x3e, 0x34, 0x66, 0x59, 0x64, 0x9d, 0x74, 0x8b, 0x3e, 0xfb, 0xd9, 0x98, 0x75, 0x09,
+	0x59, 0x2f, 0x8d, 0x92, 0x1d, 0xfe, 0x7c, 0xcc, 0xda, 0x40, 0xd6, 0x0a, 0xc3, 0xeb, 0xa3, 0x87,
+	0x85, 0xc9, 0xe5, 0xb7, 0xdd, 0x24, 0x6a, 0xa5, 0x4c, 0x9c, 0x83, 0x39, 0x7d, 0x97, 0x3b, 0xb4,
+	0x6f, 0xed, 0x20, 0x5b, 0x14, 0x35, 0x0c, 0x0e, 0x83, 0xd5, 0x52, 0x3f, 0x88, 0xd3, 0x46, 0x90,
+	0xf8, 0x0e, 0x3d, 0x61, 0x5d, 0x46, 0xf6, 0x48, 0xa2, 0x0c, 0x9e, 0xd4, 0x61, 0xbc, 0x13, 0xf8,
+	0x02, 0x12, 0x26, 0xbe, 0xef, 0xfa, 0x4d, 0x3a, 0x6f, 0x6d, 0x24, 0x54, 0x82, 0x92, 0x08, 0x20,
+	0x52, 0xf1, 0x93, 0xf9, 0xad, 0xea, 0xd3, 0xc4, 0x67, 0xb3, 0xcc, 0xf5, 0x58, 0xdd, 0xe3, 0xf4,
+	0x94, 0xb5, 0x8b, 0x6c, 0x2b, 0x53, 0x93, 0xb8, 0x15, 0x84, 0xe0, 0x42, 0x87, 0xde, 0x98, 0x0b,
+	0xa5, 0xc8, 0x51, 0x0f, 0x7c, 0xd5, 0x41, 0xde, 0xf4, 0x26, 0x6b, 0x1f, 0xd9, 0x55, 0x20, 0xa2,
+	0x34, 0x9d, 0xc0, 0x71, 0x1b, 0xa0, 0x90, 0x80, 0x2c, 0x58, 0x97, 0x93, 0xbd, 0x43, 0x10, 0xb7,
+	0xd3, 0xf5, 0x78, 0x87, 0xfb, 0xb1, 0x42, 0x9d, 0xb6, 0x76, 0x93, 0xed, 0x25, 0xed, 0x62, 0x96,
+	0x7a, 0x01, 0x58, 0x07, 0xe9, 0x8b, 0x43, 0xf4, 0x46, 0x10, 0xd6, 0x5d, 0x07, 0x2c, 0x27, 0xe8,
+	0x83, 0x21, 0x25, 0x20, 0xb0, 0x1b, 0x9e, 0x0b, 0x26, 0x44, 0xf2, 0x19, 0x6b, 0x2f, 0xd9, 0x59,
+	0x20, 0x0b, 0xcb, 0x18, 0xe6, 0xbd, 0xd9, 0xba, 0x94, 0xec, 0x2e, 0x20, 0x5c, 0x7f, 0x96, 0x79,
+	0x2e, 0xb8, 0x82, 0x85, 0x4c, 0x6a, 0x7b, 0xb6, 0x2c, 0x44, 0xc3, 0xf5, 0xb8, 0xc1, 0x63, 0x69,
+	0x48, 0x55, 0x9b, 0x41, 0xf0, 0xa4, 0x8d, 0x30, 0xe8, 0x80, 0xbf, 0x3d, 0x4f, 0x70, 0x59, 0xb6,
+	0xf6, 0x90, 0x1d, 0x05, 0x54, 0x93, 0xc7, 0xa9, 0xe3, 0x36, 0x31, 0x52, 0x10, 0xb0, 0x92, 0x1b,
+	0x35, 0xe4, 0x4d, 0x17, 0xde, 0x65, 0xaf, 0x0c, 0xb9, 0x25, 0x87, 0xe8, 0x27, 0x04, 0xd9, 0x28,
+	0xed, 0x7a, 0x49, 0xd3, 0xf5, 0xe5, 0x2b, 0xba, 0x35, 0x77, 0x3a, 0x92, 0x9a, 0x21, 0x73, 0x40,
+	0x58, 0x88, 0x51, 0xc1, 0xe0, 0x2d, 0xb9, 0x57, 0x91, 0xda, 0x61, 0xb3, 0xdc, 0xcf, 0x88, 0xe7,
+	0xac, 0x19, 0x72, 0x00, 0xde, 0x5f, 0x9c, 0x79, 0x8c, 0xc7, 0x73, 0x41, 0xd8, 0x4e, 0x3d, 0x10,
+	0x06, 0xc2, 0x2c, 0xcd, 0x92, 0x46, 0x44, 0xdf, 0x6a, 0x1d, 0x24, 0x33, 0xa3, 0xb0, 0xda, 0x7c,
+	0x79, 0x82, 0xf1, 0x59, 0x87, 0xd3, 0xb7, 0x59, 0xd7, 0x92, 0x6b, 0x46, 0xe1, 0x73, 0x9c, 0x13,
+	0xf0, 0x48, 0x58, 0x95, 0x1f, 0x81, 0xfb, 0xe8, 0xdb, 0xd1, 0xaa, 0x17, 0xba, 0x01, 0xc2, 0x8d,
+	0xd3, 0x77, 0xa0, 0x45, 0x46, 0xa1, 0xc0, 0x7d, 0x11, 0x17, 0x6a, 0xbd, 0x33, 0x77, 0x4f, 0x27,
+	0xf1, 0x62, 0x17, 0xcc, 0xc5, 0x62, 0x08, 0xa3, 0x0e, 0x5c, 0x26, 0x9f, 0x23, 0xa2, 0xde, 0x85,
+	0x4f, 0x59, 0x3d, 0x6f, 0xb7, 0x29, 0xa3, 0xeb, 0xb6, 0x31, 0xf0, 0xd9, 0x76, 0x33, 0x1b, 0xb8,
+	0x1d, 0xd6, 0xe4, 0xb9, 0x4f, 0xbf, 0x3a, 0x0e, 0xaf, 0x73, 0xb7, 0x09, 0xc8, 0xd5, 0xb1, 0x43,
+	0xce, 0xd0, 0x6a, 0xf4, 0x6b, 0xe3, 0x10, 0x63, 0xbb, 0x4c, 0x10, 0x3c, 0x5b, 0x03, 0x88, 0x8c,
+	0xbe, 0x3e, 0x6e, 0xed, 0x27, 0x7b, 0x47, 0x33, 0x82, 0x04, 0x09, 0x59, 0x91, 0xc1, 0x93, 0xa1,
+	0xdf, 0x18, 0xb7, 0xae, 0x26, 0x07, 0x4c, 0x98, 0x4c, 0x3e, 0xf8, 0xa2, 0xd2, 0x30, 0xf0, 0xbc,
+	0x20, 0x89, 0xd3, 0x2e, 0xf7, 0x1d, 0xbc, 0xf7, 0x9b, 0xe3, 0xe0, 0xec, 0xcd, 0x26, 0xf8, 0x70,
+	0x50, 0x4f, 0x1b, 0xf0, 0xfe, 0x81, 0xd3, 0x3d, 0xe3, 0xd6, 0x15, 0xe4, 0xd2, 0xc2, 0x85, 0x22,
+	0x7d, 0x43, 0xe8, 0x45, 0x41, 0x12, 0xda, 0x5c, 0x03, 0xef, 0x1d, 0x87, 0x80, 0xda, 0x52, 0xe6,
+	0xa2, 0xef, 0xb8, 0x6f, 0x88, 0xda, 0x0d, 0x9c, 0x8c, 0x7a, 0xff, 0xb8, 0x75, 0x25, 0xb9, 0xec,
+	0x42, 0x97, 0x68, 0xe4, 0x03, 0x17, 0xd0, 0x1f, 0xc0, 0x31, 0x0b, 0x85, 0x29, 0xff, 0x34, 0x6e,
+	0x6d, 0x27, 0x9b, 0x4c, 0x58, 0xe2, 0xb7, 0x38, 0xf3, 0xe2, 0x56, 0x8f, 0xfe, 0x79, 0x88, 0x85,
+	0x0f, 0x01, 0x92, 0x76, 0x20, 0x59, 0xc2, 0x43, 0xea, 0x02, 0x97, 0x08, 0x8a, 0x1c, 0xfd, 0x68,
+	0xa5, 0xec, 0x32, 0x01, 0x73, 0xdc, 0xa8, 0x9d, 0x83, 0x3e, 0x56, 0xb1, 0xae, 0x22, 0x97, 0x0f,
+	0x81, 0x74, 0x6c, 0x99, 0x69, 0xf4, 0xe3, 0x95, 0xb2, 0x77, 0x05, 0xb4, 0x8b, 0x19, 0x44, 0xb3,
+	0xbb, 0x63, 0xf4, 0x9d, 0x89, 0x8f, 0xbf, 0x39, 0x89, 0x64, 0xf4, 0x89, 0x0a, 0x84, 0xf2, 0xce,
+	0x11, 0x20, 0x88, 0x23, 0xbb, 0x25, 0x20, 0x77, 0x56, 0xca, 0xf1, 0x28, 0xc5, 0xc2, 0x4a, 0xc0,
+	0x99, 0xd3, 0xa3, 0x77, 0x0d, 0x09, 0x23, 0xbd, 0x98, 0xaa, 0x8b, 0xd0, 0x86, 0x77, 0x57, 0xca,
+	0x9e, 0x57, 0x95, 0x1a, 0x4d, 0xee, 0xc3, 0x0f, 0x6e, 0x20, 0xa3, 0xff, 0x53, 0x43, 0x52, 0x6b,
+	0x20, 0x2a, 0xd7, 0x76, 0x3d, 0x0c, 0x8f, 0x4f, 0x0f, 0x59, 0x2a, 0xe3, 0xe6, 0xb9, 0x18, 0x95,
+	0x0d, 0x1e, 0xdb, 0xf2, 0x85, 0x7d, 0xa6, 0x52, 0x76, 0x90, 0x11, 0xbc, 0x39, 0xec, 0xb3, 0x43,
+	0x76, 0xc0, 0x90, 0xc2, 0x27, 0xee, 0xc2, 0xb3, 0x3f, 0x8a, 0x2a, 0xfc, 0xb4, 0x82, 0xb5, 0x58,
+	0x67, 0x40, 0x59, 0xec, 0x9e, 0xa9, 0x94, 0x2b, 0xb7, 0xa2, 0xd3, 0x67, 0x2b, 0xd6, 0x01, 0xb2,
+	0x6f, 0x04, 0xa5, 0xe4, 0x80, 0xf3, 0x15, 0xc8, 0x7f, 0xfb, 0x47, 0xc7, 0xe0, 0x1c, 0x73, 0x45,
+	0x06, 0xd4, 0x3c, 0x9f, 0xab, 0x40, 0x4d, 0xd8, 0x36, 0x8a, 0x27, 0x87, 0x8c, 0x1a, 0xd3, 0x57,
+	0x2a, 0x46, 0x67, 0xa0, 0x3f, 0x7a, 0xbe, 0x82, 0x9d, 0x41, 0xd4, 0xf3, 0xed, 0xec, 0xe8, 0x85,
+	0x4a, 0xde, 0x55, 0xe8, 0xb3, 0x17, 0x2b, 0x50, 0xcd, 0x2f, 0x76, 0xf8, 0xac, 0x48, 0x6b, 0xfa,
+	0xf4, 0x25, 0x71, 0x6a, 0x7b, 0x9c, 0xf9, 0x49, 0x37, 0x3b, 0x7d, 0x59, 0xb0, 0x2c, 0x00, 0x5f,
+	0xad, 0x40, 0xc7, 0xb6, 0xb1, 0x54, 0xd8, 0x25, 0xe9, 0xb5, 0x4a, 0xd6, 0x9a, 0xe8, 0xa3, 0xdb,
+	0x26, 0x90, 0xad, 0x90, 0x49, 0x70, 0x91, 0xc6, 0x7c, 0x72, 0x02, 0x0a, 0xe7, 0x0e, 0x2d, 0x82,
+	0xac, 0x46, 0xc0, 0x47, 0x31, 0xe5, 0xdd, 0x88, 0xfe, 0x70, 0x12, 0x43, 0x71, 0x08, 0x21, 0x78,
+	0x0b, 0xc0, 0x8f, 0x26, 0xd1, 0x8d, 0x43, 0x00, 0x65, 0x12, 0x01, 0x79, 0x70, 0x72, 0xe4, 0x2d,
+	0x58, 0xc1, 0xdd, 0x26, 0x42, 0xe8, 0x8f, 0x27, 0x21, 0x77, 0xef, 0xc9, 0x4d, 0x11, 0x25, 0xdd,
+	0x6e, 0x10, 0x62, 0xf3, 0x30, 0xfb, 0x7a, 0x28, 0x5e, 0xbe, 0xdb, 0xc0, 0x96, 0xf5, 0x27, 0x93,
+	0xe5, 0x67, 0x21, 0x9a, 0x20, 0x9b, 0xf9, 0x36, 0x17, 0x41, 0xfa, 0xb9, 0xa9, 0xf2, 0xb3, 0x70,
+	0xe0, 0xb9, 0xc0, 0x6b, 0x80, 0x02, 0x71, 0xc4, 0xe6, 0xdc, 0x01, 0xcc, 0xe7, 0xa7, 0xd0, 0x10,
+	0x52, 0xc3, 0xfc, 0xcb, 0x2f, 0x4c, 0x41, 0x37, 0x4d, 0x95, 0xd0, 0xf9, 0xf1, 0x17, 0xa7, 0x30,
+	0xb5, 0x96, 0x4a, 0xbe, 0x26, 0x7e, 0x69, 0x0a, 0x93, 0x54, 0xb1, 0xa9, 0x51, 0xd7, 0xd1, 0x2f,
+	0x4f, 0x41, 0xc3, 0xb2, 0x55, 0x68, 0x23, 0xea, 0x03, 0x34, 0xdd, 0xac, 0xd9, 0xcc, 0x3a, 0xb6,
+	0xf7, 0x56, 0x51, 0x13, 0x41, 0xd6, 0x8d, 0x30, 0x14, 0x32, 0xd0, 0xc6, 0x51, 0x80, 0xf7, 0x55,
+	0xd1, 0x20, 0x45, 0x80, 0xd1, 0x08, 0x2a, 0xd4, 0xfb, 0xab, 0x18, 0x9d, 0xe6, 0x2d, 0x7a, 0x8a,
+	0x91, 0xf4, 0x0f, 0xe4, 0xd7, 0x28, 0x7a, 0xd6, 0xd8, 0x4b, 0xc0, 0x07, 0x87, 0x00, 0xda, 0xb1,
+	0x0a, 0xf0, 0xa1, 0x2a, 0xda, 0x45, 0x02, 0x44, 0xaf, 0x23, 0x8f, 0x3f, 0x9c, 0x8b, 0xa7, 0xbe,
+	0x9b, 0x63, 0xf8, 0xae, 0xe3, 0xd0, 0x35, 0xb4, 0xfc, 0x48, 0x15, 0x13, 0x8b, 0x89, 0xc2, 0xf4,
+	0xde, 0x60, 0xb6, 0x79, 0xc3, 0xed, 0x55, 0xf4, 0x99, 0xb6, 0xbc, 0x9a, 0x13, 0x4a, 0x19, 0xea,
+	0xaf, 0x55, 0xcc, 0x28, 0x59, 0x48, 0xc1, 0x2c, 0x90, 0xc2, 0x38, 0xd2, 0x15, 0x35, 0x03, 0xae,
+	0x84, 0xa7, 0x28, 0x60, 0x7f, 0xab, 0x5a, 0x5b, 0x88, 0x95, 0xb1, 0x92, 0x2f, 0x08, 0x09, 0x7f,
+	0xaf, 0xa2, 0x37, 0x14, 0x01, 0x07, 0x99, 0x94, 0x75, 0xbb, 0x5e, 0x2f, 0x85, 0x7c, 0xc0, 0xbd,
+	0x88, 0x3e, 0x5d, 0xc5, 0x97, 0x64, 0x92, 0x75, 0x73, 0x4d, 0xff, 0x61, 0x7e, 0x09, 0x6d, 0x44,
+	0x07, 0xd5, 0x44, 0x07, 0x08, 0x43, 0xd3, 0x7f, 0x56, 0xb1, 0x2c, 0x9a, 0x5f, 0xce, 0x42, 0xd3,
+	0xa4, 0xc5, 0xfe, 0x57, 0x55, 0xc6, 0x7d, 0x4e, 0x05, 0x5f, 0x16, 0x10, 0xff, 0xae, 0xca, 0xd7,
+	0x25, 0x10, 0x3a, 0xa1, 0x9a, 0x80, 0xdf, 0xd6, 0xe4, 0xc3, 0x28, 0x00, 0x82, 0x46, 0x43, 0xc4,
+	0x34, 0x36, 0x46, 0x02, 0xf5, 0x9f, 0xaa, 0x81, 0x82, 0x83, 0x2c, 0x8d, 0x41, 0xf7, 0x03, 0xf6,
+	0xf2, 0x38, 0x5a, 0x92, 0xfe, 0xd7, 0xd4, 0x05, 0xeb, 0x48, 0xf6, 0xb2, 0x04, 0x93, 0x67, 0x4c,
+	0x26, 0x82, 0x1c, 0x42, 0x49, 0x05, 0xa7, 0x15, 0x50, 0xcf, 0x9a, 0x4c, 0xb0, 0x5f, 0x2c, 0x92,
+	0xcf, 0x9b, 0x06, 0xd1, 0xf2, 0x66, 0xd6, 0x7c, 0x4e, 0xc4, 0x6b, 0x46, 0x55, 0x63, 0x64, 0x4e,
+	0x7f, 0xbe, 0x28, 0x21, 0xf4, 0x6f, 0xd0, 0x3a, 0xc8, 0x56, 0x0c, 0xc9, 0x2f, 0x98, 0xa1, 0x12,
+	0x87, 0xcc, 0x8f, 0x44, 0x77, 0x57, 0x10, 0xe0, 0x45, 0xd3, 0x97, 0x11, 0x74, 0xd5, 0xc2, 0xc7,
+	0x82, 0xf4, 0x92, 0x79, 0x7b, 0xf6, 0xd1, 0x5c, 0xe8, 0xc6, 0x92, 0xfd, 0xcb, 0x66, 0x94, 0xc9,
+	0xb6, 0x32, 0x43, 0x09, 0x21, 0xe4, 0xa8, 0xf2, 0x4a, 0x15, 0x9b, 0x1d, 0xd3, 0xab, 0x2a, 0xb8,
+	0x7d, 0xd9, 0xd5, 0xe6, 0x2d, 0xc3, 0xab, 0x55, 0xac, 0xc0, 0xa5, 0xd0, 0x76, 0x61, 0x26, 0x0a,
+	0x7d, 0xe6, 0x99, 0x63, 0xd6, 0x6b, 0x55, 0xac, 0x69, 0x5a, 0x6f, 0x68, 0xef, 0xea, 0x0c, 0x72,
+	0x97, 0xe8, 0x4c, 0x6b, 0x46, 0x08, 0x41, 0x60, 0xb8, 0x8d, 0x9e, 0x68, 0x5b, 0x99, 0x18, 0xe4,
+	0x11, 0xf1, 0xee, 0x1a, 0x2a, 0x2c, 0x73, 0x7d, 0x94, 0x67, 0x55, 0x24, 0x3d, 0x5a, 0xb3, 0x36,
+	0x93, 0x0d, 0x82, 0x64, 0x6b, 0x32, 0x9e, 0x3f, 0x96, 0x9f, 0xbb, 0x9d, 0x66, 0xde, 0xe5, 0x3e,
+	0x5e, 0x43, 0x03, 0x49, 0xbc, 0xec, 0xdf, 0xec, 0x8e, 0x63, 0x34, 0xd8, 0xbf, 0xa8, 0x61, 0xe1,
+	0x2c, 0xd3, 0xb1, 0xc9, 0xf5, 0x21, 0x5c, 0x8f, 0xf2, 0x30, 0xc0, 0x96, 0x5e, 0xfa, 0xe1, 0x97,
+	0x35, 0x34, 0xe6, 0x28, 0x6c, 0xec, 0x76, 0xb8, 0x83, 0xfd, 0x2b, 0xc2, 0x7e, 0x55, 0xc3, 0x9a,
+	0x3d, 0x0a, 0x96, 0xe5, 0x59, 0x81, 0xfb, 0xf5, 0xaa, 0x38, 0x7e, 0x84, 0xdb, 0x49, 0x96, 0x29,
+	0x7e, 0x53, 0xc3, 0x94, 0x33, 0x1a, 0xe7, 0xea, 0x61, 0xf4, 0x77, 0x35, 0x0c, 0xd2, 0x91, 0x20,
+	0xa0, 0xfe, 0x7e, 0x48, 0x72, 0x30, 0x3f, 0xb4, 0xaf, 0xdc, 0xb7, 0x5d, 0x08, 0x01, 0x84, 0x22,
+	0xec, 0x89, 0x9a, 0x75, 0x0d, 0xb9, 0x62, 0x55, 0x58, 0xe2, 0x77, 0xc0, 0x6e, 0x2d, 0xa6, 0x4c,
+	0xfb, 0x64, 0x0d, 0xab, 0xe4, 0xd0, 0x95, 0x66, 0xf6, 0xfa, 0x43, 0x0d, 0x6b, 0x8d, 0x80, 0x28,
+	0x47, 0xab, 0x9e, 0x0e, 0x89, 0x7f, 0xac, 0x61, 0x84, 0x98, 0x44, 0x2d, 0xee, 0x53, 0x42, 0x19,
+	0xe5, 0x75, 0x31, 0xb0, 0xa2, 0xc3, 0x54, 0x8d, 0xa0, 0xf7, 0x4c, 0xa3, 0x4b, 0x4d, 0x6a, 0x36,
+	0xce, 0x0a, 0xfa, 0xbd, 0xd3, 0xf8, 0xe8, 0xf3, 0x4a, 0x2c, 0x15, 0xe8, 0x95, 0x50, 0xf7, 0x4d,
+	0x63, 0xf3, 0xa7, 0x51, 0x49, 0x17, 0xe6, 0x6e, 0x11, 0xf0, 0x30, 0xf0, 0x45, 0x69, 0x04, 0xff,
+	0x4a, 0xd6, 0x08, 0xbd, 0x7f, 0x1a, 0xcd, 0xb2, 0x0a, 0x94, 0xd9, 0x21, 0x0e, 0xfc, 0x08, 0x96,
+	0x6f, 0xe9, 0x81, 0x69, 0x2c, 0xa1, 0x0a, 0x5d, 0x87, 0x94, 0x03, 0xa4, 0x58, 0x45, 0xe9, 0xb7,
+	0x4c, 0x9a, 0x08, 0xae, 0x5c, 0xa0, 0x6f, 0x9b, 0x6a, 0xc9, 0x5c, 0xde, 0x0d, 0x83, 0x9c, 0xef,
+	0x77, 0x4c, 0xba, 0xc3, 0x1b, 0x0c, 0xe6, 0xc1, 0x14, 0xa6, 0xc9, 0x44, 0xd1, 0xbf, 0x3b, 0x8d,
+	0x2f, 0xb3, 0x68, 0xb4, 0xb8, 0x05, 0xba, 0x24, 0x75, 0x98, 0x80, 0xe3, 0x3c, 0x9e, 0xbe, 0x37,
+	0x6d, 0xbd, 0x8e, 0x5c, 0xa9, 0x80, 0x72, 0xaa, 0x74, 0x3b, 0xd8, 0x9a, 0xe8, 0xfb, 0x8a, 0x7b,
+	0x88, 0xef, 0x4f, 0x63, 0x02, 0x93, 0x2d, 0x81, 0x07, 0x99, 0xc3, 0xee, 0x41, 0x19, 0x4a, 0x5b,
+	0x41, 0x20, 0x5f, 0xf3, 0x9d, 0x44, 0x4c, 0x51, 0xd8, 0x84, 0x8d, 0xa0, 0xde, 0x45, 0x8c, 0xfc,
+	0x34, 0x82, 0x7e, 0x37, 0x99, 0xb9, 0x7d, 0x1d, 0x59, 0x1f, 0xad, 0xcc, 0xcf, 0xf7, 0x97, 0x96,
+	0x4f, 0x0d, 0x16, 0xc5, 0x3a, 0xab, 0x4a, 0x2a, 0xbe, 0xeb, 0xd1, 0x8b, 0x70, 0x33, 0xc4, 0x1c,
+	0x27, 0xd3, 0x16, 0xd2, 0x67, 0x40, 0x8f, 0xe3, 0xaa, 0x51, 0x77, 0x42, 0xc6, 0x79, 0x1f, 0x47,
+	0xe5, 0xe1, 0xf3, 0xb4, 0xe9, 0x05, 0x75, 0xe6, 0xa9, 0x1c, 0x42, 0x4f, 0xe0, 0x56, 0xa5, 0x69,
+	0xc3, 0xb0, 0x99, 0x35, 0x38, 0xb8, 0x38, 0x52, 0x64, 0x1c, 0x78, 0xe6, 0x71, 0x69, 0x38, 0x9a,
+	0x74, 0x12, 0xf7, 0x7f, 0xf2, 0x0a, 0xc5, 0x42, 0xed, 0xbc, 0xe8, 0xa9, 0x9c, 0xa2, 0x3e, 0xd5,
+	0xeb, 0xad, 0x1b, 0x51, 0xdc, 0x86, 0x7b, 0x44, 0xa6, 0x2a, 0x69, 0x46, 0xb9, 0x86, 0x82, 0x73,
+	0x85, 0xd5, 0x8b, 0x93, 0x38, 0xec, 0xd1, 0x05, 0x5c, 0xea, 0x20, 0xde, 0xd8, 0xc3, 0x64, 0x2d,
+	0x86, 0x52, 0xe2, 0xb4, 0xc6, 0x44, 0x6d, 0xd6, 0x68, 0x04, 0x9e, 0x93, 0xf5, 0x9d, 0xd9, 0x8a,
+	0x87, 0x2e, 0xa2, 0xa2, 0x88, 0x31, 0x96, 0x2c, 0x5a, 0x13, 0x26, 0x6a, 0xe7, 0x00, 0x72, 0xc2,
+	0x3e, 0x44, 0xac, 0xba, 0xd5, 0x10, 0xdb, 0x8f, 0x33, 0xb8, 0x59, 0x29, 0xa8, 0x36, 0x0c, 0xd4,
+	0xca, 0xde, 0x6c, 0xbd, 0x89, 0x5c, 0x3f, 0x82, 0xa5, 0xa8, 0xea, 0x73, 0x2d, 0x5c, 0x7c, 0x1d,
+	0x81, 0x22, 0x67, 0x17, 0x37, 0x32, 0xf2, 0x9e, 0xb3, 0x18, 0x66, 0x6a, 0x76, 0x19, 0x6a, 0x99,
+	0x70, 0x5d, 0x89, 0x2f, 0x47, 0x90, 0xb3, 0xee, 0x51, 0x76, 0xc5, 0x6a, 0x6b, 0xe9, 0xfa, 0xf0,
+	0x2b, 0xa4, 0x26, 0xb1, 0xd4, 0x7d, 0x58, 0x1c, 0x25, 0x5d, 0x5c, 0x21, 0x71, 0x79, 0xf4, 0xc8,
+	0x98, 0x75, 0x2d, 0xb9, 0x7a, 0x94, 0xd5, 0x64, 0xf7, 0xa4, 0x6d, 0x1c, 0x40, 0x32, 0x0a, 0x5d,
+	0x87, 0x47, 0xf4, 0x51, 0xb1, 0x22, 0x35, 0x99, 0x5c, 0xf7, 0x06, 0xfa, 0xd8, 0x98, 0x75, 0x90,
+	0x5c, 0xb5, 0x2a, 0x1b, 0x5d, 0x37, 0x31, 0x37, 0x74, 0xa1, 0xd4, 0xd3, 0xc7, 0xc7, 0xb0, 0x37,
+	0xd7, 0xc2, 0xe9, 0xc5, 0xf2, 0x53, 0x63, 0x58, 0xe1, 0xca, 0x93, 0x9a, 0x17, 0x34, 0x23, 0x5c,
+	0xb9, 0x64, 0x9a, 0x62, 0xcb, 0x02, 0x14, 0xc8, 0x2f, 0x10, 0x68, 0x75, 0x8e, 0xab, 0x96, 0x8c,
+	0x96, 0x7f, 0x26, 0x0a, 0x39, 0xee, 0x57, 0x20, 0x1d, 0xc3, 0x73, 0xc1, 0xc9, 0x7d, 0xd5, 0xfd,
+	0x01, 0xb4, 0x66, 0x05, 0xc8, 0xd0, 0xee, 0x00, 0xea, 0x44, 0x01, 0xb0, 0xca, 0xde, 0x00, 0x5e,
+	0x75, 0x01, 0x56, 0xde, 0x19, 0x94, 0xef, 0x19, 0xda, 0x17, 0x80, 0xb3, 0x4b, 0x80, 0xc2, 0xae,
+	0x00, 0xca, 0x46, 0x51, 0x0c, 0x73, 0x4f, 0x60, 0x5c, 0x3e, 0x72, 0x47, 0x90, 0xd9, 0xa8, 0x15,
+	0x44, 0xb1, 0x19, 0x45, 0x9f, 0x14, 0xa3, 0xad, 0x58, 0xc9, 0x64, 0x51, 0x84, 0x33, 0x36, 0xcc,
+	0x03, 0x89, 0x2f, 0x86, 0x95, 0xfc, 0xf8, 0xbc, 0x18, 0x5a, 0xb1, 0x24, 0xa9, 0x60, 0xc6, 0x9e,
+	0x82, 0x7e, 0x65, 0x42, 0x8c, 0x63, 0x1c, 0xa5, 0xf1, 0x71, 0x2a, 0x69, 0x78, 0xac, 0x99, 0x75,
+	0xaf, 0x0d, 0xe6, 0x45, 0x9c, 0x3e, 0x31, 0x81, 0xe2, 0xab, 0xa8, 0x50, 0xab, 0x0c, 0x9d, 0x8f,
+	0xe9, 0x0f, 0x26, 0x8d, 0x34, 0x9d, 0xad, 0x12, 0x74, 0x8d, 0x82, 0xcc, 0x25, 0xb6, 0x05, 0x20,
+	0x2b, 0x4c, 0xa9, 0x50, 0x1e, 0x33, 0x20, 0xf3, 0x9b, 0x2a, 0xac, 0x70, 0x3c, 0x35, 0x29, 0x8a,
+	0x3f, 0xfe, 0x85, 0xe5, 0xc1, 0x49, 0xa3, 0xf4, 0xa9, 0x05, 0x84, 0xca, 0xe9, 0x06, 0x67, 0x98,
+	0x4c, 0x0f, 0x91, 0x99, 0xd5, 0x44, 0xc8, 0x8a, 0x4e, 0x04, 0x4d, 0xb6, 0x34, 0x1b, 0x0c, 0xa9,
+	0x17, 0x13, 0x12, 0x00, 0x24, 0x75, 0xc1, 0xc3, 0x9c, 0xbe, 0xa7, 0x6a, 0x84, 0xaf, 0xaa, 0xfd,
+	0x41, 0x07, 0x3a, 0x4b, 0x87, 0xfe, 0x45, 0xb4, 0xff, 0x22, 0x81, 0x15, 0x08, 0xa2, 0x47, 0x82,
+	0x16, 0x09, 0x07, 0x1f, 0xe8, 0xb8, 0x46, 0x7d, 0x3b, 0xd4, 0x94, 0xe0, 0xf4, 0x83, 0x95, 0xf7,
+	0xff, 0x62, 0x45, 0xa5, 0xc3, 0x91, 0x08, 0x1a, 0x2a, 0x89, 0x96, 0x6d, 0x89, 0xc2, 0xe2, 0x7f,
+	0xb2, 0xe5, 0x16, 0x99, 0xe6, 0x69, 0x43, 0xfa, 0x62, 0x4d, 0xa2, 0x8f, 0xd4, 0xea, 0xd7, 0x1f,
+	0x7d, 0xe3, 0xfc, 0xa9, 0xe5, 0x93, 0x2b, 0x37, 0x1c, 0x7c, 0xf3, 0xe0, 0xf4, 0xa1, 0xe6, 0x60,
+	0x30, 0xbf, 0xd0, 0xb7, 0x07, 0x8b, 0xcb, 0xc7, 0x4e, 0x2d, 0xf6, 0xcf, 0xc6, 0x83, 0xc1, 0xc2,
+	0xd2, 0xa1, 0xa5, 0x9b, 0x8e, 0x9d, 0x38, 0x31, 0x58, 0x38, 0x7e, 0x48, 0xfc, 0x19, 0xee, 0x90,
+	0xf8, 0x33, 0xdc, 0x0d, 0x53, 0xe2, 0x97, 0xeb, 0xfe, 0x07, 0x29, 0x53, 0x4e, 0x52, 0xa9, 0x1b,
+	0x00, 0x00,
 }
@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -135,11 +136,18 @@ const StatusCode_INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME = StatusCode(enums.S
 const StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST)
 const StatusCode_INIT_DOCKER_NETWORK_INVALID_MODE = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_INVALID_MODE)
 const StatusCode_INIT_DOCKER_NETWORK_PARSE_ERR = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_PARSE_ERR)
+const StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR = StatusCode(enums.StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR)
+const StatusCode_BUILD_SIGN_ERR = StatusCode(enums.StatusCode_BUILD_SIGN_ERR)
 const StatusCode_STATUSCHECK_IMAGE_PULL_ERR = StatusCode(enums.StatusCode_STATUSCHECK_IMAGE_PULL_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_CREATING)
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_JOB_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_FAILED)
+const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED)
+const StatusCode_STATUSCHECK_JOB_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_JOB_PENDING)
+const StatusCode_STATUSCHECK_POD_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_POD_PENDING)
+const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
@@ -212,6 +220,8 @@ const StatusCode_DEPLOY_MANIFEST_WRITE_ERR = StatusCode(enums.StatusCode_DEPLOY_
 const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR)
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
+const StatusCode_DEPLOY_ROLLBACK_ERR = StatusCode(enums.StatusCode_DEPLOY_ROLLBACK_ERR)
+const StatusCode_DEPLOY_VERIFY_SIGNATURE_ERR = StatusCode(enums.StatusCode_DEPLOY_VERIFY_SIGNATURE_ERR)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -225,6 +235,8 @@ const StatusCode_TEST_CUSTOM_CMD_RUN_ERR = StatusCode(enums.StatusCode_TEST_CUST
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR)
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR)
 const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR)
+const StatusCode_TEST_VERIFY_FAILED_ERR = StatusCode(enums.StatusCode_TEST_VERIFY_FAILED_ERR)
+const StatusCode_TEST_VERIFY_RUN_ERR = StatusCode(enums.StatusCode_TEST_VERIFY_RUN_ERR)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -236,6 +248,9 @@ const StatusCode_CONFIG_APPLY_PROFILES_ERR = StatusCode(enums.StatusCode_CONFIG_
 const StatusCode_CONFIG_DEFAULT_VALUES_ERR = StatusCode(enums.StatusCode_CONFIG_DEFAULT_VALUES_ERR)
 const StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR)
 const StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR = StatusCode(enums.StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR)
+const StatusCode_BUILD_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_BUILD_LIFECYCLE_HOOK_ERR)
+const StatusCode_SYNC_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_SYNC_LIFECYCLE_HOOK_ERR)
+const StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR)
 
 // SuggestionCode from public import enums/enums.proto
 type SuggestionCode = enums.SuggestionCode
@@ -295,6 +310,7 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_LIFECYCLE_HOOK = SuggestionCode(enums.SuggestionCode_CHECK_LIFECYCLE_HOOK)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -135,11 +136,18 @@ const StatusCode_INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME = StatusCode(enums.S
 const StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST)
 const StatusCode_INIT_DOCKER_NETWORK_INVALID_MODE = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_INVALID_MODE)
 const StatusCode_INIT_DOCKER_NETWORK_PARSE_ERR = StatusCode(enums.StatusCode_INIT_DOCKER_NETWORK_PARSE_ERR)
+const StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR = StatusCode(enums.StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH_ERR)
+const StatusCode_BUILD_SIGN_ERR = StatusCode(enums.StatusCode_BUILD_SIGN_ERR)
 const StatusCode_STATUSCHECK_IMAGE_PULL_ERR = StatusCode(enums.StatusCode_STATUSCHECK_IMAGE_PULL_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_CREATING)
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_JOB_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_FAILED)
+const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED)
+const StatusCode_STATUSCHECK_JOB_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_JOB_PENDING)
+const StatusCode_STATUSCHECK_POD_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_POD_PENDING)
+const StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
@@ -212,6 +220,8 @@ const StatusCode_DEPLOY_MANIFEST_WRITE_ERR = StatusCode(enums.StatusCode_DEPLOY_
 const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR)
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
+const StatusCode_DEPLOY_ROLLBACK_ERR = StatusCode(enums.StatusCode_DEPLOY_ROLLBACK_ERR)
+const StatusCode_DEPLOY_VERIFY_SIGNATURE_ERR = StatusCode(enums.StatusCode_DEPLOY_VERIFY_SIGNATURE_ERR)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -225,6 +235,8 @@ const StatusCode_TEST_CUSTOM_CMD_RUN_ERR = StatusCode(enums.StatusCode_TEST_CUST
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR)
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR)
 const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR)
+const StatusCode_TEST_VERIFY_FAILED_ERR = StatusCode(enums.StatusCode_TEST_VERIFY_FAILED_ERR)
+const StatusCode_TEST_VERIFY_RUN_ERR = StatusCode(enums.StatusCode_TEST_VERIFY_RUN_ERR)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -236,6 +248,9 @@ const StatusCode_CONFIG_APPLY_PROFILES_ERR = StatusCode(enums.StatusCode_CONFIG_
 const StatusCode_CONFIG_DEFAULT_VALUES_ERR = StatusCode(enums.StatusCode_CONFIG_DEFAULT_VALUES_ERR)
 const StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR)
 const StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR = StatusCode(enums.StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR)
+const StatusCode_BUILD_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_BUILD_LIFECYCLE_HOOK_ERR)
+const StatusCode_SYNC_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_SYNC_LIFECYCLE_HOOK_ERR)
+const StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR = StatusCode(enums.StatusCode_DEPLOY_LIFECYCLE_HOOK_ERR)
 
 // SuggestionCode from public import enums/enums.proto
 type SuggestionCode = enums.SuggestionCode
@@ -295,6 +310,7 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_LIFECYCLE_HOOK = SuggestionCode(enums.SuggestionCode_CHECK_LIFECYCLE_HOOK)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -2035,20 +2051,22 @@ func (m *StatusCheckSubtaskEvent) GetActionableErr() *ActionableErr {
 
 // PortForwardEvent Event describes each port forwarding event.
 type PortForwardEvent struct {
-	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId               string       `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	LocalPort            int32        `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
-	PodName              string       `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
-	ContainerName        string       `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
-	Namespace            string       `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PortName             string       `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
-	ResourceType         string       `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
-	ResourceName         string       `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
-	Address              string       `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
-	TargetPort           *IntOrString `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId               string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	LocalPort            int32          `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	PodName              string         `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string         `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string         `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string         `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
+	ResourceType         string         `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	ResourceName         string         `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Address              string         `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	TargetPort           *IntOrString   `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	Status               string         `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,13,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *PortForwardEvent) Reset()         { *m = PortForwardEvent{} }
@@ -2153,6 +2171,20 @@ func (m *PortForwardEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortForwardEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PortForwardEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2697,142 +2729,142 @@ func init() {
 func init() { proto.RegisterFile("v2/skaffold.proto", fileDescriptor_39088757fd9c8e40) }
 
 var fileDescriptor_39088757fd9c8e40 = []byte{
-	// 2158 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x73, 0x1c, 0x47,
-	0x15, 0xd7, 0xee, 0x6a, 0xff, 0xcc, 0x5b, 0x49, 0x96, 0x5a, 0xb6, 0xb4, 0xac, 0xe5, 0xc4, 0x1e,
-	0x1c, 0x70, 0x12, 0x67, 0xd7, 0x92, 0x21, 0x4e, 0xb9, 0x10, 0x41, 0x52, 0x64, 0x49, 0xd8, 0xc4,
-	0x71, 0x4b, 0xc9, 0x01, 0x48, 0xb9, 0x46, 0x3b, 0xad, 0xf5, 0x94, 0x76, 0x67, 0x96, 0x99, 0x1e,
-	0x85, 0xbd, 0x51, 0x14, 0x55, 0x70, 0x86, 0x9c, 0x38, 0x71, 0xe0, 0xc2, 0x89, 0x0b, 0xdf, 0x80,
-	0x8f, 0x40, 0x15, 0x77, 0xaa, 0x38, 0x50, 0x14, 0x1f, 0x82, 0xea, 0xd7, 0xdd, 0x33, 0x3d, 0x33,
-	0xbb, 0x96, 0x64, 0x97, 0x2b, 0x17, 0x69, 0xba, 0xfb, 0xf7, 0x5e, 0xbf, 0xf7, 0xfa, 0xd7, 0xaf,
-	0x5f, 0xf7, 0xc2, 0xd2, 0xd9, 0x46, 0x37, 0x3a, 0x75, 0x4e, 0x4e, 0x82, 0x81, 0xdb, 0x19, 0x85,
-	0x01, 0x0f, 0x48, 0x03, 0xff, 0x75, 0xce, 0x36, 0xda, 0x6b, 0xfd, 0x20, 0xe8, 0x0f, 0x58, 0xd7,
-	0x19, 0x79, 0x5d, 0xc7, 0xf7, 0x03, 0xee, 0x70, 0x2f, 0xf0, 0x23, 0x89, 0x6b, 0xbf, 0xad, 0x46,
-	0xb1, 0x75, 0x1c, 0x9f, 0x74, 0xb9, 0x37, 0x64, 0x11, 0x77, 0x86, 0x23, 0x05, 0xb8, 0x9e, 0x07,
-	0xb0, 0xe1, 0x88, 0x8f, 0xd5, 0xe0, 0x12, 0xf3, 0xe3, 0x61, 0xd4, 0xc5, 0xbf, 0xb2, 0xcb, 0xfe,
-	0x10, 0xe6, 0x0f, 0xb9, 0xc3, 0x19, 0x65, 0xd1, 0x28, 0xf0, 0x23, 0x46, 0xde, 0x81, 0x6a, 0x24,
-	0x3a, 0x5a, 0xa5, 0x9b, 0xa5, 0x3b, 0xcd, 0x8d, 0x2b, 0x1d, 0x6d, 0x59, 0x47, 0xe2, 0xe4, 0xa8,
-	0xbd, 0x06, 0x8d, 0x44, 0x64, 0x11, 0x2a, 0xc3, 0xa8, 0x8f, 0x02, 0x16, 0x15, 0x9f, 0xf6, 0x0d,
-	0xa8, 0x53, 0xf6, 0x8b, 0x98, 0x45, 0x9c, 0x10, 0x98, 0xf5, 0x9d, 0x21, 0x53, 0xa3, 0xf8, 0x6d,
-	0xff, 0x6b, 0x16, 0xaa, 0xa8, 0x8d, 0x7c, 0x0f, 0xe0, 0x38, 0xf6, 0x06, 0xee, 0xa1, 0x31, 0xe5,
-	0xd5, 0x74, 0xca, 0xed, 0x64, 0x8c, 0x1a, 0x38, 0xf2, 0x00, 0x9a, 0x2e, 0x1b, 0x0d, 0x82, 0xb1,
-	0x14, 0x2b, 0xa3, 0xd8, 0xb5, 0x54, 0xec, 0x93, 0x74, 0x90, 0x9a, 0x48, 0xf2, 0x18, 0x16, 0x4e,
-	0x82, 0xf0, 0x2b, 0x27, 0x74, 0x99, 0xfb, 0x59, 0x10, 0xf2, 0xa8, 0x55, 0xb9, 0x59, 0xb9, 0xd3,
-	0xdc, 0xf8, 0x76, 0xce, 0xcb, 0xce, 0xa3, 0x0c, 0x6a, 0xd7, 0xe7, 0xe1, 0x98, 0xe6, 0x44, 0xc9,
-	0x23, 0x58, 0x14, 0xb1, 0x88, 0xa3, 0x9d, 0x17, 0xac, 0x77, 0x2a, 0x4d, 0x99, 0x45, 0x53, 0xda,
-	0x59, 0x75, 0x26, 0x82, 0x16, 0x64, 0xc8, 0x26, 0xcc, 0x9f, 0x78, 0x03, 0x76, 0x38, 0xf6, 0x7b,
-	0x52, 0x49, 0x15, 0x95, 0xac, 0xa6, 0x4a, 0x1e, 0x99, 0xc3, 0x34, 0x8b, 0x26, 0x87, 0xb0, 0xec,
-	0xb2, 0xe3, 0xb8, 0xdf, 0xf7, 0xfc, 0xfe, 0x4e, 0xe0, 0x73, 0xc7, 0xf3, 0x59, 0x18, 0xb5, 0x6a,
-	0xe8, 0xd8, 0x2d, 0x33, 0x28, 0x79, 0xd0, 0xee, 0x19, 0xf3, 0x39, 0x9d, 0x24, 0x4d, 0x3a, 0xd0,
-	0x18, 0x32, 0xee, 0xb8, 0x0e, 0x77, 0x5a, 0x75, 0x34, 0x87, 0xa4, 0x9a, 0x7e, 0xa2, 0x46, 0x68,
-	0x82, 0x21, 0xeb, 0x60, 0x71, 0x16, 0x71, 0x69, 0x7f, 0x03, 0x05, 0x96, 0x53, 0x81, 0x23, 0x3d,
-	0x44, 0x53, 0x54, 0xfb, 0x4b, 0x58, 0x9e, 0x10, 0x65, 0x41, 0xa6, 0x53, 0x36, 0x46, 0x2a, 0x54,
-	0xa9, 0xf8, 0x24, 0xf7, 0xa0, 0x7a, 0xe6, 0x0c, 0x62, 0xbd, 0xce, 0x46, 0x70, 0x85, 0x98, 0xd2,
-	0x21, 0x7d, 0x91, 0xc0, 0x87, 0xe5, 0x8f, 0x4a, 0xf6, 0x1f, 0xcb, 0xd0, 0xd0, 0x86, 0x92, 0x0f,
-	0xa0, 0x8a, 0xf4, 0x51, 0x0c, 0x5b, 0xcd, 0x31, 0x2c, 0x71, 0x48, 0xa2, 0xc8, 0x3d, 0xa8, 0x49,
-	0xd6, 0xa8, 0x29, 0x5b, 0x79, 0x6a, 0x25, 0x02, 0x0a, 0x47, 0xde, 0x83, 0x59, 0xe1, 0x59, 0xab,
-	0x82, 0xf8, 0x95, 0xac, 0xeb, 0x09, 0x1a, 0x31, 0x64, 0x1b, 0xc0, 0x71, 0x5d, 0x4f, 0x6c, 0x6b,
-	0x67, 0xd0, 0xea, 0xe1, 0x3a, 0xd9, 0xc5, 0xe8, 0x76, 0xb6, 0x12, 0x90, 0xe4, 0x9f, 0x21, 0xd5,
-	0xde, 0x84, 0x2b, 0xb9, 0x61, 0x33, 0x70, 0x96, 0x0c, 0xdc, 0x55, 0x33, 0x70, 0x96, 0x19, 0x9c,
-	0xdf, 0x56, 0x60, 0x3e, 0xe3, 0x39, 0xb9, 0x0b, 0x4b, 0x7e, 0x3c, 0x3c, 0x66, 0xe1, 0xd3, 0x93,
-	0xad, 0x90, 0x7b, 0x27, 0x4e, 0x8f, 0x47, 0x6a, 0x11, 0x8a, 0x03, 0xe4, 0x47, 0xd0, 0xc0, 0x48,
-	0x09, 0xa2, 0x95, 0xd1, 0x81, 0xdb, 0x53, 0x42, 0xda, 0x39, 0x18, 0x3a, 0x7d, 0xb6, 0x2d, 0xc1,
-	0x34, 0x91, 0xc2, 0x80, 0x8d, 0x47, 0x0c, 0x03, 0xb6, 0x90, 0x04, 0x4c, 0x66, 0x26, 0x44, 0x1f,
-	0x8d, 0x47, 0x8c, 0x22, 0x86, 0xec, 0x4d, 0x08, 0xd8, 0x77, 0xa7, 0xcd, 0xf7, 0xb2, 0xa8, 0x51,
-	0x98, 0x33, 0xcd, 0x21, 0x77, 0x95, 0x11, 0x25, 0x34, 0xa2, 0x55, 0x34, 0x82, 0x85, 0x86, 0x19,
-	0x57, 0xa1, 0xda, 0x0b, 0x62, 0x9f, 0x63, 0x38, 0xab, 0x54, 0x36, 0x5e, 0x77, 0x25, 0xbe, 0x2e,
-	0xc1, 0x9c, 0xc9, 0x11, 0xf2, 0x00, 0xea, 0xa2, 0x2d, 0x22, 0x5b, 0x42, 0x4f, 0x6f, 0x4c, 0x26,
-	0x53, 0x47, 0xa2, 0xa8, 0x46, 0xb7, 0x1f, 0x43, 0x4d, 0x7e, 0x92, 0xf7, 0x33, 0x6e, 0xad, 0x66,
-	0xdc, 0x92, 0x90, 0xf3, 0xbc, 0xb2, 0xff, 0x59, 0x82, 0x85, 0x2c, 0xd5, 0xc9, 0xc7, 0x60, 0x49,
-	0xb2, 0xa7, 0xa6, 0xdd, 0x9a, 0xb6, 0x2f, 0x54, 0x93, 0x85, 0x34, 0x95, 0x21, 0x1b, 0x50, 0xef,
-	0x0d, 0x62, 0x31, 0x3d, 0xce, 0x95, 0x0f, 0xf8, 0x8e, 0x1c, 0x43, 0xd3, 0x34, 0xb0, 0xfd, 0x14,
-	0x1a, 0x5a, 0x15, 0xf9, 0x20, 0xe3, 0xd6, 0xb7, 0x32, 0xc2, 0x1a, 0x74, 0xae, 0x63, 0xff, 0x29,
-	0x01, 0xa4, 0xa7, 0x0a, 0xd9, 0x02, 0xcb, 0x31, 0xe8, 0x9e, 0x3b, 0x0b, 0x52, 0x60, 0x27, 0xe1,
-	0xbe, 0x64, 0x55, 0x2a, 0x45, 0x6e, 0x42, 0xd3, 0x89, 0x79, 0x70, 0x14, 0x7a, 0xfd, 0xbe, 0x72,
-	0xad, 0x41, 0xcd, 0x2e, 0xf2, 0x00, 0x40, 0x25, 0xfd, 0xc0, 0xd5, 0x8c, 0xcf, 0xae, 0xca, 0x61,
-	0x32, 0x4c, 0x0d, 0x68, 0xfb, 0x07, 0xb0, 0x90, 0x9d, 0xf7, 0x52, 0xd4, 0xfa, 0x39, 0x58, 0x49,
-	0xe2, 0x25, 0x2b, 0x50, 0x93, 0x8a, 0x95, 0xac, 0x6a, 0xe5, 0x6c, 0x2b, 0x5f, 0xd8, 0x36, 0xfb,
-	0x57, 0x25, 0x68, 0x1a, 0xe7, 0xec, 0xd4, 0x09, 0xde, 0x5c, 0x78, 0xec, 0xff, 0x96, 0x60, 0x31,
-	0x7f, 0xbe, 0x4e, 0xb5, 0x63, 0x0f, 0xac, 0x90, 0x45, 0x41, 0x1c, 0xf6, 0x98, 0xce, 0x59, 0xef,
-	0x4e, 0x3f, 0xa6, 0x3b, 0x54, 0x63, 0xd5, 0x7a, 0x27, 0xb2, 0xaf, 0xb5, 0x9a, 0x59, 0xad, 0x97,
-	0x5a, 0xcd, 0x03, 0x98, 0xcf, 0x94, 0x01, 0xaf, 0x1e, 0x70, 0xfb, 0x6f, 0x75, 0xa8, 0xe2, 0x79,
-	0x49, 0x3e, 0x02, 0x2b, 0x29, 0x20, 0xd5, 0xd9, 0xd8, 0xee, 0xc8, 0x0a, 0xb2, 0xa3, 0x2b, 0xc8,
-	0xce, 0x91, 0x46, 0xd0, 0x14, 0x4c, 0xee, 0x83, 0x25, 0x0e, 0x7f, 0x54, 0xa3, 0x4e, 0xc9, 0xe5,
-	0xec, 0x19, 0x86, 0x43, 0xfb, 0x33, 0x34, 0xc5, 0x91, 0x7d, 0x58, 0xd4, 0x75, 0xef, 0x93, 0xa0,
-	0x2f, 0x65, 0x2b, 0x85, 0x8a, 0x29, 0x87, 0xd8, 0x9f, 0xa1, 0x05, 0x29, 0xf2, 0x0c, 0x96, 0x9d,
-	0xd1, 0x68, 0xe0, 0xf5, 0xb0, 0x3a, 0x4e, 0x94, 0xc9, 0xf2, 0xcb, 0xc8, 0x98, 0x5b, 0x45, 0xd0,
-	0xfe, 0x0c, 0x9d, 0x24, 0x2b, 0x3c, 0xe2, 0x4e, 0x74, 0x2a, 0x15, 0x55, 0x0b, 0x25, 0x8c, 0x1e,
-	0x12, 0x1e, 0x25, 0x38, 0xf2, 0x18, 0x96, 0x64, 0x5d, 0x1a, 0x1f, 0xa7, 0xc2, 0x35, 0x14, 0xbe,
-	0x9e, 0xcf, 0x23, 0x06, 0x64, 0x7f, 0x86, 0x16, 0xe5, 0xc8, 0xa7, 0x40, 0x54, 0xb1, 0x6a, 0x6a,
-	0x93, 0xe5, 0xd7, 0x5a, 0xa1, 0xba, 0xcd, 0xaa, 0x9b, 0x20, 0x49, 0x1e, 0x82, 0x35, 0x0a, 0x42,
-	0x2e, 0xd5, 0x34, 0xce, 0x2b, 0x9e, 0x84, 0x63, 0x09, 0x9c, 0x7c, 0x09, 0xab, 0x66, 0xa1, 0x6a,
-	0x1a, 0x64, 0xa1, 0xa6, 0x5b, 0x93, 0x37, 0x4f, 0xd6, 0xaa, 0x69, 0x3a, 0xc8, 0xc7, 0x69, 0xcd,
-	0x2b, 0x95, 0xc2, 0xb4, 0x9a, 0x57, 0xab, 0xca, 0xe2, 0x85, 0x7d, 0xee, 0xe4, 0x82, 0xb6, 0xd5,
-	0xcc, 0xdb, 0x37, 0xa5, 0xf2, 0x15, 0xf6, 0x4d, 0xd1, 0x21, 0x98, 0xca, 0x59, 0x38, 0xf4, 0x7c,
-	0xe4, 0x88, 0xd4, 0x3b, 0x97, 0x8f, 0xe0, 0x51, 0x0e, 0x21, 0x98, 0x9a, 0x97, 0x12, 0x8b, 0x20,
-	0xaa, 0x3e, 0xa9, 0x62, 0xbe, 0xa8, 0x22, 0xe2, 0xb9, 0x98, 0xa5, 0xf0, 0xed, 0x39, 0x00, 0x26,
-	0x3e, 0x9e, 0x8b, 0x03, 0xcd, 0xfe, 0x1c, 0x16, 0xf3, 0x33, 0x4e, 0x4d, 0x02, 0xef, 0x42, 0x85,
-	0x85, 0xa1, 0xda, 0x98, 0x46, 0x54, 0xb7, 0x7a, 0x58, 0xa8, 0x1c, 0x0f, 0xd8, 0x6e, 0x18, 0x52,
-	0x81, 0x11, 0x15, 0xc8, 0x7c, 0xa6, 0x9b, 0xac, 0x43, 0x9d, 0x85, 0x21, 0xa6, 0xb7, 0xd2, 0xcb,
-	0xd3, 0x9b, 0xc6, 0x91, 0x16, 0xd4, 0x87, 0x2c, 0x8a, 0x9c, 0xbe, 0xce, 0x5c, 0xba, 0x49, 0x3e,
-	0x84, 0x66, 0x14, 0xf7, 0xfb, 0x2c, 0xc2, 0x6b, 0xac, 0xba, 0x6f, 0x19, 0x57, 0xbc, 0xc3, 0x64,
-	0x90, 0x9a, 0x40, 0xfb, 0x19, 0x58, 0x49, 0x16, 0x11, 0x69, 0x91, 0x89, 0x8c, 0xa9, 0xbc, 0x94,
-	0x8d, 0xcc, 0x25, 0xa5, 0x7c, 0xfe, 0x25, 0xc5, 0xfe, 0x8b, 0x38, 0x2f, 0xf2, 0x99, 0x64, 0x15,
-	0xea, 0x22, 0xfa, 0xcf, 0x3d, 0x57, 0x87, 0x50, 0x34, 0x0f, 0x5c, 0x72, 0x03, 0x20, 0x92, 0x2b,
-	0x23, 0xc6, 0xa4, 0x57, 0x96, 0xea, 0x39, 0x70, 0x45, 0xe4, 0x83, 0xd0, 0xeb, 0x7b, 0x3e, 0x66,
-	0x30, 0x8b, 0xaa, 0x16, 0x79, 0x1f, 0xaa, 0x03, 0x76, 0xc6, 0x06, 0x98, 0x8b, 0x16, 0x92, 0x5b,
-	0xa9, 0x0c, 0xdd, 0x93, 0xa0, 0xff, 0x44, 0x0c, 0x52, 0x89, 0x31, 0xc3, 0x56, 0xcd, 0x84, 0xcd,
-	0x0e, 0x60, 0x79, 0x42, 0xee, 0x22, 0xb7, 0x61, 0xbe, 0xa7, 0x99, 0xfa, 0x69, 0x7a, 0xad, 0xce,
-	0x76, 0x0a, 0xb5, 0xa3, 0xc0, 0xc5, 0x71, 0xb5, 0x1a, 0xaa, 0x69, 0x4e, 0x58, 0xc9, 0x4e, 0xf8,
-	0xe7, 0x12, 0x58, 0x49, 0x92, 0x23, 0x0b, 0x50, 0x4e, 0x02, 0x52, 0xf6, 0x5c, 0x71, 0x8b, 0x17,
-	0x7e, 0x2b, 0x75, 0xf8, 0x4d, 0xd6, 0xc0, 0xf2, 0x38, 0x0b, 0xd1, 0x40, 0xd4, 0x56, 0xa5, 0x69,
-	0x87, 0xc1, 0xcc, 0xd9, 0x0c, 0x33, 0x37, 0x61, 0xde, 0x31, 0xd9, 0x56, 0xbc, 0xed, 0x66, 0x39,
-	0x9a, 0x45, 0xdb, 0x7f, 0x2d, 0xc1, 0x52, 0x21, 0x9d, 0x16, 0xcc, 0x35, 0x16, 0xb5, 0x9c, 0x59,
-	0xd4, 0x36, 0x34, 0x74, 0xe5, 0xa6, 0x02, 0x90, 0xb4, 0xdf, 0x94, 0xc5, 0xbf, 0x2f, 0x89, 0x7d,
-	0x9b, 0xdd, 0xe6, 0x17, 0x37, 0x38, 0x35, 0xaa, 0xf2, 0x72, 0xa3, 0x66, 0x2f, 0x65, 0xd4, 0xd7,
-	0x25, 0x20, 0xc5, 0x73, 0xe4, 0x1b, 0x37, 0xeb, 0x77, 0x65, 0x58, 0x9d, 0x72, 0x9a, 0x5c, 0x6a,
-	0x8d, 0x75, 0xb5, 0xa6, 0xd7, 0x58, 0xb7, 0xa7, 0xae, 0xf1, 0xd4, 0x8d, 0x98, 0x2b, 0xf7, 0x6a,
-	0x17, 0x2e, 0xf7, 0x8a, 0xa1, 0xa8, 0x5f, 0x2a, 0x14, 0xff, 0x2b, 0xc3, 0x62, 0xfe, 0x88, 0xbe,
-	0x78, 0x0c, 0xd6, 0xc0, 0x1a, 0x04, 0x3d, 0x67, 0x20, 0x34, 0xe8, 0xbd, 0x99, 0x74, 0x98, 0xf9,
-	0x61, 0x36, 0x9b, 0x1f, 0x0a, 0xf9, 0xa5, 0x3a, 0x29, 0xbf, 0xac, 0x81, 0xe5, 0x3b, 0x43, 0x16,
-	0x8d, 0x9c, 0x9e, 0x0c, 0x89, 0x45, 0xd3, 0x0e, 0x11, 0x7f, 0x51, 0x47, 0xa0, 0x78, 0x5d, 0xc6,
-	0x5f, 0xb7, 0x89, 0x0d, 0x73, 0x7a, 0x2d, 0xc4, 0x55, 0x0d, 0xab, 0x12, 0x8b, 0x66, 0xfa, 0x4c,
-	0x0c, 0xea, 0xb0, 0xb2, 0x18, 0x9d, 0xc7, 0x1c, 0xd7, 0x0d, 0x59, 0x14, 0x61, 0xe5, 0x60, 0x51,
-	0xdd, 0x24, 0xdf, 0x07, 0xe0, 0x4e, 0xd8, 0x67, 0x1c, 0x5d, 0x6f, 0xe6, 0x9f, 0x06, 0x0f, 0x7c,
-	0xfe, 0x34, 0x3c, 0xe4, 0xa1, 0xe7, 0xf7, 0xa9, 0x01, 0xb4, 0xff, 0x5e, 0x4a, 0xeb, 0xeb, 0xcb,
-	0xc7, 0x5a, 0xd4, 0x26, 0x3b, 0x78, 0xd9, 0x54, 0xb1, 0x4e, 0x3a, 0xc4, 0xd1, 0xe5, 0x0d, 0x05,
-	0xaf, 0x64, 0xa4, 0x65, 0xc3, 0xe0, 0x61, 0xf5, 0xe5, 0xfb, 0xa7, 0x76, 0x29, 0xd2, 0xfc, 0xa9,
-	0x02, 0xab, 0x53, 0xaa, 0x9d, 0xd7, 0xdf, 0xdb, 0x6f, 0x9c, 0x35, 0x49, 0x66, 0xae, 0xe7, 0x32,
-	0x73, 0x0b, 0xea, 0x61, 0xec, 0x8b, 0xcb, 0x87, 0x22, 0x8c, 0x6e, 0x92, 0xb7, 0x00, 0xbe, 0x0a,
-	0xc2, 0x53, 0xcf, 0xef, 0x7f, 0xe2, 0x85, 0x8a, 0x29, 0x46, 0x0f, 0x79, 0x06, 0x80, 0x25, 0x9e,
-	0x7c, 0xec, 0x05, 0x2c, 0x3e, 0xd6, 0xcf, 0xad, 0x0c, 0x65, 0xbf, 0xf1, 0xf4, 0x6b, 0x28, 0x69,
-	0x6f, 0xc2, 0x95, 0xdc, 0xf0, 0x79, 0xf7, 0xb8, 0x79, 0xf3, 0x1e, 0xb7, 0x09, 0x4b, 0x9f, 0x47,
-	0x2c, 0x3c, 0xf0, 0x39, 0xf3, 0xb9, 0x7e, 0x24, 0xbf, 0x03, 0x35, 0x0f, 0x3b, 0xd4, 0x25, 0x6c,
-	0x31, 0x43, 0x58, 0x01, 0x54, 0xe3, 0xf6, 0x0f, 0x61, 0x41, 0x5d, 0xe3, 0xb4, 0xec, 0xdd, 0xec,
-	0x83, 0xbd, 0xf9, 0xf6, 0x28, 0x81, 0x99, 0x77, 0xfb, 0x75, 0x98, 0x33, 0xbb, 0x49, 0x1b, 0xea,
-	0x0c, 0xe9, 0x23, 0xa9, 0xd1, 0xd8, 0x9f, 0xa1, 0xba, 0x63, 0xbb, 0x0a, 0x95, 0x33, 0x67, 0x60,
-	0xff, 0x18, 0x6a, 0xd2, 0x08, 0xe1, 0x55, 0xfa, 0x8c, 0xda, 0xd0, 0xaf, 0xa5, 0x04, 0x66, 0xa3,
-	0xb1, 0xdf, 0x53, 0x37, 0x4d, 0xfc, 0x16, 0x1c, 0x52, 0x2f, 0xa8, 0x15, 0xec, 0x55, 0x2d, 0xdb,
-	0x03, 0x48, 0x0b, 0x3e, 0xb2, 0x03, 0x0b, 0x69, 0xc9, 0x67, 0xd4, 0x9b, 0xd7, 0xb3, 0xf9, 0x35,
-	0x03, 0xa1, 0x39, 0x11, 0x31, 0x95, 0xdc, 0x04, 0x9a, 0xc6, 0xb2, 0x65, 0x3f, 0x83, 0xa6, 0xb1,
-	0xd9, 0xb1, 0x82, 0xd1, 0xaf, 0x47, 0x55, 0xf5, 0x44, 0xb4, 0x82, 0x61, 0xff, 0xc2, 0x19, 0xa8,
-	0x37, 0x22, 0xd5, 0x92, 0x3b, 0x20, 0x14, 0xfd, 0xc9, 0x0e, 0x10, 0xad, 0x8d, 0xdf, 0x54, 0x61,
-	0x49, 0x17, 0x90, 0x5f, 0x6c, 0x1c, 0xb2, 0xf0, 0xcc, 0xeb, 0x31, 0xf2, 0x08, 0x1a, 0x7b, 0x4c,
-	0x3f, 0xb3, 0x14, 0x6e, 0xcf, 0xbb, 0xc3, 0x11, 0x1f, 0xb7, 0xf3, 0x3f, 0xa3, 0xd8, 0x4b, 0xbf,
-	0xfe, 0xc7, 0xbf, 0xff, 0x50, 0x6e, 0x12, 0xab, 0x7b, 0xb6, 0xd1, 0xc5, 0xa5, 0x21, 0x7b, 0x50,
-	0x43, 0xf6, 0x45, 0x17, 0xd1, 0x82, 0x48, 0x9b, 0xa0, 0x96, 0x39, 0x02, 0x42, 0x0b, 0x5e, 0x15,
-	0xa2, 0x7b, 0x25, 0xf2, 0x33, 0xa8, 0xef, 0xfe, 0x92, 0xf5, 0x62, 0xce, 0x88, 0x71, 0x09, 0x2d,
-	0xb0, 0xae, 0x3d, 0x65, 0x1a, 0xfb, 0x3a, 0x6a, 0xbd, 0x66, 0x37, 0x51, 0xab, 0xd4, 0xf4, 0x50,
-	0x11, 0x90, 0xb8, 0x60, 0x6d, 0xc5, 0x3c, 0xc0, 0x1a, 0x8c, 0xb4, 0x0a, 0x64, 0x3b, 0x4f, 0xf7,
-	0x3b, 0xa8, 0xfb, 0xed, 0xf6, 0x8a, 0xd0, 0x8d, 0xfc, 0xe9, 0x3a, 0x31, 0x0f, 0x9e, 0xeb, 0x69,
-	0x24, 0x4d, 0xc9, 0x31, 0x34, 0xc4, 0x2c, 0x22, 0x1b, 0xbf, 0xc2, 0x24, 0xb7, 0x71, 0x92, 0xb7,
-	0xda, 0xd7, 0x30, 0xb8, 0x63, 0xbf, 0x37, 0x71, 0x8e, 0x13, 0x00, 0x31, 0x87, 0x2c, 0x83, 0x5e,
-	0x61, 0x96, 0xef, 0xe0, 0x2c, 0x37, 0xdb, 0xab, 0x62, 0x16, 0xc9, 0xef, 0x89, 0xf3, 0x3c, 0x85,
-	0xda, 0xbe, 0xe3, 0xbb, 0x03, 0x46, 0xf2, 0xeb, 0x37, 0x55, 0xf5, 0x1a, 0xaa, 0x5e, 0xb1, 0x97,
-	0xd2, 0x75, 0xed, 0xbe, 0x40, 0x1d, 0x0f, 0x4b, 0xef, 0x6d, 0xdf, 0xff, 0xe9, 0x7a, 0xdf, 0xe3,
-	0x2f, 0xe2, 0xe3, 0x4e, 0x2f, 0x18, 0x76, 0xf7, 0x50, 0x43, 0x92, 0xc0, 0x8e, 0x82, 0x60, 0x10,
-	0x25, 0x3f, 0x2f, 0xca, 0xdf, 0x01, 0xbb, 0x67, 0x1b, 0x9f, 0x55, 0x8e, 0x6b, 0xf8, 0x7d, 0xff,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x91, 0xe8, 0xd4, 0x7f, 0x1c, 0x00, 0x00,
+	// 2153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xc5, 0x59, 0xcd, 0x73, 0x1b, 0xb7,
+	0x15, 0x2f, 0x49, 0x2d, 0xc9, 0x7d, 0x14, 0x15, 0x11, 0x8a, 0x25, 0x86, 0x96, 0x13, 0x7b, 0xe3,
+	0xb4, 0xf9, 0x24, 0x63, 0xb9, 0xf9, 0x18, 0x4f, 0xdd, 0x56, 0x52, 0x64, 0xd9, 0xcd, 0x87, 0x93,
+	0x95, 0x92, 0x43, 0xdb, 0x8c, 0x67, 0x49, 0x42, 0xf4, 0x8e, 0x48, 0x2e, 0xbb, 0xbb, 0x54, 0xa2,
+	0x5b, 0xa7, 0xd3, 0x99, 0xf6, 0xdc, 0xf6, 0xd4, 0x53, 0x0f, 0xbd, 0xf4, 0xd4, 0x4b, 0xff, 0x83,
+	0xfe, 0x09, 0x99, 0xc9, 0x3d, 0x33, 0x39, 0x74, 0xfa, 0x57, 0x14, 0x78, 0x00, 0x76, 0x81, 0x5d,
+	0xae, 0x3e, 0xec, 0xf1, 0xf4, 0x22, 0x2d, 0x80, 0xdf, 0xfb, 0x7e, 0x78, 0x78, 0x00, 0xa1, 0x75,
+	0xb2, 0xd5, 0x8b, 0x8e, 0xbd, 0xa3, 0xa3, 0x60, 0x3c, 0xec, 0xce, 0xc2, 0x20, 0x0e, 0x48, 0x1d,
+	0xff, 0x75, 0x4f, 0xb6, 0x3a, 0x9b, 0xa3, 0x20, 0x18, 0x8d, 0x69, 0xcf, 0x9b, 0xf9, 0x3d, 0x6f,
+	0x3a, 0x0d, 0x62, 0x2f, 0xf6, 0x83, 0x69, 0x24, 0x70, 0x9d, 0x97, 0xe4, 0x2a, 0x8e, 0xfa, 0xf3,
+	0xa3, 0x5e, 0xec, 0x4f, 0x68, 0x14, 0x7b, 0x93, 0x99, 0x04, 0x5c, 0xcd, 0x02, 0xe8, 0x64, 0x16,
+	0x9f, 0xca, 0xc5, 0x16, 0x9d, 0xce, 0x27, 0x51, 0x0f, 0xff, 0x8a, 0x29, 0xe7, 0x5d, 0x68, 0x1e,
+	0x30, 0x11, 0xd4, 0xa5, 0xd1, 0x8c, 0x89, 0xa1, 0xe4, 0x15, 0xb0, 0x22, 0x3e, 0xd1, 0x2e, 0x5d,
+	0x2f, 0xbd, 0xda, 0xd8, 0x7a, 0xae, 0xab, 0x34, 0xeb, 0x0a, 0x9c, 0x58, 0x75, 0x36, 0xa1, 0x9e,
+	0x90, 0xac, 0x42, 0x65, 0x12, 0x8d, 0x90, 0xc0, 0x76, 0xf9, 0xa7, 0x73, 0x0d, 0x6a, 0x2e, 0xfd,
+	0xcd, 0x9c, 0x69, 0x46, 0x08, 0x2c, 0x4d, 0xbd, 0x09, 0x95, 0xab, 0xf8, 0xed, 0x7c, 0xb7, 0x04,
+	0x16, 0x72, 0x23, 0x3f, 0x06, 0xe8, 0xcf, 0xfd, 0xf1, 0xf0, 0x40, 0x13, 0xf9, 0x7c, 0x2a, 0x72,
+	0x27, 0x59, 0x73, 0x35, 0x1c, 0x79, 0x0f, 0x1a, 0x43, 0x3a, 0x1b, 0x07, 0xa7, 0x82, 0xac, 0x8c,
+	0x64, 0x57, 0x52, 0xb2, 0x0f, 0xd2, 0x45, 0x57, 0x47, 0x92, 0x0f, 0x61, 0xe5, 0x28, 0x08, 0xbf,
+	0xf2, 0xc2, 0x21, 0x1d, 0x7e, 0x1a, 0x84, 0x71, 0xd4, 0xae, 0x5c, 0xaf, 0x30, 0xda, 0x97, 0x33,
+	0x56, 0x76, 0xef, 0x19, 0xa8, 0xbd, 0x69, 0x1c, 0x9e, 0xba, 0x19, 0x52, 0x72, 0x0f, 0x56, 0xb9,
+	0x2f, 0xe6, 0xd1, 0xee, 0x63, 0x3a, 0x38, 0x16, 0xaa, 0x2c, 0xa1, 0x2a, 0x1d, 0x93, 0x9d, 0x8e,
+	0x70, 0x73, 0x34, 0xe4, 0x2e, 0x34, 0x8f, 0xfc, 0x31, 0x3d, 0x38, 0x9d, 0x0e, 0x04, 0x13, 0x0b,
+	0x99, 0x6c, 0xa4, 0x4c, 0xee, 0xe9, 0xcb, 0xae, 0x89, 0x26, 0x07, 0xb0, 0x36, 0xa4, 0xfd, 0xf9,
+	0x68, 0xe4, 0x4f, 0x47, 0xbb, 0xc1, 0x34, 0xf6, 0xfc, 0x29, 0x0d, 0xa3, 0x76, 0x15, 0x0d, 0xbb,
+	0xa1, 0x3b, 0x25, 0x0b, 0xda, 0x3b, 0xa1, 0xd3, 0xd8, 0x5d, 0x44, 0x4d, 0xba, 0x50, 0x9f, 0xd0,
+	0xd8, 0x1b, 0x7a, 0xb1, 0xd7, 0xae, 0xa1, 0x3a, 0x24, 0xe5, 0xf4, 0xb1, 0x5c, 0x71, 0x13, 0x0c,
+	0xb9, 0x05, 0x76, 0xcc, 0xa2, 0x2d, 0xf4, 0xaf, 0x23, 0xc1, 0x5a, 0x4a, 0x70, 0xa8, 0x96, 0xdc,
+	0x14, 0xd5, 0xf9, 0x12, 0xd6, 0x16, 0x78, 0x99, 0x27, 0xd3, 0x31, 0x3d, 0xc5, 0x54, 0xb0, 0x5c,
+	0xfe, 0x49, 0xde, 0x06, 0xeb, 0xc4, 0x1b, 0xcf, 0x55, 0x9c, 0x35, 0xe7, 0x72, 0x32, 0xc9, 0x43,
+	0xd8, 0x22, 0x80, 0x77, 0xca, 0xef, 0x97, 0x9c, 0xbf, 0x96, 0xa1, 0xae, 0x14, 0x25, 0x6f, 0x81,
+	0x85, 0xe9, 0x23, 0x33, 0x6c, 0x23, 0x93, 0x61, 0x89, 0x41, 0x02, 0xc5, 0x24, 0x56, 0x45, 0xd6,
+	0x48, 0x91, 0xed, 0x6c, 0x6a, 0x25, 0x04, 0x12, 0x47, 0x5e, 0x87, 0x25, 0x6e, 0x19, 0x4b, 0x27,
+	0x8e, 0x5f, 0x37, 0x4d, 0x4f, 0xd0, 0x88, 0x21, 0x3b, 0x00, 0xde, 0x70, 0xe8, 0xf3, 0x6d, 0xed,
+	0x8d, 0xdb, 0x03, 0x8c, 0x93, 0x93, 0xf7, 0x6e, 0x77, 0x3b, 0x01, 0x89, 0xfc, 0xd3, 0xa8, 0x3a,
+	0x77, 0xe1, 0xb9, 0xcc, 0xb2, 0xee, 0x38, 0x5b, 0x38, 0xee, 0x79, 0xdd, 0x71, 0xb6, 0xee, 0x9c,
+	0x3f, 0x54, 0xa0, 0x69, 0x58, 0x4e, 0xde, 0x84, 0x16, 0xab, 0x0a, 0x7d, 0x1a, 0x3e, 0x3c, 0xda,
+	0x0e, 0x63, 0xff, 0xc8, 0x1b, 0xb0, 0xcd, 0x21, 0x82, 0x90, 0x5f, 0x20, 0x3f, 0x87, 0x3a, 0x7a,
+	0x8a, 0x27, 0x5a, 0x19, 0x0d, 0xb8, 0x59, 0xe0, 0xd2, 0xee, 0x83, 0x89, 0x37, 0xa2, 0x3b, 0x02,
+	0xec, 0x26, 0x54, 0xe8, 0xb0, 0xd3, 0x19, 0x45, 0x87, 0xad, 0x24, 0x0e, 0x13, 0x95, 0x09, 0xd1,
+	0x87, 0x6c, 0xd5, 0x45, 0x0c, 0xd9, 0x5f, 0xe0, 0xb0, 0x1f, 0x15, 0xc9, 0x3b, 0xcb, 0x6b, 0x2e,
+	0x2c, 0xeb, 0xea, 0x30, 0xa3, 0x85, 0x12, 0x25, 0x54, 0xa2, 0x9d, 0x57, 0x82, 0x86, 0x9a, 0x1a,
+	0xcc, 0x9d, 0x83, 0x60, 0x3e, 0x8d, 0xd1, 0x9d, 0x96, 0x2b, 0x06, 0x4f, 0x1b, 0x89, 0xbf, 0x94,
+	0x60, 0x59, 0xcf, 0x11, 0x56, 0xdb, 0x6a, 0x7c, 0xcc, 0x3d, 0x5b, 0x42, 0x4b, 0xaf, 0x2d, 0x4e,
+	0xa6, 0xae, 0x40, 0xb9, 0x0a, 0xdd, 0xf9, 0x10, 0xaa, 0xe2, 0x93, 0xbc, 0x61, 0x98, 0xb5, 0x61,
+	0x98, 0x25, 0x20, 0xe7, 0x59, 0xe5, 0x7c, 0x5b, 0x82, 0x15, 0x33, 0xd5, 0xc9, 0xcf, 0xc0, 0x16,
+	0xc9, 0x9e, 0xaa, 0x76, 0xa3, 0x68, 0x5f, 0xc8, 0x21, 0x53, 0x2f, 0xa5, 0x21, 0x5b, 0x50, 0x1b,
+	0x8c, 0xe7, 0x5c, 0x3c, 0xca, 0xca, 0x3a, 0x7c, 0x57, 0xac, 0xa1, 0x6a, 0x0a, 0xd8, 0x79, 0x08,
+	0x75, 0xc5, 0x8a, 0x6d, 0x62, 0xdd, 0xac, 0x17, 0x0c, 0x62, 0x05, 0x3a, 0xd7, 0xb0, 0xff, 0x94,
+	0x00, 0xd2, 0x53, 0x85, 0x6c, 0x83, 0xed, 0x69, 0xe9, 0x9e, 0x39, 0x0b, 0x52, 0x60, 0x37, 0xc9,
+	0x7d, 0x91, 0x55, 0x29, 0x15, 0xb9, 0x0e, 0x0d, 0x6f, 0x1e, 0x07, 0x87, 0xa1, 0x3f, 0x1a, 0x49,
+	0xd3, 0xea, 0xae, 0x3e, 0xc5, 0x42, 0x0a, 0xb2, 0xe8, 0x07, 0x43, 0x95, 0xf1, 0x66, 0x54, 0x0e,
+	0x92, 0x65, 0x57, 0x83, 0x76, 0x7e, 0x02, 0x2b, 0xa6, 0xdc, 0x4b, 0xa5, 0xd6, 0xaf, 0xc1, 0x4e,
+	0x0a, 0x2f, 0x59, 0x87, 0xaa, 0x60, 0x2c, 0x69, 0xe5, 0x28, 0xa3, 0x5b, 0xf9, 0xc2, 0xba, 0x39,
+	0xbf, 0x2d, 0x41, 0x43, 0x3b, 0x67, 0x0b, 0x05, 0x3c, 0x3b, 0xf7, 0x38, 0xff, 0x2d, 0xc1, 0x6a,
+	0xf6, 0x7c, 0x2d, 0xd4, 0x63, 0x1f, 0xec, 0x90, 0x46, 0xc1, 0x3c, 0x1c, 0x50, 0x55, 0xb3, 0x5e,
+	0x2b, 0x3e, 0xa6, 0xbb, 0xae, 0xc2, 0xca, 0x78, 0x27, 0xb4, 0x4f, 0x15, 0x4d, 0x93, 0xeb, 0xa5,
+	0xa2, 0xf9, 0x00, 0x9a, 0x46, 0x1b, 0xf0, 0xe4, 0x0e, 0x77, 0xfe, 0x55, 0x03, 0x0b, 0xcf, 0x4b,
+	0xf2, 0x3e, 0x3b, 0xb6, 0x55, 0x03, 0x29, 0xcf, 0xc6, 0x4e, 0x57, 0x74, 0x90, 0x5d, 0xd5, 0x41,
+	0x76, 0x0f, 0x15, 0xc2, 0x4d, 0xc1, 0xe4, 0x36, 0xd8, 0xfc, 0xf0, 0x47, 0x36, 0xf2, 0x94, 0x5c,
+	0x33, 0xcf, 0x30, 0x5c, 0xba, 0xff, 0x03, 0x37, 0xc5, 0x91, 0xfb, 0xac, 0x63, 0x92, 0x7d, 0xef,
+	0x47, 0xc1, 0x48, 0xd0, 0x56, 0x72, 0x1d, 0x53, 0x06, 0xc1, 0x58, 0xe4, 0xa8, 0xc8, 0x67, 0xb0,
+	0xe6, 0xcd, 0x66, 0x63, 0x7f, 0x80, 0xdd, 0x71, 0xc2, 0x4c, 0xb4, 0x5f, 0x5a, 0xc5, 0xdc, 0xce,
+	0x83, 0x18, 0xbf, 0x45, 0xb4, 0xdc, 0xa2, 0xd8, 0x8b, 0x8e, 0x05, 0x23, 0x2b, 0xd7, 0xc2, 0xa8,
+	0x25, 0x6e, 0x51, 0x82, 0x63, 0x0d, 0x65, 0x4b, 0xf4, 0xa5, 0xf3, 0x7e, 0x4a, 0x5c, 0x45, 0xe2,
+	0xab, 0xd9, 0x3a, 0xa2, 0x41, 0x18, 0x93, 0x3c, 0x1d, 0xf9, 0x04, 0x88, 0x6c, 0x56, 0x75, 0x6e,
+	0xa2, 0xfd, 0xda, 0xcc, 0x75, 0xb7, 0x26, 0xbb, 0x05, 0x94, 0xe4, 0x0e, 0xd8, 0x33, 0xd6, 0x21,
+	0x09, 0x36, 0xf5, 0xf3, 0x9a, 0x27, 0x6e, 0x58, 0x02, 0x27, 0x5f, 0xc2, 0x86, 0xde, 0xa8, 0xea,
+	0x0a, 0xd9, 0xc8, 0xe9, 0xc6, 0xe2, 0xcd, 0x63, 0x6a, 0x55, 0xc4, 0x83, 0x1d, 0x26, 0x49, 0x17,
+	0x2b, 0x98, 0x42, 0x51, 0xcf, 0xab, 0x58, 0x99, 0x78, 0xae, 0xdf, 0x70, 0x71, 0x43, 0xdb, 0x6e,
+	0x64, 0xf5, 0x2b, 0xe8, 0x7c, 0xb9, 0x7e, 0x05, 0x3c, 0x78, 0xa6, 0xb2, 0xe3, 0x67, 0xe2, 0x4f,
+	0x31, 0x47, 0x04, 0xdf, 0xe5, 0xac, 0x07, 0x0f, 0x33, 0x08, 0x9e, 0xa9, 0x59, 0x2a, 0x1e, 0x04,
+	0xde, 0xf5, 0x09, 0x16, 0xcd, 0x3c, 0x0b, 0x56, 0xa0, 0x4d, 0x9f, 0xa5, 0xf0, 0x9d, 0x65, 0x00,
+	0xca, 0x3f, 0x1e, 0xf1, 0x03, 0xcd, 0xf9, 0x1c, 0x56, 0xb3, 0x12, 0x0b, 0x8b, 0xc0, 0x6b, 0x50,
+	0xa1, 0x61, 0x28, 0x37, 0xa6, 0xe6, 0xd5, 0xed, 0x01, 0x36, 0x2a, 0xfd, 0x31, 0xdd, 0x0b, 0x43,
+	0x97, 0x63, 0x78, 0x07, 0xd2, 0x34, 0xa6, 0x59, 0x33, 0x5f, 0x63, 0x0b, 0x58, 0xde, 0x4a, 0x67,
+	0x97, 0x37, 0x85, 0x23, 0x6d, 0xa8, 0xb1, 0xca, 0x10, 0xb1, 0xde, 0x4a, 0x56, 0x2e, 0x35, 0x24,
+	0xef, 0x42, 0x23, 0x62, 0x2e, 0x66, 0x36, 0xf1, 0x6b, 0xac, 0xbc, 0x6f, 0x69, 0x57, 0xbc, 0x83,
+	0x64, 0xd1, 0xd5, 0x81, 0xce, 0x67, 0x60, 0x27, 0x55, 0x84, 0x97, 0x45, 0xca, 0x2b, 0xa6, 0xb4,
+	0x52, 0x0c, 0x8c, 0x4b, 0x4a, 0xf9, 0xfc, 0x4b, 0x8a, 0xf3, 0x0f, 0x7e, 0x5e, 0x64, 0x2b, 0xc9,
+	0x06, 0xd4, 0xb8, 0xf7, 0x1f, 0xf9, 0x43, 0xe5, 0x42, 0x3e, 0x7c, 0x30, 0x24, 0xd7, 0x58, 0x9d,
+	0x17, 0x91, 0xe1, 0x6b, 0xc2, 0x2a, 0x5b, 0xce, 0xb0, 0x65, 0xe6, 0xf9, 0x80, 0xd5, 0x53, 0x7f,
+	0x8a, 0x15, 0x8c, 0x91, 0x89, 0x11, 0x6b, 0xbe, 0xac, 0x31, 0x0b, 0xda, 0x18, 0x6b, 0xd1, 0x4a,
+	0x72, 0x2b, 0x15, 0xae, 0x63, 0x52, 0x3f, 0xe2, 0x8b, 0xae, 0xc0, 0xe8, 0x6e, 0xb3, 0x0c, 0xb7,
+	0x39, 0x01, 0xac, 0x2d, 0xa8, 0x5d, 0xe4, 0x26, 0x34, 0x07, 0x2a, 0x53, 0x3f, 0x49, 0xaf, 0xd5,
+	0xe6, 0x24, 0x67, 0x3b, 0x0b, 0x86, 0xb8, 0x2e, 0xa3, 0x21, 0x87, 0xba, 0xc0, 0x8a, 0x29, 0xf0,
+	0xef, 0x25, 0xd6, 0x2e, 0x24, 0xfb, 0x73, 0x05, 0xca, 0x89, 0x43, 0xd8, 0x17, 0xbf, 0xc5, 0x73,
+	0xbb, 0x25, 0x3b, 0xfc, 0x26, 0x9b, 0x60, 0xfb, 0x2c, 0xdd, 0x51, 0x41, 0xe4, 0x66, 0xb9, 0xe9,
+	0x84, 0x96, 0x99, 0x4b, 0x46, 0x66, 0xb2, 0xdb, 0xae, 0xa7, 0x67, 0x5b, 0xfe, 0xb6, 0x6b, 0xe6,
+	0xa8, 0x89, 0x76, 0xfe, 0x59, 0x82, 0x56, 0xae, 0x9c, 0xe6, 0xd4, 0xd5, 0x82, 0x5a, 0x36, 0x82,
+	0xda, 0x81, 0xba, 0xea, 0xdc, 0xa4, 0x03, 0x92, 0xf1, 0xb3, 0xd2, 0xf8, 0x4f, 0x25, 0xbe, 0x6f,
+	0xcd, 0x6d, 0x7e, 0x71, 0x85, 0x53, 0xa5, 0x2a, 0x67, 0x2b, 0xb5, 0x74, 0x29, 0xa5, 0xd8, 0xa6,
+	0x27, 0xf9, 0x73, 0xe4, 0xff, 0xae, 0xd6, 0x1f, 0xcb, 0xb0, 0x51, 0x70, 0x9a, 0x5c, 0x2a, 0xc6,
+	0xaa, 0x5b, 0x53, 0x31, 0x56, 0xe3, 0xc2, 0x18, 0x17, 0x6e, 0xc4, 0x4c, 0xbb, 0x57, 0xbd, 0x70,
+	0xbb, 0x97, 0x77, 0x45, 0xed, 0x52, 0xae, 0xf8, 0x77, 0x05, 0x56, 0xb3, 0x47, 0xf4, 0xc5, 0x7d,
+	0xc0, 0xf6, 0xe6, 0x38, 0x18, 0x78, 0x63, 0xce, 0x41, 0xed, 0xcd, 0x64, 0x42, 0xaf, 0x0f, 0x4b,
+	0x66, 0x7d, 0xc8, 0xd5, 0x17, 0x6b, 0x51, 0x7d, 0x61, 0xdc, 0xf9, 0x3b, 0x5e, 0x34, 0xf3, 0x06,
+	0xc2, 0x25, 0xac, 0x32, 0x26, 0x13, 0xdc, 0xff, 0xbc, 0x8f, 0x40, 0xf2, 0x9a, 0xf0, 0xbf, 0x1a,
+	0x13, 0x07, 0x96, 0x55, 0x2c, 0xf8, 0x55, 0x0d, 0xbb, 0x12, 0xdb, 0x35, 0xe6, 0x74, 0x0c, 0xf2,
+	0xb0, 0x4d, 0x8c, 0xaa, 0x63, 0xec, 0x5e, 0xcf, 0xa6, 0x22, 0xec, 0x1c, 0x98, 0x05, 0x72, 0x48,
+	0xde, 0x01, 0x88, 0xbd, 0x70, 0x44, 0x63, 0x34, 0xbd, 0x91, 0x7d, 0x1a, 0x7c, 0x30, 0x8d, 0x1f,
+	0x86, 0x07, 0x71, 0xc8, 0x8e, 0x7c, 0x57, 0x03, 0x6a, 0x89, 0xb1, 0x7c, 0x76, 0x42, 0x37, 0x2f,
+	0x17, 0xc5, 0x52, 0xda, 0xb6, 0x5f, 0x3e, 0x84, 0xbc, 0xe5, 0xd9, 0xc5, 0x3b, 0xac, 0x0c, 0x61,
+	0x32, 0xc1, 0x4f, 0x44, 0x9f, 0x3f, 0x65, 0xc8, 0x00, 0x8a, 0x81, 0x66, 0x85, 0x75, 0xb6, 0x15,
+	0xd5, 0x4b, 0x59, 0xf1, 0xb7, 0x0a, 0x6c, 0x14, 0x34, 0x51, 0x4f, 0x5f, 0x32, 0x9e, 0x79, 0x32,
+	0x26, 0x05, 0xbf, 0x96, 0x29, 0xf8, 0x4c, 0x72, 0xc8, 0x7c, 0xc9, 0xee, 0x34, 0x32, 0x0f, 0xd5,
+	0x90, 0xbc, 0x08, 0xf0, 0x55, 0x10, 0x1e, 0x33, 0x6b, 0x3f, 0xf0, 0x43, 0x99, 0x80, 0xda, 0x0c,
+	0xbb, 0x7e, 0x00, 0x76, 0x8e, 0xe2, 0x0d, 0x19, 0xb0, 0xa7, 0xb9, 0x75, 0x6e, 0xc3, 0x29, 0xe6,
+	0xb5, 0x17, 0x65, 0x8d, 0x09, 0x7f, 0x47, 0xca, 0x2c, 0x9f, 0x77, 0x3d, 0x6c, 0xea, 0xd7, 0xc3,
+	0xbb, 0xd0, 0xfa, 0x3c, 0xa2, 0x21, 0x4b, 0x6f, 0xfe, 0x0e, 0x2a, 0xdf, 0xde, 0x5f, 0x85, 0xaa,
+	0x8f, 0x13, 0xf2, 0x6e, 0xb7, 0x6a, 0xec, 0x03, 0x0e, 0x94, 0xeb, 0xce, 0x4f, 0x61, 0x45, 0xde,
+	0x0e, 0x15, 0xed, 0x9b, 0xe6, 0xef, 0x00, 0xfa, 0x93, 0xa6, 0x00, 0x1a, 0x3f, 0x07, 0xdc, 0x82,
+	0x65, 0x7d, 0x9a, 0xb9, 0xbd, 0x46, 0x31, 0x7d, 0x44, 0x6a, 0xd4, 0x59, 0x5f, 0xab, 0x26, 0x76,
+	0x2c, 0xa8, 0x30, 0xbd, 0x9d, 0x5f, 0x40, 0x55, 0x28, 0xc1, 0xad, 0x4a, 0x5f, 0x67, 0xeb, 0xea,
+	0x11, 0x96, 0xb5, 0x1c, 0x11, 0xdb, 0x35, 0xf2, 0x02, 0x8b, 0xdf, 0x3c, 0x87, 0xe4, 0xc3, 0x6c,
+	0x05, 0x67, 0xe5, 0xc8, 0xf1, 0x01, 0xd2, 0x3e, 0x92, 0xec, 0xc2, 0x4a, 0xda, 0x49, 0x6a, 0x6d,
+	0xec, 0x55, 0xb3, 0x6c, 0x1b, 0x10, 0x37, 0x43, 0xc2, 0x45, 0x89, 0x4d, 0xa0, 0xd2, 0x58, 0x8c,
+	0x58, 0x5f, 0xda, 0xd0, 0x6a, 0x08, 0x36, 0x46, 0xea, 0x51, 0xca, 0x92, 0x2f, 0x4f, 0xeb, 0xe8,
+	0xf6, 0x2f, 0xbc, 0xb1, 0x7c, 0x7a, 0x92, 0x23, 0xb1, 0x03, 0x42, 0x3e, 0x9f, 0xec, 0x00, 0x3e,
+	0xda, 0xfa, 0xbd, 0x05, 0x2d, 0xd5, 0x97, 0x7e, 0xb1, 0x75, 0x40, 0xc3, 0x13, 0x9f, 0x65, 0xee,
+	0x3d, 0xa8, 0xef, 0x53, 0xf5, 0x7a, 0x93, 0xbb, 0x94, 0xef, 0xf1, 0x9f, 0x75, 0x3a, 0xd9, 0x5f,
+	0x67, 0x9c, 0xd6, 0xef, 0xbe, 0xf9, 0xfe, 0xcf, 0xe5, 0x06, 0xb1, 0x7b, 0xfc, 0x37, 0x26, 0xa4,
+	0xdd, 0x87, 0x2a, 0x66, 0x5f, 0x74, 0x11, 0x2e, 0x88, 0x74, 0x08, 0x72, 0x59, 0x26, 0xc0, 0xb9,
+	0xe0, 0x0d, 0x24, 0x7a, 0xbb, 0x44, 0x7e, 0x05, 0xb5, 0xbd, 0xaf, 0xe9, 0x60, 0xce, 0x78, 0x6a,
+	0x77, 0xdb, 0x5c, 0xd6, 0x75, 0x0a, 0xc4, 0x38, 0x57, 0x91, 0xeb, 0x15, 0xa7, 0x81, 0x5c, 0x05,
+	0xa7, 0x3b, 0x32, 0x01, 0xc9, 0x10, 0xec, 0xed, 0x79, 0x1c, 0x60, 0x6b, 0x47, 0xda, 0xb9, 0x64,
+	0x3b, 0x8f, 0xf7, 0x2b, 0xc8, 0xfb, 0xa5, 0xce, 0x3a, 0xe7, 0x8d, 0xf9, 0xd3, 0xe3, 0x2f, 0x1e,
+	0x8f, 0x94, 0x18, 0x91, 0xa6, 0xa4, 0x0f, 0x75, 0x2e, 0x85, 0x57, 0xe3, 0x27, 0x10, 0x72, 0x13,
+	0x85, 0xbc, 0xd8, 0xb9, 0x82, 0xce, 0x65, 0x3c, 0x16, 0xca, 0x38, 0x02, 0xe0, 0x32, 0x44, 0x77,
+	0xf5, 0x04, 0x52, 0x7e, 0x88, 0x52, 0xae, 0x77, 0x36, 0xb8, 0x14, 0x91, 0xdf, 0x0b, 0xe5, 0x3c,
+	0x84, 0xea, 0x7d, 0x6f, 0x3a, 0x1c, 0x53, 0x92, 0x8d, 0x5f, 0x21, 0xeb, 0x4d, 0x64, 0xbd, 0xee,
+	0xb4, 0xd2, 0xb8, 0xf6, 0x1e, 0x23, 0x8f, 0x3b, 0xa5, 0xd7, 0x77, 0x6e, 0xff, 0xf2, 0xd6, 0xc8,
+	0x8f, 0x1f, 0xcf, 0xfb, 0xdd, 0x41, 0x30, 0xe9, 0xed, 0x23, 0x87, 0xa4, 0x80, 0x1d, 0x06, 0xc1,
+	0x38, 0x4a, 0x7e, 0xb5, 0x14, 0x3f, 0x2f, 0x32, 0x2e, 0x9f, 0x56, 0xfa, 0x55, 0xfc, 0xbe, 0xfd,
+	0x3f, 0xaf, 0xb9, 0xbe, 0x51, 0xd6, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.